@@ -28,6 +28,9 @@ contract_sources=(
   'mocks/parseIntScientificExporter'
   'mocks/tokenWhitelistableExporter'
   'mocks/walletMock'
+  'mocks/rateAdapter'
+  'mocks/falseToken'
+  'mocks/dexAdapter'
   'externals/ens/PublicResolver'
   'externals/ens/ENSRegistry'
   'externals/upgradeability/UpgradeabilityProxy'
@@ -70,6 +73,9 @@ contracts=(
   "mocks/parseIntScientificExporter/ParseIntScientificExporter mocks/parseIntScientificExporter.go ParseIntScientificExporter mocks"
   "mocks/tokenWhitelistableExporter/TokenWhitelistableExporter mocks/tokenWhitelistableExporter.go TokenWhitelistableExporter mocks"
   "mocks/walletMock/WalletMock mocks/walletMock.go WalletMock mocks"
+  "mocks/rateAdapter/RateAdapter mocks/rateAdapter.go RateAdapter mocks"
+  "mocks/falseToken/FalseToken mocks/falseToken.go FalseToken mocks"
+  "mocks/dexAdapter/DexAdapter mocks/dexAdapter.go DexAdapter mocks"
   "externals/ens/ENSRegistry/ENSRegistry externals/ens/ENSRegistry.go ENSRegistry ens"
   "externals/ens/PublicResolver/PublicResolver externals/ens/PublicResolver.go PublicResolver ens"
   "externals/upgradeability/UpgradeabilityProxy/UpgradeabilityProxy externals/upgradeability/UpgradeabilityProxy.go UpgradeabilityProxy upgradeability"
@@ -0,0 +1,24 @@
+// Command bindcheck fails if any Go binding differs from the contracts compiled by build.sh.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/tokencard/contracts/v3/pkg/bindcheck"
+)
+
+func main() {
+	buildDir := flag.String("build", "build", "directory holding the compiler artifacts")
+	flag.Parse()
+
+	errs := bindcheck.CheckAll(*buildDir)
+	for _, err := range errs {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	if len(errs) > 0 {
+		os.Exit(1)
+	}
+	fmt.Printf("%d bindings match the compiled contracts\n", len(bindcheck.Bindings))
+}
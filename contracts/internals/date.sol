@@ -35,6 +35,27 @@ contract Date {
     bytes32 private constant _NOVEMBER = keccak256("Nov");
     bytes32 private constant _DECEMBER = keccak256("Dec");
 
+    /// @return the unix timestamp of a date encoded as YYYYMMDDhhmmss e.g. 20180913153211.
+    /// @param _date the encoded UTC date, malformed dates and dates before 1970 are mapped to 0.
+    function _dateToTimestamp(uint256 _date) internal pure returns (uint256) {
+        uint256 year = _date / 10**10;
+        uint256 month = (_date / 10**8) % 100;
+        uint256 day = (_date / 10**6) % 100;
+        if (year < 1970 || month == 0 || month > 12 || day == 0) {
+            return 0;
+        }
+        uint256 secondsOfDay = ((_date / 10**4) % 100) * 1 hours + ((_date / 10**2) % 100) * 1 minutes + (_date % 100);
+        // Count the days since the epoch, see http://howardhinnant.github.io/date_algorithms.html#days_from_civil
+        if (month <= 2) {
+            year -= 1;
+        }
+        uint256 era = year / 400;
+        uint256 yearOfEra = year - era * 400;
+        uint256 dayOfYear = (153 * (month > 2 ? month - 3 : month + 9) + 2) / 5 + day - 1;
+        uint256 dayOfEra = yearOfEra * 365 + yearOfEra / 4 - yearOfEra / 100 + dayOfYear;
+        return (era * 146097 + dayOfEra - 719468) * 1 days + secondsOfDay;
+    }
+
     /// @return the number of the month based on its name.
     /// @param _month the first three letters of a month's name e.g. "Jan".
     function _monthToNumber(string memory _month) internal pure returns (uint8) {
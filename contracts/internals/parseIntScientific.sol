@@ -33,6 +33,11 @@ contract ParseIntScientific {
     bytes1 private constant _E_ASCII = bytes1(uint8(69)); //decimal value of 'E'
     bytes1 private constant _LOWERCASE_E_ASCII = bytes1(uint8(101)); //decimal value of 'e'
 
+    /// @dev The largest exponent and number of decimal digits parsed, 10**78 doesn't fit in a uint256.
+    uint256 internal constant _MAX_EXPONENT = 77;
+
+    uint256 private constant _MAX_INT256 = 2**255 - 1; // the largest positive int256, its smallest value is -(2**255)
+
     /// @notice ParseIntScientific delegates the call to _parseIntScientific(string, uint) with the 2nd argument being 0.
     function _parseIntScientific(string memory _inString) internal pure returns (uint256) {
         return _parseIntScientific(_inString, 0);
@@ -43,6 +48,51 @@ contract ParseIntScientific {
         return _parseIntScientific(_inString, 18);
     }
 
+    /// @notice ParseIntScientificSigned delegates the call to _parseIntScientificSigned(string, uint) with the 2nd argument being 0.
+    function _parseIntScientificSigned(string memory _inString) internal pure returns (int256) {
+        return _parseIntScientificSigned(_inString, 0);
+    }
+
+    /// @notice ParseIntScientificSigned parses a floating point number like _parseIntScientific, allowing a leading '-'.
+    /// @dev The result has to fit in an int256, i.e. be between -(2**255) and 2**255 - 1, otherwise it reverts.
+    /// @param _inString is input string.
+    /// @param _magnitudeMult multiplies the number with 10^_magnitudeMult.
+    function _parseIntScientificSigned(string memory _inString, uint256 _magnitudeMult) internal pure returns (int256) {
+        (bool negative, uint256 magnitude) = _parseIntScientificSignMagnitude(_inString, _magnitudeMult);
+        if (!negative) {
+            require(magnitude <= _MAX_INT256, "int256 overflow");
+            return int256(magnitude);
+        }
+        require(magnitude <= _MAX_INT256 + 1, "int256 overflow");
+        // -(2**255) is the only value whose absolute value doesn't fit in an int256, int256(2**255) and its negation wrap around to it.
+        return -int256(magnitude);
+    }
+
+    /// @notice ParseIntScientificSignMagnitude delegates the call to _parseIntScientificSignMagnitude(string, uint) with the 2nd argument being 0.
+    function _parseIntScientificSignMagnitude(string memory _inString) internal pure returns (bool, uint256) {
+        return _parseIntScientificSignMagnitude(_inString, 0);
+    }
+
+    /// @notice ParseIntScientificSignMagnitude parses a floating point number like _parseIntScientific, allowing a leading '-'.
+    /// @param _inString is input string.
+    /// @param _magnitudeMult multiplies the number with 10^_magnitudeMult.
+    /// @return whether the number is negative, which is never the case if its magnitude is 0 (e.g. '-0').
+    /// @return the absolute value of the number.
+    function _parseIntScientificSignMagnitude(string memory _inString, uint256 _magnitudeMult) internal pure returns (bool, uint256) {
+        bytes memory inBytes = bytes(_inString);
+        if (inBytes.length == 0 || inBytes[0] != _DASH_ASCII) {
+            return (false, _parseIntScientific(_inString, _magnitudeMult));
+        }
+        // the number following the sign is parsed as usual, so any other '-' is still validated
+        require(inBytes.length > 1, "missing integral part");
+        bytes memory absBytes = new bytes(inBytes.length - 1);
+        for (uint256 i = 1; i < inBytes.length; i++) {
+            absBytes[i - 1] = inBytes[i];
+        }
+        uint256 magnitude = _parseIntScientific(string(absBytes), _magnitudeMult);
+        return (magnitude != 0, magnitude);
+    }
+
     /// @notice ParseIntScientific parses a JSON standard - floating point number.
     /// @param _inString is input string.
     /// @param _magnitudeMult multiplies the number with 10^_magnitudeMult.
@@ -124,7 +174,7 @@ contract ParseIntScientific {
             if (mintExp >= _magnitudeMult) {
                 // the (negative) exponent is bigger than the given parameter for "shifting left".
                 // use integer division to reduce the precision.
-                require(mintExp - _magnitudeMult < 78, "exponent > 77"); //
+                require(mintExp - _magnitudeMult <= _MAX_EXPONENT, "exponent > 77"); //
                 mint /= 10**(mintExp - _magnitudeMult);
                 return mint;
             } else {
@@ -142,23 +192,138 @@ contract ParseIntScientific {
             // the decimals are fewer or equal than the shifts: use all of them
             // shift number and add the decimals at the end
             // include decimals if present in the original input
-            require(decMinted < 78, "more than 77 decimal digits parsed"); //
+            require(decMinted <= _MAX_EXPONENT, "more than 77 decimal digits parsed"); //
             mint = mint.mul(10**(decMinted));
             mint = mint.add(mintDec);
             //// add zeros at the end if the decimals were fewer than #_magnitudeMult
-            require(_magnitudeMult - decMinted < 78, "exponent > 77"); //
+            require(_magnitudeMult - decMinted <= _MAX_EXPONENT, "exponent > 77"); //
             mint = mint.mul(10**(_magnitudeMult - decMinted));
         } else {
             // the decimals are more than the #_magnitudeMult shifts
             // use only the ones needed, discard the rest
             decMinted -= _magnitudeMult;
-            require(decMinted < 78, "more than 77 decimal digits parsed"); //
+            require(decMinted <= _MAX_EXPONENT, "more than 77 decimal digits parsed"); //
             mintDec /= 10**(decMinted);
             // shift number and add the decimals at the end
-            require(_magnitudeMult < 78, "more than 77 decimal digits parsed"); //
+            require(_magnitudeMult <= _MAX_EXPONENT, "more than 77 decimal digits parsed"); //
             mint = mint.mul(10**(_magnitudeMult));
             mint = mint.add(mintDec);
         }
         return mint;
     }
+
+    /// @notice TryParseIntScientific delegates the call to _tryParseIntScientific(string, uint) with the 2nd argument being 0.
+    function _tryParseIntScientific(string memory _inString) internal pure returns (bool, uint256) {
+        return _tryParseIntScientific(_inString, 0);
+    }
+
+    /// @notice TryParseIntScientific parses the same format as _parseIntScientific, but returns false instead of reverting.
+    /// @param _inString is input string.
+    /// @param _magnitudeMult multiplies the number with 10^_magnitudeMult.
+    /// @return whether the string was parsed and the parsed number, 0 if it wasn't.
+    function _tryParseIntScientific(string memory _inString, uint256 _magnitudeMult) internal pure returns (bool, uint256) {
+        (bool ok, uint256 mint, uint256 mintDec, uint256 decMinted, uint256 mintExp, bool minus) = _tryParseParts(bytes(_inString));
+        if (!ok) {
+            return (false, 0);
+        }
+
+        if (minus) {
+            if (mintExp >= _magnitudeMult) {
+                if (mintExp - _magnitudeMult > _MAX_EXPONENT) {
+                    return (false, 0);
+                }
+                return (true, mint / 10**(mintExp - _magnitudeMult));
+            }
+            _magnitudeMult = _magnitudeMult - mintExp;
+        } else {
+            if (_magnitudeMult > uint256(-1) - mintExp) {
+                return (false, 0);
+            }
+            _magnitudeMult = _magnitudeMult + mintExp;
+        }
+
+        if (_magnitudeMult >= decMinted) {
+            if (decMinted > _MAX_EXPONENT || _magnitudeMult - decMinted > _MAX_EXPONENT) {
+                return (false, 0);
+            }
+            (ok, mint) = _tryMulAdd(mint, 10**decMinted, mintDec);
+            if (!ok) {
+                return (false, 0);
+            }
+            return _tryMulAdd(mint, 10**(_magnitudeMult - decMinted), 0);
+        }
+        // the decimals are more than the #_magnitudeMult shifts, discard the ones not needed
+        decMinted -= _magnitudeMult;
+        if (decMinted > _MAX_EXPONENT || _magnitudeMult > _MAX_EXPONENT) {
+            return (false, 0);
+        }
+        return _tryMulAdd(mint, 10**_magnitudeMult, mintDec / 10**decMinted);
+    }
+
+    /// @dev splits _inBytes into its integral part, decimals and exponent, following the checks of _parseIntScientific.
+    function _tryParseParts(bytes memory _inBytes)
+        private
+        pure
+        returns (bool ok, uint256 mint, uint256 mintDec, uint256 decMinted, uint256 mintExp, bool minus)
+    {
+        uint256 expIndex = 0;
+        bool integral = false;
+        bool decimals = false;
+        bool exp = false;
+        bool plus = false;
+        uint256 i;
+        for (i = 0; i < _inBytes.length; i++) {
+            if ((_inBytes[i] >= _ZERO_ASCII) && (_inBytes[i] <= _NINE_ASCII)) {
+                if (exp) {
+                    (ok, mintExp) = _tryMulAdd(mintExp, 10, uint8(_inBytes[i]) - uint8(_ZERO_ASCII));
+                } else if (decimals) {
+                    (ok, mintDec) = _tryMulAdd(mintDec, 10, uint8(_inBytes[i]) - uint8(_ZERO_ASCII));
+                    decMinted++;
+                } else {
+                    integral = true;
+                    (ok, mint) = _tryMulAdd(mint, 10, uint8(_inBytes[i]) - uint8(_ZERO_ASCII));
+                }
+                if (!ok) {
+                    return (false, 0, 0, 0, 0, false);
+                }
+            } else if (_inBytes[i] == _DOT_ASCII) {
+                if (!integral || decimals || exp) {
+                    return (false, 0, 0, 0, 0, false);
+                }
+                decimals = true;
+            } else if ((_inBytes[i] == _DASH_ASCII) || (_inBytes[i] == _PLUS_ASCII)) {
+                // only one sign is allowed, immediately after the exponent symbol
+                if (minus || plus || expIndex + 1 != i) {
+                    return (false, 0, 0, 0, 0, false);
+                }
+                minus = _inBytes[i] == _DASH_ASCII;
+                plus = !minus;
+            } else if ((_inBytes[i] == _E_ASCII) || (_inBytes[i] == _LOWERCASE_E_ASCII)) {
+                if (!integral || exp) {
+                    return (false, 0, 0, 0, 0, false);
+                }
+                exp = true;
+                expIndex = i;
+            } else {
+                return (false, 0, 0, 0, 0, false);
+            }
+        }
+
+        // the exponent has to be specified after the exponent symbol and its sign
+        if (((minus || plus) && i <= expIndex + 2) || (exp && i <= expIndex + 1)) {
+            return (false, 0, 0, 0, 0, false);
+        }
+        return (true, mint, mintDec, decMinted, mintExp, minus);
+    }
+
+    /// @dev returns _a * _b + _c, or false if it overflows.
+    function _tryMulAdd(uint256 _a, uint256 _b, uint256 _c) private pure returns (bool, uint256) {
+        if (_a != 0 && _a > uint256(-1) / _b) {
+            return (false, 0);
+        }
+        if (_a * _b > uint256(-1) - _c) {
+            return (false, 0);
+        }
+        return (true, _a * _b + _c);
+    }
 }
@@ -18,13 +18,13 @@
 
 pragma solidity 0.5.17;
 
+import "../externals/Address.sol";
 import "../externals/ERC20.sol";
-import "../externals/SafeERC20.sol";
 
 
 /// @title SafeTransfer, allowing contract to withdraw tokens accidentally sent to itself
 contract Transferrable {
-    using SafeERC20 for ERC20;
+    using Address for address;
 
     /// @dev This function is used to move tokens sent accidentally to this contract method.
     /// @dev The owner can chose the new destination address
@@ -37,7 +37,10 @@ contract Transferrable {
             (bool success, ) = _to.call.value(_amount)("");
             require(success, "safeTransfer failed");
         } else {
-            ERC20(_asset).safeTransfer(_to, _amount);
+            require(_asset.isContract(), "asset is not a contract");
+            // Some tokens return false instead of reverting, others return nothing at all.
+            (bool success, bytes memory returndata) = _asset.call(abi.encodeWithSelector(ERC20(_asset).transfer.selector, _to, _amount));
+            require(success && (returndata.length == 0 || abi.decode(returndata, (bool))), "transfer failed");
         }
     }
 }
@@ -0,0 +1,23 @@
+pragma solidity 0.5.17;
+
+
+/// @title FalseToken is a mock ERC20 token that returns false from its transfers instead of reverting.
+contract FalseToken {
+    /// @dev Balances for each account.
+    mapping(address => uint256) public balanceOf;
+
+    /// @dev Credit an account with new tokens.
+    function credit(address _to, uint256 _amount) public returns (bool) {
+        balanceOf[_to] += _amount;
+        return true;
+    }
+
+    /// @dev Transfers always fail, without moving any tokens.
+    function transfer(address, uint256) public returns (bool) {
+        return false;
+    }
+
+    function transferFrom(address, address, uint256) public returns (bool) {
+        return false;
+    }
+}
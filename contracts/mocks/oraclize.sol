@@ -5,11 +5,36 @@ contract OraclizeConnector {
     address public cbAddress;
     uint256 gasPrice;
     bytes1 public proofType;
+    address private reentryTarget;
+    bytes private reentryData;
 
     constructor(address _cbAddress) public {
         cbAddress = _cbAddress;
     }
 
+    /// @dev Changes the address callbacks are accepted from, e.g. to this connector so that its reentrant calls are let in.
+    function setCbAddress(address _cbAddress) external {
+        cbAddress = _cbAddress;
+    }
+
+    /// @dev Makes the queries call _target with _data, used to test reentrancy protection.
+    function setReentry(address _target, bytes calldata _data) external {
+        reentryTarget = _target;
+        reentryData = _data;
+    }
+
+    function reenter() private {
+        if (reentryTarget != address(0)) {
+            (bool success, bytes memory returndata) = reentryTarget.call(reentryData);
+            if (!success) {
+                // bubble up the revert reason
+                assembly {
+                    revert(add(returndata, 32), mload(returndata))
+                }
+            }
+        }
+    }
+
     function query(string memory _arg) private pure returns (bytes32) {
         return keccak256(bytes(_arg));
     }
@@ -22,6 +47,7 @@ contract OraclizeConnector {
     }
 
     function query_withGasLimit(uint256 _timestamp, string calldata _datasource, string calldata _arg, uint256 _gaslimit) external payable returns (bytes32) {
+        reenter();
         if (_timestamp == 0 && bytes(_datasource).length == 0 && _gaslimit == 0) {
             return query(_arg);
         }
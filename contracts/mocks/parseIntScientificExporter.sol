@@ -1,4 +1,5 @@
 pragma solidity 0.5.17;
+pragma experimental ABIEncoderV2;
 
 import "../internals/parseIntScientific.sol";
 
@@ -14,8 +15,57 @@ contract ParseIntScientificExporter is ParseIntScientific {
         return _parseIntScientific(_a, _b);
     }
 
+    /// @dev parses each string with _parseIntScientific(string, uint) and the shared _b, e.g. to validate a feed in one call.
+    /// @notice reverts with the index of the first invalid string, parseIntScientificDecimals tells why it is invalid.
+    /// @notice _a is in memory as 0.5 can't index calldata arrays of dynamically encoded types.
+    function parseIntScientificBatch(string[] memory _a, uint256 _b) public pure returns (uint256[] memory) {
+        uint256[] memory values = new uint256[](_a.length);
+        for (uint256 i = 0; i < _a.length; i++) {
+            (bool ok, uint256 value) = _tryParseIntScientific(_a[i], _b);
+            require(ok, string(abi.encodePacked("invalid number at index ", _uintToString(i))));
+            values[i] = value;
+        }
+        return values;
+    }
+
     /// @dev exports _parseIntScientificWei(string) as an external function.
     function parseIntScientificWei(string calldata _a) external pure returns (uint256) {
         return _parseIntScientificWei(_a);
     }
+
+    /// @dev exports _parseIntScientificSigned(string) as an external function.
+    function parseIntScientificSigned(string calldata _a) external pure returns (int256) {
+        return _parseIntScientificSigned(_a);
+    }
+
+    /// @dev exports _parseIntScientificSignMagnitude(string) as an external function.
+    function parseIntScientificSignMagnitude(string calldata _a) external pure returns (bool negative, uint256 magnitude) {
+        return _parseIntScientificSignMagnitude(_a);
+    }
+
+    /// @dev exports _tryParseIntScientific(string) as an external function.
+    function tryParseIntScientific(string calldata _a) external pure returns (bool ok, uint256 value) {
+        return _tryParseIntScientific(_a);
+    }
+
+    /// @dev exports _MAX_EXPONENT as an external function.
+    function maxExponent() external pure returns (uint256) {
+        return _MAX_EXPONENT;
+    }
+
+    /// @dev returns the decimal representation of _i.
+    function _uintToString(uint256 _i) private pure returns (string memory) {
+        if (_i == 0) {
+            return "0";
+        }
+        uint256 len;
+        for (uint256 j = _i; j != 0; j /= 10) {
+            len++;
+        }
+        bytes memory b = new bytes(len);
+        for (; _i != 0; _i /= 10) {
+            b[--len] = bytes1(uint8(48 + (_i % 10)));
+        }
+        return string(b);
+    }
 }
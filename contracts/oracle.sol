@@ -29,6 +29,14 @@ import "./externals/oraclizeAPI_0.5.sol";
 import "./externals/base64.sol";
 
 
+/// @title IRateAdapter is implemented by custom rate sources the Oracle can read from.
+interface IRateAdapter {
+    /// @return the value in wei of one whole token.
+    /// @return when the rate was observed (unix timestamp).
+    function getRate(address) external view returns (uint256, uint256);
+}
+
+
 /// @title Oracle provides asset exchange rates and conversion functionality.
 contract Oracle is ENSResolvable, usingOraclize, Transferrable, Base64, Date, Controllable, ParseIntScientific, TokenWhitelistable {
     using strings for *;
@@ -47,6 +55,16 @@ contract Oracle is ENSResolvable, usingOraclize, Transferrable, Base64, Date, Co
 
     event SetCryptoComparePublicKey(address _sender, bytes _publicKey);
 
+    event SetMaxRateAge(address _sender, uint256 _maxRateAge);
+
+    event SetFeeToken(address _sender, address _feeToken);
+
+    event SetMaintenance(address _sender, bool _maintenance);
+
+    event SetAdapter(address _sender, address _token, address _adapter);
+
+    event PrunedHistory(address _sender, address _token, uint256 _keepLast);
+
     event Claimed(address _to, address _asset, uint256 _amount);
 
     /**********************/
@@ -55,6 +73,7 @@ contract Oracle is ENSResolvable, usingOraclize, Transferrable, Base64, Date, Co
 
     uint256 private constant _PROOF_LEN = 165;
     uint256 private constant _ECDSA_SIG_LEN = 65;
+    uint256 private constant _RATE_HISTORY_LEN = 8; // the number of past rates kept per token.
     uint256 private constant _ENCODING_BYTES = 2;
     uint256 private constant _HEADERS_LEN = _PROOF_LEN - 2 * _ENCODING_BYTES - _ECDSA_SIG_LEN; // 2 bytes encoding headers length + 2 for signature.
     uint256 private constant _DIGEST_BASE64_LEN = 44; //base64 encoding of the SHA256 hash (32-bytes) of the result: fixed length.
@@ -68,6 +87,44 @@ contract Oracle is ENSResolvable, usingOraclize, Transferrable, Base64, Date, Co
     bytes public cryptoCompareAPIPublicKey;
     mapping(bytes32 => address) private _queryToToken;
 
+    /// @notice Rates that haven't been updated for longer than this (in seconds) are stale, 0 disables staleness.
+    uint256 public maxRateAge;
+
+    /// @dev Is set while a guarded function is being executed.
+    bool private _entered;
+
+    /// @notice Is the token the licence fee is taken in, i.e. TKN.
+    address public feeToken;
+
+    /// @notice Is set while the oracle is under maintenance, rate updates are blocked but the last rates can be read.
+    bool public maintenance;
+
+    /// @notice Is the custom rate source of each token, if any.
+    mapping(address => address) public rateAdapter;
+
+    /// @dev The last _RATE_HISTORY_LEN rates of each token, overwritten in a circular fashion.
+    mapping(address => uint256[_RATE_HISTORY_LEN]) private _rateHistory;
+
+    /// @dev The number of rates ever recorded for each token, the next one is stored at this index modulo the length.
+    mapping(address => uint256) private _rateHistoryCount;
+
+    /// @notice Is set once the oracle has been configured with initialize().
+    bool public initialized;
+
+    /// @notice Rejects calls made while another guarded call is being executed.
+    modifier nonReentrant() {
+        require(!_entered, "reentrant call");
+        _entered = true;
+        _;
+        _entered = false;
+    }
+
+    /// @notice Rejects calls made while the oracle is under maintenance.
+    modifier notInMaintenance() {
+        require(!maintenance, "maintenance");
+        _;
+    }
+
     /// @notice Construct the oracle with multiple controllers, address resolver and custom gas price.
     /// @param _resolver_ is the address of the oraclize resolver
     /// @param _ens_ is the address of the ENS.
@@ -97,16 +154,263 @@ contract Oracle is ENSResolvable, usingOraclize, Transferrable, Base64, Date, Co
         emit SetGasPrice(msg.sender, _gasPrice);
     }
 
+    /// @notice Sets the age after which a token rate is considered stale.
+    /// @param _maxRateAge in seconds, 0 means rates never go stale.
+    function setMaxRateAge(uint256 _maxRateAge) external onlyAdmin {
+        maxRateAge = _maxRateAge;
+        emit SetMaxRateAge(msg.sender, _maxRateAge);
+    }
+
+    /// @notice Blocks or unblocks rate updates, e.g. during an upgrade.
+    /// @param _maintenance is true to block the updates.
+    function setMaintenance(bool _maintenance) external onlyController {
+        maintenance = _maintenance;
+        emit SetMaintenance(msg.sender, _maintenance);
+    }
+
+    /// @notice Reads the last rate of a token, flagging whether it may be outdated because of maintenance.
+    /// @param _token is the address of a whitelisted token.
+    /// @return the value in wei of one whole token.
+    /// @return whether the oracle is under maintenance.
+    function tokenRate(address _token) external view returns (uint256, bool) {
+        (, , uint256 rate, bool available, , , ) = _getTokenInfo(_token);
+        require(available, "token not available");
+        return (rate, maintenance);
+    }
+
+    /// @notice Registers a custom rate source for a token, e.g. for an integration with a bespoke price feed.
+    /// @param _token is the address of the token.
+    /// @param _adapter is the address of the IRateAdapter, 0x0 removes the token's adapter.
+    function setAdapter(address _token, address _adapter) external onlyAdmin {
+        rateAdapter[_token] = _adapter;
+        emit SetAdapter(msg.sender, _token, _adapter);
+    }
+
+    /// @notice Reads the rate of a token from its registered adapter.
+    /// @param _token is the address of the token.
+    /// @return the value in wei of one whole token.
+    /// @return when the rate was observed (unix timestamp).
+    function tokenRateFromAdapter(address _token) external view returns (uint256, uint256) {
+        address adapter = rateAdapter[_token];
+        require(adapter != address(0), "no adapter");
+        return IRateAdapter(adapter).getRate(_token);
+    }
+
+    /// @notice Measures how far the pushed rate of a token has drifted from the rate of its adapter.
+    /// @param _token is the address of a whitelisted token with an adapter.
+    /// @return the absolute difference between the rates in basis points of the pushed rate, rounded down.
+    function rateConsistency(address _token) external view returns (uint256) {
+        (, , uint256 pushedRate, bool available, , , ) = _getTokenInfo(_token);
+        require(available, "token not available");
+        require(pushedRate != 0, "no pushed rate");
+        address adapter = rateAdapter[_token];
+        require(adapter != address(0), "no adapter");
+        (uint256 adapterRate, ) = IRateAdapter(adapter).getRate(_token);
+        require(adapterRate != 0, "no adapter rate");
+        uint256 diff = pushedRate > adapterRate ? pushedRate - adapterRate : adapterRate - pushedRate;
+        return diff.mul(10000).div(pushedRate);
+    }
+
+    /// @notice Sets the token the licence fee is taken in.
+    /// @param _feeToken is the address of the fee token, it must be whitelisted for its rate to be quoted.
+    function setFeeToken(address _feeToken) external onlyAdmin {
+        feeToken = _feeToken;
+        emit SetFeeToken(msg.sender, _feeToken);
+    }
+
+    /// @notice Configures a freshly deployed oracle in a single transaction, it can only be called once.
+    /// @param _tokens are the addresses of the tokens with a custom rate source.
+    /// @param _adapters are the IRateAdapter addresses of the respective tokens.
+    /// @param _maxRateAge in seconds, 0 means rates never go stale.
+    /// @param _feeToken is the address of the fee token, 0x0 leaves it unset.
+    function initialize(address[] calldata _tokens, address[] calldata _adapters, uint256 _maxRateAge, address _feeToken) external onlyAdmin {
+        require(!initialized, "already initialized");
+        require(_tokens.length == _adapters.length, "parameter lengths do not match");
+        initialized = true;
+        for (uint256 i = 0; i < _tokens.length; i++) {
+            rateAdapter[_tokens[i]] = _adapters[i];
+            emit SetAdapter(msg.sender, _tokens[i], _adapters[i]);
+        }
+        maxRateAge = _maxRateAge;
+        emit SetMaxRateAge(msg.sender, _maxRateAge);
+        if (_feeToken != address(0)) {
+            feeToken = _feeToken;
+            emit SetFeeToken(msg.sender, _feeToken);
+        }
+    }
+
+    /// @notice Quotes the fee token in another asset, e.g. to display the licence fee in a stablecoin.
+    /// @param _quote is the address of the quote token or 0x0 for ether.
+    /// @return the value of one whole fee token in base units of the quote asset.
+    function feeTokenRateIn(address _quote) external view returns (uint256) {
+        require(feeToken != address(0), "fee token not set");
+        return _crossRate(feeToken, _quote);
+    }
+
+    /// @notice Values a wallet's holdings in ether, e.g. for a portfolio dashboard.
+    /// @param _wallet is the address of the wallet.
+    /// @param _tokens are the addresses of the whitelisted tokens to include, each listed once.
+    /// @return the wallet's ether balance plus the value in wei of each token balance.
+    function portfolioValue(address _wallet, address[] calldata _tokens) external view returns (uint256) {
+        uint256 value = _wallet.balance;
+        for (uint256 i = 0; i < _tokens.length; i++) {
+            uint256 balance = ERC20(_tokens[i]).balanceOf(_wallet);
+            if (balance == 0) {
+                continue;
+            }
+            (, uint256 magnitude, uint256 rate, bool available, , , ) = _getTokenInfo(_tokens[i]);
+            require(available, "token not available");
+            require(rate != 0, "rate=0");
+            // The rate of a token is the value in wei of one whole token.
+            value = value.add(balance.mul(rate).div(magnitude));
+        }
+        return value;
+    }
+
+    /// @notice Returns basic statistics of the token's recent rates, i.e. the ones kept in its rate history.
+    /// @param _token is the address of the token.
+    /// @return the lowest, highest and (rounded down) average of the recorded rates.
+    function rateStats(address _token) external view returns (uint256 min, uint256 max, uint256 avg) {
+        uint256 count = _rateHistoryCount[_token];
+        require(count != 0, "no rate history");
+        if (count > _RATE_HISTORY_LEN) {
+            count = _RATE_HISTORY_LEN;
+        }
+        uint256 sum;
+        min = _rateHistory[_token][0];
+        for (uint256 i = 0; i < count; i++) {
+            uint256 rate = _rateHistory[_token][i];
+            if (rate < min) {
+                min = rate;
+            }
+            if (rate > max) {
+                max = rate;
+            }
+            sum = sum.add(rate);
+        }
+        return (min, max, sum / count);
+    }
+
+    /// @notice Drops all but the most recent rates of a token from its history, freeing the storage of the others.
+    /// @param _token is the address of the token.
+    /// @param _keepLast is the number of rates to keep, it must be lower than the number of rates kept so far.
+    function pruneHistory(address _token, uint256 _keepLast) external onlyController {
+        uint256 count = _rateHistoryCount[_token];
+        uint256 n = count;
+        if (n > _RATE_HISTORY_LEN) {
+            n = _RATE_HISTORY_LEN;
+        }
+        require(_keepLast < n, "nothing to prune");
+        // Move the kept rates to the front of the buffer, oldest first, so the next rate is recorded after them.
+        uint256[_RATE_HISTORY_LEN] memory kept;
+        for (uint256 i = 0; i < _keepLast; i++) {
+            kept[i] = _rateHistory[_token][(count - _keepLast + i) % _RATE_HISTORY_LEN];
+        }
+        for (uint256 i = 0; i < n; i++) {
+            if (i < _keepLast) {
+                _rateHistory[_token][i] = kept[i];
+            } else {
+                delete _rateHistory[_token][i];
+            }
+        }
+        _rateHistoryCount[_token] = _keepLast;
+        emit PrunedHistory(msg.sender, _token, _keepLast);
+    }
+
+    /// @notice Averages the recorded rates of a token giving more weight to the recent ones, e.g. to smooth a chart.
+    /// @dev The weights are linear: of the n rates kept, the most recent weighs n, the one before n - 1 and the oldest 1.
+    /// @param _token is the address of the token.
+    /// @return the weighted average in wei of one whole token, rounded down.
+    function rateRecencyWeighted(address _token) external view returns (uint256) {
+        uint256 count = _rateHistoryCount[_token];
+        require(count != 0, "no rate history");
+        uint256 n = count;
+        if (n > _RATE_HISTORY_LEN) {
+            n = _RATE_HISTORY_LEN;
+        }
+        uint256 sum;
+        uint256 weights;
+        for (uint256 i = 0; i < n; i++) {
+            // Walk back from the most recent rate, which was stored at (count - 1) % _RATE_HISTORY_LEN.
+            uint256 rate = _rateHistory[_token][(count - 1 - i) % _RATE_HISTORY_LEN];
+            sum = sum.add(rate.mul(n - i));
+            weights = weights.add(n - i);
+        }
+        return sum / weights;
+    }
+
+    /// @notice Measures how much the rate of a token changed with its last update, e.g. to show it next to a chart.
+    /// @param _token is the address of the token.
+    /// @return the change from the previous to the most recent rate of its history in basis points of the previous one,
+    /// rounded towards zero, or 0 if fewer than two rates are kept or the previous one is 0.
+    function rateChangeBps(address _token) external view returns (int256) {
+        uint256 count = _rateHistoryCount[_token];
+        if (count < 2) {
+            return 0;
+        }
+        uint256 current = _rateHistory[_token][(count - 1) % _RATE_HISTORY_LEN];
+        uint256 previous = _rateHistory[_token][(count - 2) % _RATE_HISTORY_LEN];
+        if (previous == 0) {
+            return 0;
+        }
+        if (current >= previous) {
+            return int256((current - previous).mul(10000).div(previous));
+        }
+        return -int256((previous - current).mul(10000).div(previous));
+    }
+
+    /// @notice Reports which of the whitelisted tokens have a stale rate.
+    /// @return the whitelisted token addresses.
+    /// @return whether each token's rate is older than maxRateAge.
+    function stalenessReport() external view returns (address[] memory, bool[] memory) {
+        address[] memory tokens = _tokenAddressArray();
+        bool[] memory stale = new bool[](tokens.length);
+        for (uint256 i = 0; i < tokens.length; i++) {
+            (, , , , , , uint256 lastUpdate) = _getTokenInfo(tokens[i]);
+            stale[i] = _isRateStale(lastUpdate);
+        }
+        return (tokens, stale);
+    }
+
+    /// @notice Counts the whitelisted tokens with a stale rate, e.g. for a monitoring gauge.
+    /// @return the number of tokens whose rate is older than maxRateAge, always 0 if rates never go stale.
+    function staleTokenCount() external view returns (uint256) {
+        address[] memory tokens = _tokenAddressArray();
+        uint256 count;
+        for (uint256 i = 0; i < tokens.length; i++) {
+            (, , , , , , uint256 lastUpdate) = _getTokenInfo(tokens[i]);
+            if (_isRateStale(lastUpdate)) {
+                count++;
+            }
+        }
+        return count;
+    }
+
+    /// @notice Returns when the rate of a token is due for an update, e.g. for keepers scheduling the updates.
+    /// @param _token is the address of a whitelisted token.
+    /// @return the update interval in seconds i.e. maxRateAge, 0 if rates never go stale.
+    /// @return when the rate was last updated (unix timestamp), 0 if it never was.
+    /// @return when the rate goes stale (unix timestamp), 0 if rates never go stale.
+    function updateScheduleInfo(address _token) external view returns (uint256 interval, uint256 lastUpdate, uint256 nextDue) {
+        (, , , bool available, , , uint256 lastUpdateDate) = _getTokenInfo(_token);
+        require(available, "token not available");
+        uint256 updatedAt = _dateToTimestamp(lastUpdateDate);
+        if (maxRateAge == 0) {
+            return (0, updatedAt, 0);
+        }
+        return (maxRateAge, updatedAt, updatedAt.add(maxRateAge));
+    }
+
     /// @notice Update ERC20 token exchange rates for all supported tokens.
     /// @param _gasLimit the gas limit is passed, this is used for the Oraclize callback
-    function updateTokenRates(uint256 _gasLimit) external payable onlyController {
+    function updateTokenRates(uint256 _gasLimit) external payable nonReentrant notInMaintenance onlyController {
         _updateTokenRates(_gasLimit);
     }
 
     /// @notice Update ERC20 token exchange rates for the list of tokens provided.
     /// @param _gasLimit the gas limit is passed, this is used for the Oraclize callback
     /// @param _tokenList the list of tokens that need to be updated
-    function updateTokenRatesList(uint256 _gasLimit, address[] calldata _tokenList) external payable onlyController {
+    function updateTokenRatesList(uint256 _gasLimit, address[] calldata _tokenList) external payable nonReentrant notInMaintenance onlyController {
         _updateTokenRatesList(_gasLimit, _tokenList);
     }
 
@@ -121,7 +425,7 @@ contract Oracle is ENSResolvable, usingOraclize, Transferrable, Base64, Date, Co
     /// @param _result query result in JSON format.
     /// @param _proof origin proof from crypto compare.
     // solium-disable-next-line mixedcase
-    function __callback(bytes32 _queryID, string memory _result, bytes memory _proof) public {
+    function __callback(bytes32 _queryID, string memory _result, bytes memory _proof) public nonReentrant notInMaintenance {
         // Require that the caller is the Oraclize contract.
         require(msg.sender == oraclize_cbAddress(), "sender is not oraclize");
         // Use the query ID to find the matching token address.
@@ -144,7 +448,42 @@ contract Oracle is ENSResolvable, usingOraclize, Transferrable, Base64, Date, Co
             delete _queryToToken[_queryID];
 
             _updateTokenRate(token, parsedRate, parsedLastUpdate);
+            _recordRate(token, parsedRate);
+        }
+    }
+
+    /// @notice Adds the rate to the token's rate history, replacing the oldest one once the history is full.
+    function _recordRate(address _token, uint256 _rate) internal {
+        uint256 count = _rateHistoryCount[_token];
+        _rateHistory[_token][count % _RATE_HISTORY_LEN] = _rate;
+        _rateHistoryCount[_token] = count.add(1);
+    }
+
+    /// @notice Checks whether a rate updated at the given date is older than maxRateAge.
+    /// @param _lastUpdate is the last update date of the rate e.g. 20180913153211.
+    function _isRateStale(uint256 _lastUpdate) internal view returns (bool) {
+        if (maxRateAge == 0) {
+            return false;
+        }
+        return now > _dateToTimestamp(_lastUpdate).add(maxRateAge);
+    }
+
+    /// @notice Converts the rates of two whitelisted tokens, both expressed in wei, into a rate between them.
+    /// @param _base is the address of the quoted token.
+    /// @param _quote is the address of the token the rate is expressed in or 0x0 for ether.
+    /// @return the value of one whole base token in base units of the quote asset.
+    function _crossRate(address _base, address _quote) internal view returns (uint256) {
+        (, , uint256 baseRate, bool baseAvailable, , , ) = _getTokenInfo(_base);
+        require(baseAvailable, "base token not available");
+        require(baseRate != 0, "base rate=0");
+        // The rate of a token is the value in wei of one whole token.
+        if (_quote == address(0)) {
+            return baseRate;
         }
+        (, uint256 quoteMagnitude, uint256 quoteRate, bool quoteAvailable, , , ) = _getTokenInfo(_quote);
+        require(quoteAvailable, "quote token not available");
+        require(quoteRate != 0, "quote rate=0");
+        return baseRate.mul(quoteMagnitude).div(quoteRate);
     }
 
     /// @notice Extracts JSON rate value from the response object.
@@ -98,6 +98,9 @@ contract TokenWhitelist is ENSResolvable, Controllable, Transferrable {
     /// @notice is registered ENS node identifying the oracle contract.
     bytes32 private _oracleNode;
 
+    /// @notice Is the account (an admin or the oracle) that last updated the rate of each token.
+    mapping(address => address) private _tokenLastUpdater;
+
     /// @notice Constructor initializes ENSResolvable, and Controllable.
     /// @param _ens_ is the ENS registry address.
     /// @param _oracleNode_ is the ENS node of the Oracle.
@@ -186,6 +189,7 @@ contract TokenWhitelist is ENSResolvable, Controllable, Transferrable {
             }
             // Delete the token object.
             delete _tokenInfoMap[token];
+            delete _tokenLastUpdater[token];
             // Remove the token address from the address list.
             for (uint256 j = 0; j < _tokenAddressArray.length.sub(1); j++) {
                 if (_tokenAddressArray[j] == token) {
@@ -269,7 +273,35 @@ contract TokenWhitelist is ENSResolvable, Controllable, Transferrable {
         _tokenInfoMap[_token].rate = _rate;
         // Update the token's last update timestamp.
         _tokenInfoMap[_token].lastUpdate = _updateDate;
-        // Emit the rate update event.
+        // Keep track of who updated the rate.
+        _tokenLastUpdater[_token] = msg.sender;
+        // Emit the rate update event, _sender is the updater.
+        emit UpdatedTokenRate(msg.sender, _token, _rate);
+    }
+
+    /// @notice Adds an ERC20 token along with its rate, so that it's never whitelisted without a rate.
+    /// @dev The token is neither loadable nor redeemable, setTokenLoadable and setTokenRedeemable can change that.
+    /// @param _token ERC20 token contract address.
+    /// @param _symbol ERC20 token name.
+    /// @param _magnitude 10 to the power of number of decimal places used by the token.
+    /// @param _rate ERC20 token exchange rate in wei.
+    /// @param _date is a unit representing an ISO datetime e.g. 20180913153211.
+    function configureToken(address _token, bytes32 _symbol, uint256 _magnitude, uint256 _rate, uint256 _date) external onlyAdmin {
+        // Require that the token isn't already available.
+        require(!_tokenInfoMap[_token].available, "token already available");
+        string memory symbol = _symbol.toSliceB32().toString();
+        _tokenInfoMap[_token] = Token({
+            symbol: symbol,
+            magnitude: _magnitude,
+            rate: _rate,
+            available: true,
+            loadable: false,
+            redeemable: false,
+            lastUpdate: _date
+        });
+        _tokenAddressArray.push(_token);
+        _tokenLastUpdater[_token] = msg.sender;
+        emit AddedToken(msg.sender, _token, symbol, _magnitude, false, false);
         emit UpdatedTokenRate(msg.sender, _token, _rate);
     }
 
@@ -314,6 +346,13 @@ contract TokenWhitelist is ENSResolvable, Controllable, Transferrable {
         );
     }
 
+    /// @notice This returns the account that last updated the rate of the given token.
+    /// @param _token ERC20 token contract address.
+    /// @return address of the admin or oracle, 0x0 if the rate was never updated.
+    function tokenLastUpdater(address _token) external view returns (address) {
+        return _tokenLastUpdater[_token];
+    }
+
     /// @notice This returns an array of all whitelisted token addresses.
     /// @return address[] of whitelisted tokens.
     function tokenAddressArray() external view returns (address[] memory) {
@@ -25,6 +25,7 @@ import "./internals/balanceable.sol";
 import "./internals/transferrable.sol";
 import "./internals/ensResolvable.sol";
 import "./internals/tokenWhitelistable.sol";
+import "./internals/bytesUtils.sol";
 import "./externals/SafeMath.sol";
 import "./externals/Address.sol";
 import "./externals/ECDSA.sol";
@@ -34,6 +35,14 @@ import "./externals/SafeERC20.sol";
 import "./externals/ERC165.sol";
 
 
+/// @title IDexAdapter is implemented by the exchanges the Wallet can auto-convert deposits with.
+interface IDexAdapter {
+    /// @dev Swaps _amount of the _from token, pulled from the sender with transferFrom, for the _to token sent to the sender.
+    /// @return the amount of the _to token sent.
+    function swap(address _from, address _to, uint256 _amount) external returns (uint256);
+}
+
+
 /// @title ControllableOwnable combines Controllable and Ownable
 /// @dev providing an additional modifier to check if Owner or Controller
 contract ControllableOwnable is Controllable, Ownable {
@@ -104,6 +113,7 @@ contract AddressWhitelist is ControllableOwnable, SelfCallableOwnable {
         delete _pendingWhitelistAddition;
         // Reset the submitted operation flag.
         submittedWhitelistAddition = false;
+        _clearedWhitelistAddition();
         // Emit the cancellation event.
         emit CancelledWhitelistAddition(msg.sender, _hash);
     }
@@ -138,6 +148,7 @@ contract AddressWhitelist is ControllableOwnable, SelfCallableOwnable {
                 whitelistMap[_pendingWhitelistAddition[i]] = true;
                 whitelistArray.push(_pendingWhitelistAddition[i]);
             }
+            _addedToWhitelist(_pendingWhitelistAddition[i]);
         }
         // Emit the addition event.
         emit AddedToWhitelist(msg.sender, _pendingWhitelistAddition);
@@ -145,6 +156,7 @@ contract AddressWhitelist is ControllableOwnable, SelfCallableOwnable {
         delete _pendingWhitelistAddition;
         // Reset the submission flag.
         submittedWhitelistAddition = false;
+        _clearedWhitelistAddition();
     }
 
     /// @dev Confirm pending removal of whitelisted addresses.
@@ -158,6 +170,7 @@ contract AddressWhitelist is ControllableOwnable, SelfCallableOwnable {
             // check if it exists
             if (whitelistMap[_pendingWhitelistRemoval[i]]) {
                 whitelistMap[_pendingWhitelistRemoval[i]] = false;
+                _removedFromWhitelist(_pendingWhitelistRemoval[i]);
                 for (uint256 j = 0; j < whitelistArray.length.sub(1); j++) {
                     if (whitelistArray[j] == _pendingWhitelistRemoval[i]) {
                         whitelistArray[j] = whitelistArray[whitelistArray.length - 1];
@@ -175,6 +188,15 @@ contract AddressWhitelist is ControllableOwnable, SelfCallableOwnable {
         submittedWhitelistRemoval = false;
     }
 
+    /// @dev Called for each address added to the whitelist, including the ones already whitelisted.
+    function _addedToWhitelist(address _address) internal;
+
+    /// @dev Called for each address removed from the whitelist.
+    function _removedFromWhitelist(address _address) internal;
+
+    /// @dev Called once a pending whitelist addition has been confirmed or cancelled.
+    function _clearedWhitelistAddition() internal;
+
     /// @dev Getter for pending addition array.
     function pendingWhitelistAddition() external view returns (address[] memory) {
         return _pendingWhitelistAddition;
@@ -199,6 +221,7 @@ contract AddressWhitelist is ControllableOwnable, SelfCallableOwnable {
                 // adds to the whitelist array
                 whitelistArray.push(_addresses[i]);
             }
+            _addedToWhitelist(_addresses[i]);
         }
         isSetWhitelist = true;
         // Emit the addition event.
@@ -207,7 +230,7 @@ contract AddressWhitelist is ControllableOwnable, SelfCallableOwnable {
 
     /// @dev Add addresses to the whitelist.
     /// @param _addresses are the Ethereum addresses to be whitelisted.
-    function submitWhitelistAddition(address[] calldata _addresses) external onlyOwnerOrSelf noActiveSubmission hasNoOwnerOrZeroAddress(_addresses) {
+    function submitWhitelistAddition(address[] memory _addresses) public onlyOwnerOrSelf noActiveSubmission hasNoOwnerOrZeroAddress(_addresses) {
         // Require that the whitelist has been initialized.
         require(isSetWhitelist, "whitelist not initialized");
         // Require this array of addresses not empty
@@ -285,7 +308,7 @@ library DailyLimitTrait {
 
     /// @dev Modify the spend limit and spend available based on the provided value.
     /// @dev _amount is the daily limit amount in wei.
-    function _modifyLimit(DailyLimit storage self, uint256 _amount) private {
+    function _modifyLimit(DailyLimit storage self, uint256 _amount) internal {
         // Account for the spend limit daily reset.
         _updateAvailableLimit(self);
         // Set the daily limit to the provided amount.
@@ -340,14 +363,18 @@ contract SpendLimit is ControllableOwnable, SelfCallableOwnable {
 
     /// @dev Confirm pending set daily limit operation.
     function confirmSpendLimitUpdate(uint256 _amount) external onlyController {
+        uint256 periodStart = _spendLimit.limitTimestamp;
         _spendLimit._confirmLimitUpdate(_amount);
+        _updatedSpendLimit(periodStart);
         emit SetSpendLimit(msg.sender, _amount);
     }
 
     /// @dev Sets the initial daily spend (aka transfer) limit for non-whitelisted addresses.
     /// @param _amount is the daily limit amount in wei.
     function setSpendLimit(uint256 _amount) external onlyOwnerOrSelf {
+        uint256 periodStart = _spendLimit.limitTimestamp;
         _spendLimit._setLimit(_amount);
+        _updatedSpendLimit(periodStart);
         emit SetSpendLimit(msg.sender, _amount);
     }
 
@@ -382,6 +409,10 @@ contract SpendLimit is ControllableOwnable, SelfCallableOwnable {
     function _initializeSpendLimit(uint256 _limit) internal initializer {
         _spendLimit = DailyLimitTrait.DailyLimit(_limit, _limit, now, 0, false);
     }
+
+    /// @dev Called once the spend limit has been updated, which resets it if its period has ended.
+    /// @param _periodStart is when the period of the spend limit started before the update.
+    function _updatedSpendLimit(uint256 _periodStart) internal;
 }
 
 
@@ -513,15 +544,44 @@ contract LoadLimit is ControllableOwnable, SelfCallableOwnable, TokenWhitelistab
 /// @title Asset wallet with extra security features, gas top up management and card integration.
 contract Wallet is ENSResolvable, AddressWhitelist, SpendLimit, GasTopUpLimit, LoadLimit, ERC165, Transferrable, Balanceable {
     using Address for address;
+    using BytesUtils for bytes;
     using ECDSA for bytes32;
     using SafeERC20 for ERC20;
     using SafeMath for uint256;
 
     event BulkTransferred(address _to, address[] _assets);
+    event ConfirmedLargeTransfer(bytes32 _id);
+    event DailySpendFinalized(uint256 _day, uint256 _totalSpent);
     event ExecutedRelayedTransaction(bytes _data, bytes _returndata);
     event ExecutedTransaction(address _destination, uint256 _value, bytes _data, bytes _returndata);
     event IncreasedRelayNonce(address _sender, uint256 _currentNonce);
+    event InitiatedTransfer(bytes32 _id, address _to, address _asset, uint256 _amount);
     event LoadedTokenCard(address _asset, uint256 _amount);
+    event RefundedRelayer(address _relayer, address _asset, uint256 _amount);
+    event SetConfirmThreshold(address _sender, uint256 _amount);
+    event SubmittedConfirmThresholdUpdate(uint256 _amount);
+    event SubmittedSpendLimitChange(uint256 _amount, uint256 _effectiveAt);
+    event SubmittedWhitelistAdditionExpiry(uint256 _expiresAt);
+    event SubmittedWhitelistForToken(address _to, address _token);
+    event SetRelayerRefundCap(address _sender, uint256 _cap);
+    event SetRelayerRefundToken(address _sender, address _token);
+    event ScheduledSpendLimitChange(address _sender, uint256 _amount, uint256 _effectiveAt);
+    event SetTokenLoadCap(address _sender, address _token, uint256 _cap);
+    event SweptDust(address[] _tokens, address _to, uint256 _maxPerToken);
+    event AddedWhitelistForToken(address _sender, address _to, address _token);
+    event CancelledWhitelistForToken(address _sender, address _to, address _token);
+    event AllowedCallSelector(address _sender, address _target, bytes4 _selector);
+    event DisallowedCallSelector(address _sender, address _target, bytes4 _selector);
+    event RemovedWhitelistForToken(address _sender, address _to, address _token);
+    event HeldDeposit(address _asset, uint256 _amount, uint256 _releaseAt);
+    event SetDepositHold(address _sender, uint256 _threshold, uint256 _holdPeriod);
+    event SetGasTopUpController(address _sender, bool _enabled);
+    event SetRemovalGracePeriod(address _sender, uint256 _gracePeriod);
+    event SetMaxPendingTransfers(address _sender, uint256 _maxPendingTransfers);
+    event SetLifetimeSpendCap(address _sender, uint256 _cap);
+    event SetAutoConvert(address _sender, address _token, address _dexAdapter, address _targetToken);
+    event AutoConverted(address _token, address _targetToken, uint256 _amount, uint256 _received);
+    event AutoConvertFailed(address _token, uint256 _amount);
     event ToppedUpGas(address _sender, address _owner, uint256 _amount);
     event Transferred(address _to, address _asset, uint256 _amount);
     event UpdatedAvailableLimit(); // This is here because our tests don't inherit events from a library
@@ -533,15 +593,153 @@ contract Wallet is ENSResolvable, AddressWhitelist, SpendLimit, GasTopUpLimit, L
     // keccak256("isValidSignature(bytes32,bytes)") = 1626ba7e356f5979dd355a3d2bfb43e80420a480c3b854edce286a82d7496869
     bytes4 private constant _EIP_1654 = 0x1626ba7e;
 
+    /// @dev The name of the EIP-712 signing domain, its version is the WALLET_VERSION.
+    string private constant _EIP712_DOMAIN_NAME = "Monolith";
+    bytes32 private constant _EIP712_DOMAIN_TYPEHASH = keccak256("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)");
+
+    /// @dev Gas available to each auto-conversion, so that a misbehaving exchange can't make recordDeposit run out of gas.
+    uint256 private constant _AUTO_CONVERT_GAS = 300000;
+
     /// @dev Supported ERC165 interface ID.
     bytes4 private constant _ERC165_INTERFACE_ID = 0x01ffc9a7; // solium-disable-line uppercase
 
+    /// @dev How long an initiated large transfer can be confirmed for.
+    uint256 private constant _LARGE_TRANSFER_WINDOW = 1 hours;
+
+    /// @dev The most large transfers that can await confirmation at once, it bounds the gas used to prune them.
+    uint256 private constant _MAX_PENDING_TRANSFERS = 20;
+
+    /// @dev Gas not visible to gasleft() that a relayer pays for, i.e. the intrinsic gas and the refund itself.
+    uint256 private constant _RELAYER_REFUND_GAS_OVERHEAD = 30000;
+
+    struct DepositHold {
+        uint256 amount;
+        uint256 releaseAt;
+    }
+
+    struct AutoConvert {
+        address dexAdapter;
+        address targetToken;
+    }
+
+    struct PendingTransfer {
+        address payable to;
+        address asset;
+        uint256 amount;
+        uint256 initiatedAt;
+    }
+
     /// @dev this is an internal nonce to prevent replay attacks from relayer
     uint256 public relayNonce;
 
     /// @dev Is the registered ENS node identifying the licence contract.
     bytes32 private _licenceNode;
 
+    /// @dev Transfers worth more than this (in wei) need to be initiated and confirmed, 0 disables it.
+    uint256 public confirmThreshold;
+
+    /// @dev Large transfers awaiting confirmation, keyed by their id.
+    mapping(bytes32 => PendingTransfer) private _pendingTransfers;
+
+    /// @dev Used to derive unique ids for initiated transfers.
+    uint256 private _initiatedTransferCount;
+
+    /// @dev Maximum refund paid per relayed transaction in base units of relayerRefundToken, 0 disables refunds.
+    uint256 public relayerRefundCap;
+
+    /// @dev Is the asset relayers are refunded in, 0x0 for ether.
+    address public relayerRefundToken;
+
+    /// @dev Maximum total amount of each token that can ever be loaded to the card in base units, 0 disables the cap.
+    /// @notice This is a lifetime cap, unlike the daily load limit it never resets.
+    mapping(address => uint256) public tokenLoadCap;
+
+    /// @dev Total amount of each token loaded to the card in base units since the wallet was deployed, it never resets.
+    mapping(address => uint256) public tokenLoaded;
+
+    /// @dev Deposits worth at least this (in wei) are held for depositHoldPeriod, 0 disables holds.
+    uint256 public depositHoldThreshold;
+
+    /// @dev How long (in seconds) large deposits can't be spent for.
+    uint256 public depositHoldPeriod;
+
+    /// @dev The recorded holds, keyed by asset.
+    mapping(address => DepositHold) private _depositHolds;
+
+    /// @dev The balance of each asset known to the wallet, anything above it is an unrecorded deposit.
+    mapping(address => uint256) private _accountedBalance;
+
+    /// @dev The spend limit scheduled by the owner and confirmed by the controller, applied on the next spend once _scheduledSpendLimitAt has passed.
+    uint256 private _scheduledSpendLimit;
+
+    /// @dev When the scheduled spend limit takes effect, 0 if there is no scheduled change.
+    uint256 private _scheduledSpendLimitAt;
+
+    /// @dev The function selectors executeTransaction may call on each target.
+    mapping(address => mapping(bytes4 => bool)) private _allowedCallSelectors;
+
+    /// @dev The number of allowed selectors of each target, targets without any accept every call.
+    mapping(address => uint256) private _allowedCallSelectorCount;
+
+    /// @dev The value in wei spent against the spend limit in its current period.
+    uint256 private _spentInPeriod;
+
+    /// @dev Caps the number of large transfers initiated with initiateTransfer() that await confirmation, 0 leaves _MAX_PENDING_TRANSFERS.
+    /// @notice Other pending operations, e.g. whitelist or spend limit submissions, aren't counted.
+    uint256 public maxPendingTransfers;
+
+    /// @dev The ids of the initiated transfers that may still be pending, confirmed and expired ones are pruned when a transfer is initiated or confirmed.
+    bytes32[] private _pendingTransferIds;
+
+    /// @dev Is set if only the controller can top up the owner's gas, e.g. for custodial setups.
+    bool public gasTopUpController;
+
+    /// @dev How long (in seconds) addresses removed from the whitelist are still treated as whitelisted.
+    uint256 public removalGracePeriod;
+
+    /// @dev When the removal of each address removed from the whitelist takes effect.
+    mapping(address => uint256) private _removalEffectiveAt;
+
+    /// @dev The exchange and target token recorded deposits of each token are converted with, if any.
+    mapping(address => AutoConvert) public autoConvert;
+
+    /// @dev Caps the total value in wei the wallet can ever spend, 0 disables the cap.
+    uint256 public lifetimeSpendCap;
+
+    /// @dev The total value in wei spent while a lifetime spend cap was set.
+    uint256 public lifetimeSpent;
+
+    /// @dev The tokens each destination is restricted to, it receives those as if it was whitelisted.
+    mapping(address => mapping(address => bool)) private _whitelistedForToken;
+
+    /// @dev The number of tokens each destination is restricted to, destinations without any receive every asset.
+    mapping(address => uint256) private _whitelistedTokenCount;
+
+    /// @dev When each whitelisted address stops being whitelisted, 0 if it doesn't expire.
+    mapping(address => uint256) public whitelistExpiry;
+
+    /// @dev The confirmation threshold submitted by the owner that is awaiting the controller's confirmation.
+    uint256 public confirmThresholdPending;
+
+    /// @dev Is set while confirmThresholdPending awaits confirmation, as 0 is a valid threshold.
+    bool public confirmThresholdUpdateSubmitted;
+
+    /// @dev The destination and token submitted by the owner with submitWhitelistForToken(), awaiting the controller's confirmation.
+    address private _pendingWhitelistForTokenTo;
+    address private _pendingWhitelistForToken;
+
+    /// @dev Is set while a token whitelisting awaits confirmation.
+    bool public submittedWhitelistForToken;
+
+    /// @dev When the addresses of the pending whitelist addition will expire, 0 if they won't.
+    uint256 public pendingWhitelistAdditionExpiry;
+
+    /// @dev The spend limit change submitted by the owner with scheduleLimitChange(), awaiting the controller's confirmation.
+    uint256 private _pendingScheduledSpendLimit;
+
+    /// @dev When the submitted spend limit change takes effect, 0 if no change has been submitted.
+    uint256 private _pendingScheduledSpendLimitAt;
+
     /// @dev Initializes the wallet top up limit and the vault contract.
     /// @param _owner_ is the owner account of the wallet contract.
     /// @param _transferable_ indicates whether the contract ownership can be transferred.
@@ -574,6 +772,16 @@ contract Wallet is ENSResolvable, AddressWhitelist, SpendLimit, GasTopUpLimit, L
         _;
     }
 
+    /// @dev Checks if the sender can top up the owner's gas: the controller, and the owner unless gasTopUpController is set.
+    modifier onlyGasTopUpAuthority() {
+        if (gasTopUpController) {
+            require(_isController(msg.sender), "sender is not a controller");
+        } else {
+            require(_isOwner(msg.sender) || _isController(msg.sender), "only owner||controller");
+        }
+        _;
+    }
+
     /// @dev This is a bulk transfer convenience function, used to migrate contracts.
     /// @notice If any of the transfers fail, this will revert.
     /// @param _to is the recipient's address, can't be the zero (0x0) address: transfer() will revert.
@@ -591,12 +799,46 @@ contract Wallet is ENSResolvable, AddressWhitelist, SpendLimit, GasTopUpLimit, L
         emit BulkTransferred(_to, _assets);
     }
 
+    /// @dev This function transfers the full balance of each token holding at most _maxPerToken, e.g. to tidy up dust.
+    /// @notice Tokens with a larger or empty balance are skipped, the transfers are daily limit protected like any other.
+    /// @param _tokens is an array of addresses of ERC20 tokens or 0x0 for ether.
+    /// @param _to is the recipient's address, can't be the zero (0x0) address: transfer() will revert.
+    /// @param _maxPerToken is the largest balance considered dust, in the token's base unit.
+    function sweepDust(address[] calldata _tokens, address payable _to, uint256 _maxPerToken) external onlyOwnerOrSelf {
+        require(_tokens.length != 0, "token array is empty");
+        for (uint256 i = 0; i < _tokens.length; i++) {
+            uint256 amount = _balance(_tokens[i]);
+            if (amount != 0 && amount <= _maxPerToken) {
+                transfer(_to, _tokens[i], amount);
+            }
+        }
+
+        emit SweptDust(_tokens, _to, _maxPerToken);
+    }
+
+    /// @dev Confirm a large transfer previously submitted with initiateTransfer().
+    /// @param _id is the id emitted in the InitiatedTransfer event.
+    function confirmLargeTransfer(bytes32 _id) external onlyOwnerOrSelf {
+        PendingTransfer memory pending = _pendingTransfers[_id];
+        // Require that the transfer has been initiated and not yet confirmed.
+        require(pending.initiatedAt != 0, "unknown transfer");
+        // Require that the confirmation window hasn't passed.
+        require(now <= pending.initiatedAt.add(_LARGE_TRANSFER_WINDOW), "confirmation expired");
+        delete _pendingTransfers[_id];
+        _prunePendingTransfers();
+
+        _transfer(pending.to, pending.asset, pending.amount);
+
+        emit ConfirmedLargeTransfer(_id);
+    }
+
     /// @dev This function allows for the controller to relay transactions on the owner's behalf,
     /// the relayed message has to be signed by the owner.
     /// @param _nonce only used for relayed transactions, must match the wallet's relayNonce.
     /// @param _data abi encoded data payload.
     /// @param _signature signed prefix + data.
     function executeRelayedTransaction(uint256 _nonce, bytes calldata _data, bytes calldata _signature) external onlyController {
+        uint256 startGas = gasleft();
         // Expecting prefixed data ("monolith:") indicating relayed transaction...
         // ...and an Ethereum Signed Message to protect user from signing an actual Tx
         uint256 id;
@@ -615,6 +857,314 @@ contract Wallet is ENSResolvable, AddressWhitelist, SpendLimit, GasTopUpLimit, L
         require(success, string(returndata));
 
         emit ExecutedRelayedTransaction(_data, returndata);
+
+        // Reimburse the relayer for the gas used, if enabled by the owner.
+        _refundRelayer(startGas);
+    }
+
+    /// @dev Holds deposits worth at least _threshold for _holdPeriod, to deter deposit-then-drain attacks.
+    /// @notice Deposits can't be observed by the wallet, so any unrecorded increase of a balance worth at least
+    /// the threshold is held until recordDeposit() is called, and then for _holdPeriod.
+    /// Balances already held when holds are enabled count as unrecorded deposits.
+    /// @param _threshold is the deposit value in wei from which deposits are held, 0 disables holds.
+    /// @param _holdPeriod is how long in seconds recorded deposits are held for.
+    function setDepositHold(uint256 _threshold, uint256 _holdPeriod) external onlyController {
+        depositHoldThreshold = _threshold;
+        depositHoldPeriod = _holdPeriod;
+        emit SetDepositHold(msg.sender, _threshold, _holdPeriod);
+    }
+
+    /// @dev Delays the effect of future whitelist removals, so payments already in flight to the addresses go through.
+    /// @param _gracePeriod is how long in seconds removed addresses stay whitelisted, 0 makes removals immediate.
+    function setRemovalGracePeriod(uint256 _gracePeriod) external onlyController {
+        removalGracePeriod = _gracePeriod;
+        emit SetRemovalGracePeriod(msg.sender, _gracePeriod);
+    }
+
+    /// @dev Returns when the removal of an address from the whitelist takes effect, 0 if it was never removed.
+    function removalEffectiveAt(address _address) external view returns (uint256) {
+        return _removalEffectiveAt[_address];
+    }
+
+    /// @dev Restricts gas top ups to the controller, or allows the owner to top up again.
+    /// @param _enabled is true if only the controller can top up the owner's gas.
+    function setGasTopUpController(bool _enabled) external onlyController {
+        gasTopUpController = _enabled;
+        emit SetGasTopUpController(msg.sender, _enabled);
+    }
+
+    /// @dev Caps the number of large transfers that can await confirmation at once.
+    /// @param _maxPendingTransfers is the largest number of pending large transfers, 0 leaves _MAX_PENDING_TRANSFERS.
+    function setMaxPendingTransfers(uint256 _maxPendingTransfers) external onlyController {
+        require(_maxPendingTransfers <= _MAX_PENDING_TRANSFERS, "cap too large");
+        maxPendingTransfers = _maxPendingTransfers;
+        emit SetMaxPendingTransfers(msg.sender, _maxPendingTransfers);
+    }
+
+    /// @dev Returns the number of initiated large transfers that can still be confirmed.
+    function pendingTransferCount() external view returns (uint256) {
+        uint256 count = 0;
+        for (uint256 i = 0; i < _pendingTransferIds.length; i++) {
+            if (_isPendingTransfer(_pendingTransferIds[i])) {
+                count++;
+            }
+        }
+        return count;
+    }
+
+    /// @dev Records the deposits of the asset made since the last call, starting their hold period.
+    /// @notice Anyone can call this, e.g. the backend upon seeing a deposit. Deposits of a token set to be
+    /// auto-converted are swapped instead of being held, they are left untouched if the swap fails.
+    /// @param _asset is the address of an ERC20 token or 0x0 for ETH.
+    function recordDeposit(address _asset) external {
+        uint256 deposited = _unrecordedDeposit(_asset);
+        _accountedBalance[_asset] = _balance(_asset);
+        // A converted deposit is accounted for by convertDeposit() on both sides.
+        if (_autoConvertDeposit(_asset, deposited)) {
+            return;
+        }
+        if (!_isHeldDeposit(_asset, deposited)) {
+            return;
+        }
+        DepositHold storage hold = _depositHolds[_asset];
+        if (now >= hold.releaseAt) {
+            hold.amount = 0;
+        }
+        hold.amount = hold.amount.add(deposited);
+        hold.releaseAt = now.add(depositHoldPeriod);
+        emit HeldDeposit(_asset, hold.amount, hold.releaseAt);
+    }
+
+    /// @dev Caps the total value the wallet can spend, e.g. for escrow wallets only ever disbursing a fixed total.
+    /// @notice Spends are only counted while a cap is set, whether or not the recipient is whitelisted.
+    /// @param _cap is the total value in wei, 0 disables the cap.
+    function setLifetimeSpendCap(uint256 _cap) external onlyController {
+        lifetimeSpendCap = _cap;
+        emit SetLifetimeSpendCap(msg.sender, _cap);
+    }
+
+    /// @dev Sets recorded deposits of a token to be converted to another one, e.g. altcoins to a stablecoin.
+    /// @notice The exchange must be whitelisted, as the deposits are handed to it outside of the spend limit.
+    /// It is trusted to give a fair rate, as anyone can trigger a conversion with recordDeposit().
+    /// @param _token is the address of the ERC20 token to convert.
+    /// @param _dexAdapter is the address of the IDexAdapter swapping the token, 0x0 disables the conversion.
+    /// @param _targetToken is the address of the ERC20 token to convert to.
+    function setAutoConvert(address _token, address _dexAdapter, address _targetToken) external onlyOwnerOrSelf {
+        require(_token != address(0), "token is 0x0");
+        if (_dexAdapter != address(0)) {
+            require(_targetToken != address(0) && _targetToken != _token, "invalid target token");
+            require(isWhitelisted(_dexAdapter), "exchange not whitelisted");
+        }
+        autoConvert[_token] = AutoConvert(_dexAdapter, _targetToken);
+        emit SetAutoConvert(msg.sender, _token, _dexAdapter, _targetToken);
+    }
+
+    /// @dev Swaps _amount of the token with its exchange, it can only be called by the wallet itself so that
+    /// the swap can be gas bounded and undone as a whole if it fails.
+    /// @param _token is the address of the ERC20 token to convert.
+    /// @param _amount is the amount of the token to convert in base units.
+    function convertDeposit(address _token, uint256 _amount) external {
+        require(msg.sender == address(this), "only self");
+        AutoConvert memory conversion = autoConvert[_token];
+        uint256 balanceBefore = _balance(conversion.targetToken);
+        ERC20(_token).safeApprove(conversion.dexAdapter, _amount);
+        IDexAdapter(conversion.dexAdapter).swap(_token, conversion.targetToken, _amount);
+        ERC20(_token).safeApprove(conversion.dexAdapter, 0);
+        uint256 received = _balance(conversion.targetToken).sub(balanceBefore);
+        require(received != 0, "nothing received");
+        // The proceeds of the conversion aren't a deposit, so they mustn't be held later on.
+        _accountOutflow(_token, _amount);
+        _accountedBalance[conversion.targetToken] = _accountedBalance[conversion.targetToken].add(received);
+        emit AutoConverted(_token, conversion.targetToken, _amount, received);
+    }
+
+    /// @dev Returns the part of the asset balance that is on hold and can't be spent yet.
+    /// @param _asset is the address of an ERC20 token or 0x0 for ETH.
+    function heldBalance(address _asset) public view returns (uint256) {
+        if (depositHoldThreshold == 0) {
+            return 0;
+        }
+        uint256 held;
+        DepositHold memory hold = _depositHolds[_asset];
+        if (now < hold.releaseAt) {
+            held = hold.amount;
+        }
+        uint256 deposited = _unrecordedDeposit(_asset);
+        if (_isHeldDeposit(_asset, deposited)) {
+            held = held.add(deposited);
+        }
+        uint256 balance = _balance(_asset);
+        return held > balance ? balance : held;
+    }
+
+    /// @dev Converts a deposit of the asset if it's set to be auto-converted.
+    /// @return true if the deposit was converted.
+    function _autoConvertDeposit(address _asset, uint256 _amount) internal returns (bool) {
+        if (_amount == 0 || autoConvert[_asset].dexAdapter == address(0)) {
+            return false;
+        }
+        // Make sure that the conversion gets all of its gas, so that it can't be made to fail on purpose.
+        require(gasleft() > _AUTO_CONVERT_GAS.mul(64).div(63), "not enough gas to convert");
+        (bool success, ) = address(this).call.gas(_AUTO_CONVERT_GAS)(abi.encodeWithSelector(this.convertDeposit.selector, _asset, _amount));
+        if (!success) {
+            emit AutoConvertFailed(_asset, _amount);
+        }
+        return success;
+    }
+
+    /// @dev Returns how much the balance of the asset grew since it was last recorded.
+    function _unrecordedDeposit(address _asset) internal view returns (uint256) {
+        uint256 balance = _balance(_asset);
+        if (balance <= _accountedBalance[_asset]) {
+            return 0;
+        }
+        return balance - _accountedBalance[_asset];
+    }
+
+    /// @dev Returns true if a deposit of _amount of the asset is large enough to be held.
+    function _isHeldDeposit(address _asset, uint256 _amount) internal view returns (bool) {
+        if (depositHoldThreshold == 0 || _amount == 0) {
+            return false;
+        }
+        return _etherValue(_asset, _amount) >= depositHoldThreshold;
+    }
+
+    /// @dev Requires _amount of the asset not to be on hold and accounts for it leaving the wallet.
+    function _spendUnheld(address _asset, uint256 _amount) internal {
+        require(!_exceedsUnheldBalance(_asset, _amount), "balance on hold");
+        _accountOutflow(_asset, _amount);
+    }
+
+    /// @dev Returns true if _amount of the asset is more than the part of its balance that isn't on hold.
+    function _exceedsUnheldBalance(address _asset, uint256 _amount) internal view returns (bool) {
+        return _amount > _balance(_asset).sub(heldBalance(_asset));
+    }
+
+    /// @dev Counts _amount of the asset against the lifetime spend cap, if one is set.
+    function _accountLifetimeSpend(address _asset, uint256 _amount) internal {
+        if (lifetimeSpendCap == 0 || _amount == 0) {
+            return;
+        }
+        uint256 etherValue = _etherValue(_asset, _amount);
+        require(!_exceedsLifetimeCap(etherValue), "lifetime cap reached");
+        lifetimeSpent = lifetimeSpent.add(etherValue);
+    }
+
+    /// @dev Returns true if spending _etherValue more would go over the lifetime spend cap, if one is set.
+    function _exceedsLifetimeCap(uint256 _etherValue) internal view returns (bool) {
+        return lifetimeSpendCap != 0 && lifetimeSpent.add(_etherValue) > lifetimeSpendCap;
+    }
+
+    /// @dev Lowers the known balance of the asset so that later deposits aren't hidden by the outflow.
+    function _accountOutflow(address _asset, uint256 _amount) internal {
+        if (_accountedBalance[_asset] > _amount) {
+            _accountedBalance[_asset] -= _amount;
+        } else {
+            _accountedBalance[_asset] = 0;
+        }
+    }
+
+    /// @dev Submits a change of the daily spend limit at a future time, e.g. an increase once KYC completes.
+    /// @notice The change has to be confirmed by the controller with confirmLimitChange(). It then applies lazily:
+    /// views reflect it as soon as _effectiveAt has passed and it is stored on the next spend.
+    /// @param _newLimit is the daily spend limit in wei.
+    /// @param _effectiveAt is the timestamp from which the new limit applies.
+    function scheduleLimitChange(uint256 _newLimit, uint256 _effectiveAt) external onlyOwnerOrSelf {
+        require(_effectiveAt > now, "effective time in the past");
+        _pendingScheduledSpendLimit = _newLimit;
+        _pendingScheduledSpendLimitAt = _effectiveAt;
+        emit SubmittedSpendLimitChange(_newLimit, _effectiveAt);
+    }
+
+    /// @dev Confirms the spend limit change submitted by the owner, it replaces any previously scheduled change.
+    /// @dev This will only ever be applied post 2FA, by one of the Controllers
+    /// @param _newLimit is the submitted daily spend limit in wei.
+    /// @param _effectiveAt is the submitted effective time.
+    function confirmLimitChange(uint256 _newLimit, uint256 _effectiveAt) external onlyController {
+        require(_pendingScheduledSpendLimitAt != 0, "no pending submission");
+        require(_pendingScheduledSpendLimit == _newLimit && _pendingScheduledSpendLimitAt == _effectiveAt, "confirmed/submitted limit change mismatch");
+        delete _pendingScheduledSpendLimit;
+        delete _pendingScheduledSpendLimitAt;
+        _scheduledSpendLimit = _newLimit;
+        _scheduledSpendLimitAt = _effectiveAt;
+        emit ScheduledSpendLimitChange(msg.sender, _newLimit, _effectiveAt);
+    }
+
+    /// @dev Returns the spend limit change awaiting the controller's confirmation.
+    /// @return the new daily spend limit in wei.
+    /// @return the timestamp from which it would apply, 0 if no change has been submitted.
+    function pendingLimitChange() external view returns (uint256, uint256) {
+        return (_pendingScheduledSpendLimit, _pendingScheduledSpendLimitAt);
+    }
+
+    /// @dev Returns the scheduled spend limit change that hasn't been applied yet.
+    /// @return the new daily spend limit in wei.
+    /// @return the timestamp from which it applies, 0 if no change is scheduled.
+    function scheduledLimitChange() external view returns (uint256, uint256) {
+        return (_scheduledSpendLimit, _scheduledSpendLimitAt);
+    }
+
+    /// @dev View your available limit, accounting for a scheduled change that is due.
+    function spendLimitAvailable() external view returns (uint256) {
+        return _spendLimitAvailable();
+    }
+
+    /// @dev Returns the available limit, accounting for a scheduled change that is due.
+    function _spendLimitAvailable() internal view returns (uint256) {
+        uint256 available = _spendLimit._getAvailableLimit();
+        if (!_isScheduledLimitChangeDue()) {
+            return available;
+        }
+        // Mirror _modifyLimit(): the available limit is capped by the new limit.
+        if (available > _scheduledSpendLimit) {
+            return _scheduledSpendLimit;
+        }
+        return available;
+    }
+
+    /// @dev View the daily spend limit, accounting for a scheduled change that is due.
+    function spendLimitValue() external view returns (uint256) {
+        if (_isScheduledLimitChangeDue()) {
+            return _scheduledSpendLimit;
+        }
+        return _spendLimit.value;
+    }
+
+    /// @dev Returns true if a scheduled spend limit change has reached its effective time.
+    function _isScheduledLimitChangeDue() internal view returns (bool) {
+        return _scheduledSpendLimitAt != 0 && now >= _scheduledSpendLimitAt;
+    }
+
+    /// @dev Uses up _amount of the daily spend limit.
+    function _enforceSpendLimit(uint256 _amount) internal {
+        uint256 periodStart = _spendLimit.limitTimestamp;
+        _spendLimit._enforceLimit(_amount);
+        _updatedSpendLimit(periodStart);
+        _spentInPeriod = _spentInPeriod.add(_amount);
+    }
+
+    /// @dev Reports the total spent in the previous period (identified by the time it started) if the limit has just
+    /// @dev been reset, periods without spends aren't reported.
+    function _updatedSpendLimit(uint256 _periodStart) internal {
+        if (_spendLimit.limitTimestamp == _periodStart || _spentInPeriod == 0) {
+            return;
+        }
+        emit DailySpendFinalized(_periodStart, _spentInPeriod);
+        _spentInPeriod = 0;
+    }
+
+    /// @dev Stores the scheduled spend limit change once it is due.
+    function _applyScheduledLimitChange() internal {
+        if (!_isScheduledLimitChangeDue()) {
+            return;
+        }
+        uint256 newLimit = _scheduledSpendLimit;
+        delete _scheduledSpendLimit;
+        delete _scheduledSpendLimitAt;
+        uint256 periodStart = _spendLimit.limitTimestamp;
+        _spendLimit._modifyLimit(newLimit);
+        _updatedSpendLimit(periodStart);
+        emit SetSpendLimit(msg.sender, newLimit);
     }
 
     /// @dev This returns the balance of the contract for any ERC20 token or ETH.
@@ -623,11 +1173,40 @@ contract Wallet is ENSResolvable, AddressWhitelist, SpendLimit, GasTopUpLimit, L
         return _balance(_asset);
     }
 
+    /// @dev First step of a transfer that is above the confirmation threshold.
+    /// @param _to is the recipient's address.
+    /// @param _asset is the address of an ERC20 token or 0x0 for ether.
+    /// @param _amount is the amount of assets to be transferred in base units.
+    /// @return the id that has to be passed to confirmLargeTransfer().
+    function initiateTransfer(address payable _to, address _asset, uint256 _amount) external onlyOwnerOrSelf isNotZero(_amount) returns (bytes32) {
+        // Checks if the _to address is not the zero-address
+        require(_to != address(0), "destination=0");
+        _prunePendingTransfers();
+        uint256 cap = maxPendingTransfers == 0 ? _MAX_PENDING_TRANSFERS : maxPendingTransfers;
+        require(_pendingTransferIds.length < cap, "too many pending transfers");
+        bytes32 id = keccak256(abi.encodePacked(address(this), _initiatedTransferCount, _to, _asset, _amount));
+        _initiatedTransferCount++;
+        _pendingTransfers[id] = PendingTransfer(_to, _asset, _amount, now);
+        _pendingTransferIds.push(id);
+
+        emit InitiatedTransfer(id, _to, _asset, _amount);
+        return id;
+    }
+
     /// @dev This allows the user to cancel a transaction that was unexpectedly delayed by the relayer
     function increaseRelayNonce() external onlyOwner {
         _increaseRelayNonce();
     }
 
+    /// @dev Cancels the relayed transaction signed with _nonce, e.g. if it's stuck or was signed by mistake.
+    /// @notice The relayNonce is sequential, so pending relayed transactions signed with a lower nonce are cancelled too.
+    /// @param _nonce is the nonce the cancelled transaction was signed with.
+    function cancelMetaTx(uint256 _nonce) external onlyOwner {
+        require(_nonce >= relayNonce, "nonce already used");
+        relayNonce = _nonce.add(1);
+        emit IncreasedRelayNonce(msg.sender, relayNonce);
+    }
+
     /// @dev This bumps the relayNonce and emits an event accordingly
     function _increaseRelayNonce() internal {
         relayNonce++;
@@ -646,6 +1225,17 @@ contract Wallet is ENSResolvable, AddressWhitelist, SpendLimit, GasTopUpLimit, L
         return _EIP_1271;
     }
 
+    /// @dev Returns the EIP-712 domain separator of the wallet, for signature based features.
+    /// @notice It's computed on every call rather than cached, as the wallet is deployed behind a proxy and
+    /// the separator must change with the chain, e.g. after a fork.
+    function DOMAIN_SEPARATOR() external view returns (bytes32) {
+        uint256 id;
+        assembly {
+            id := chainid()
+        }
+        return keccak256(abi.encode(_EIP712_DOMAIN_TYPEHASH, keccak256(bytes(_EIP712_DOMAIN_NAME)), keccak256(bytes(WALLET_VERSION)), id, address(this)));
+    }
+
     /// @return licence contract node registered in ENS.
     function licenceNode() external view returns (bytes32) {
         return _licenceNode;
@@ -658,10 +1248,16 @@ contract Wallet is ENSResolvable, AddressWhitelist, SpendLimit, GasTopUpLimit, L
     function loadTokenCard(address _asset, uint256 _amount) external payable onlyOwnerOrSelf {
         // check if token is allowed to be used for loading the card
         require(_isTokenLoadable(_asset), "token not loadable");
+        // Keep track of the total loaded and check it against the token's load cap if set.
+        tokenLoaded[_asset] = tokenLoaded[_asset].add(_amount);
+        require(tokenLoadCap[_asset] == 0 || tokenLoaded[_asset] <= tokenLoadCap[_asset], "load cap exceeded");
         // Convert token amount to stablecoin value.
         uint256 stablecoinValue = convertToStablecoin(_asset, _amount);
         // Check against the daily spent limit and update accordingly, require that the value is under remaining limit.
         _loadLimit._enforceLimit(stablecoinValue);
+        _accountLifetimeSpend(_asset, _amount);
+        // Deposits on hold can't be loaded either.
+        _spendUnheld(_asset, _amount);
         // Get the TKN licenceAddress from ENS
         address licenceAddress = _ensResolve(_licenceNode);
         if (_asset != address(0)) {
@@ -674,6 +1270,206 @@ contract Wallet is ENSResolvable, AddressWhitelist, SpendLimit, GasTopUpLimit, L
         emit LoadedTokenCard(_asset, _amount);
     }
 
+    /// @dev Sets the value above which transfers need a second confirmation.
+    /// @notice Lowering or enabling the threshold applies immediately, raising or disabling it has to be confirmed by the controller.
+    /// @param _amount is the threshold in wei, 0 disables the confirmation step.
+    function setConfirmThreshold(uint256 _amount) external onlyOwnerOrSelf {
+        if (_amount != 0 && (confirmThreshold == 0 || _amount <= confirmThreshold)) {
+            confirmThreshold = _amount;
+            // A stricter threshold supersedes any weaker one awaiting confirmation.
+            delete confirmThresholdPending;
+            delete confirmThresholdUpdateSubmitted;
+            emit SetConfirmThreshold(msg.sender, _amount);
+        } else {
+            confirmThresholdPending = _amount;
+            confirmThresholdUpdateSubmitted = true;
+            emit SubmittedConfirmThresholdUpdate(_amount);
+        }
+    }
+
+    /// @dev Confirms the confirmation threshold submitted by the owner.
+    /// @dev This will only ever be applied post 2FA, by one of the Controllers
+    /// @param _amount is the submitted threshold in wei.
+    function confirmConfirmThresholdUpdate(uint256 _amount) external onlyController {
+        require(confirmThresholdUpdateSubmitted, "no threshold update submitted");
+        require(confirmThresholdPending == _amount, "confirmed/submitted threshold mismatch");
+        confirmThreshold = _amount;
+        delete confirmThresholdPending;
+        delete confirmThresholdUpdateSubmitted;
+        emit SetConfirmThreshold(msg.sender, _amount);
+    }
+
+    /// @dev Sets the maximum refund paid to the relayer of a relayed transaction.
+    /// @param _cap is in base units of relayerRefundToken, 0 disables relayer refunds.
+    function setRelayerRefundCap(uint256 _cap) external onlyOwnerOrSelf {
+        relayerRefundCap = _cap;
+        emit SetRelayerRefundCap(msg.sender, _cap);
+    }
+
+    /// @dev Sets the asset relayers are refunded in.
+    /// @param _token is the address of an ERC20 token or 0x0 for ether.
+    function setRelayerRefundToken(address _token) external onlyOwnerOrSelf {
+        relayerRefundToken = _token;
+        emit SetRelayerRefundToken(msg.sender, _token);
+    }
+
+    /// @dev Caps the total amount of a token that can be loaded to the card over the wallet's lifetime.
+    /// @notice tokenLoaded never resets: once it reaches the cap the token can only be loaded again if the cap is raised or disabled.
+    /// @param _token is the address of an ERC20 token or 0x0 for ether.
+    /// @param _cap is in base units of the token, 0 disables the cap.
+    function setTokenLoadCap(address _token, uint256 _cap) external onlyController {
+        tokenLoadCap[_token] = _cap;
+        emit SetTokenLoadCap(msg.sender, _token, _cap);
+    }
+
+    /// @dev Allows executeTransaction to call the given function on the target, once a target has an allowed
+    /// @dev selector any call to a selector that isn't allowed is rejected.
+    /// @param _target is the address of the called contract.
+    /// @param _selector is the function selector, i.e. the first 4 bytes of the call data.
+    function allowCallSelector(address _target, bytes4 _selector) external onlyController {
+        require(!_allowedCallSelectors[_target][_selector], "selector already allowed");
+        _allowedCallSelectors[_target][_selector] = true;
+        _allowedCallSelectorCount[_target] = _allowedCallSelectorCount[_target].add(1);
+        emit AllowedCallSelector(msg.sender, _target, _selector);
+    }
+
+    /// @dev Removes the given function from the selectors allowed on the target.
+    /// @param _target is the address of the called contract.
+    /// @param _selector is the function selector.
+    function disallowCallSelector(address _target, bytes4 _selector) external onlyOwnerOrController {
+        require(_allowedCallSelectors[_target][_selector], "selector not allowed");
+        _allowedCallSelectors[_target][_selector] = false;
+        _allowedCallSelectorCount[_target] = _allowedCallSelectorCount[_target].sub(1);
+        emit DisallowedCallSelector(msg.sender, _target, _selector);
+    }
+
+    /// @dev Returns whether executeTransaction can call the given function on the target.
+    function isCallSelectorAllowed(address _target, bytes4 _selector) public view returns (bool) {
+        return _allowedCallSelectorCount[_target] == 0 || _allowedCallSelectors[_target][_selector];
+    }
+
+    /// @dev Submits addresses to be whitelisted until the given time, e.g. for temporary counterparties.
+    /// @notice The addition has to be confirmed with confirmWhitelistAddition() like any other. Confirming it makes
+    /// already whitelisted addresses expire too, expired addresses stay in the whitelist array until they are removed.
+    /// @param _addresses are the Ethereum addresses to be whitelisted.
+    /// @param _expiresAt is the time (unix timestamp) the addresses stop being whitelisted at.
+    function submitWhitelistAdditionWithExpiry(address[] calldata _addresses, uint256 _expiresAt) external {
+        require(_expiresAt > now, "expiry in the past");
+        submitWhitelistAddition(_addresses);
+        pendingWhitelistAdditionExpiry = _expiresAt;
+        emit SubmittedWhitelistAdditionExpiry(_expiresAt);
+    }
+
+    /// @dev Submits the whitelisting of the destination for the given token only, to be confirmed by the controller.
+    /// @notice Once confirmed, other assets can no longer be sent to the destination.
+    /// @param _to is the destination address.
+    /// @param _token is the address of an ERC20 token or 0x0 for ether.
+    function submitWhitelistForToken(address _to, address _token) external onlyOwnerOrSelf {
+        require(!submittedWhitelistForToken, "token whitelist submission pending");
+        require(_to != address(0), "destination=0");
+        require(!_whitelistedForToken[_to][_token], "token already whitelisted");
+        _pendingWhitelistForTokenTo = _to;
+        _pendingWhitelistForToken = _token;
+        submittedWhitelistForToken = true;
+        emit SubmittedWhitelistForToken(_to, _token);
+    }
+
+    /// @dev Confirms the token whitelisting submitted by the owner.
+    /// @dev This will only ever be applied post 2FA, by one of the Controllers
+    /// @param _to is the submitted destination address.
+    /// @param _token is the submitted token address.
+    function confirmWhitelistForToken(address _to, address _token) external onlyController {
+        _clearWhitelistForToken(_to, _token);
+        _whitelistedForToken[_to][_token] = true;
+        _whitelistedTokenCount[_to] = _whitelistedTokenCount[_to].add(1);
+        emit AddedWhitelistForToken(msg.sender, _to, _token);
+    }
+
+    /// @dev Cancels the token whitelisting submitted by the owner.
+    /// @param _to is the submitted destination address.
+    /// @param _token is the submitted token address.
+    function cancelWhitelistForToken(address _to, address _token) external onlyOwnerOrController {
+        _clearWhitelistForToken(_to, _token);
+        emit CancelledWhitelistForToken(msg.sender, _to, _token);
+    }
+
+    /// @dev Returns the destination and token of the token whitelisting awaiting confirmation, if any.
+    function pendingWhitelistForToken() external view returns (address, address) {
+        return (_pendingWhitelistForTokenTo, _pendingWhitelistForToken);
+    }
+
+    /// @dev Removes the given token from the ones the destination is whitelisted for.
+    /// @param _to is the destination address.
+    /// @param _token is the address of an ERC20 token or 0x0 for ether.
+    function removeWhitelistForToken(address _to, address _token) external onlyOwnerOrController {
+        require(_whitelistedForToken[_to][_token], "token not whitelisted");
+        _whitelistedForToken[_to][_token] = false;
+        _whitelistedTokenCount[_to] = _whitelistedTokenCount[_to].sub(1);
+        emit RemovedWhitelistForToken(msg.sender, _to, _token);
+    }
+
+    /// @dev Returns whether the destination is whitelisted for the given token.
+    function isWhitelistedForToken(address _to, address _token) public view returns (bool) {
+        return _whitelistedForToken[_to][_token];
+    }
+
+    /// @dev Returns true if the destination can only receive the tokens it is whitelisted for.
+    function isTokenRestricted(address _to) public view returns (bool) {
+        return _whitelistedTokenCount[_to] != 0;
+    }
+
+    /// @dev Requires the submitted token whitelisting to match the given one and clears it.
+    function _clearWhitelistForToken(address _to, address _token) internal {
+        require(submittedWhitelistForToken, "no pending submission");
+        require(_to == _pendingWhitelistForTokenTo && _token == _pendingWhitelistForToken, "non-matching pending token whitelist");
+        delete _pendingWhitelistForTokenTo;
+        delete _pendingWhitelistForToken;
+        delete submittedWhitelistForToken;
+    }
+
+    /// @dev Reverts if the destination is restricted to tokens other than the given asset.
+    function _requireWhitelistedForToken(address _to, address _asset) internal view {
+        require(_isAllowedForToken(_to, _asset), "token not whitelisted for destination");
+    }
+
+    /// @dev Returns true if the destination isn't restricted to tokens other than the given asset.
+    function _isAllowedForToken(address _to, address _asset) internal view returns (bool) {
+        return !isTokenRestricted(_to) || _whitelistedForToken[_to][_asset];
+    }
+
+    /// @dev Returns whether transfer() would send _amount of the asset to the address and, if not, the reason of the
+    /// @dev first rule preventing it, which is the revert reason of the transfer.
+    /// @param _to is the recipient's address.
+    /// @param _asset is the address of an ERC20 token or 0x0 for ether.
+    /// @param _amount is the amount of assets to be transferred in base units.
+    function isSpendableTo(address _to, address _asset, uint256 _amount) external view returns (bool ok, string memory reason) {
+        if (_isLargeTransfer(_asset, _amount)) {
+            return (false, "confirmation required");
+        }
+        if (_to == address(0)) {
+            return (false, "destination=0");
+        }
+        if (!_isAllowedForToken(_to, _asset)) {
+            return (false, "token not whitelisted for destination");
+        }
+        uint256 etherValue = _etherValue(_asset, _amount);
+        if (!_isSpendLimitExempt(_to) && etherValue > _spendLimitAvailable()) {
+            return (false, "available<amount");
+        }
+        if (_exceedsLifetimeCap(etherValue)) {
+            return (false, "lifetime cap reached");
+        }
+        if (_exceedsUnheldBalance(_asset, _amount)) {
+            return (false, "balance on hold");
+        }
+        return (true, "");
+    }
+
+    /// @dev Returns true if the address is whitelisted or in the grace period of its removal.
+    function isWhitelisted(address _to) public view returns (bool) {
+        return _isWhitelistedAddress(_to) || now < _removalEffectiveAt[_to];
+    }
+
     /// @dev Checks for interface support based on ERC165.
     function supportsInterface(bytes4 _interfaceID) external view returns (bool) {
         return _interfaceID == _ERC165_INTERFACE_ID;
@@ -681,9 +1477,12 @@ contract Wallet is ENSResolvable, AddressWhitelist, SpendLimit, GasTopUpLimit, L
 
     /// @dev Refill owner's gas balance, revert if the transaction amount is too large
     /// @param _amount is the amount of ether to transfer to the owner account in wei.
-    function topUpGas(uint256 _amount) external isNotZero(_amount) onlyOwnerOrController {
+    function topUpGas(uint256 _amount) external isNotZero(_amount) onlyGasTopUpAuthority {
         // Check against the daily spent limit and update accordingly, require that the value is under remaining limit.
         _gasTopUpLimit._enforceLimit(_amount);
+        _accountLifetimeSpend(address(0), _amount);
+        // Deposits on hold can't be used for gas either.
+        _spendUnheld(address(0), _amount);
         // Then perform the transfer
         owner().transfer(_amount);
         // Emit the gas top up event.
@@ -782,23 +1581,44 @@ contract Wallet is ENSResolvable, AddressWhitelist, SpendLimit, GasTopUpLimit, L
     /// @param _value ETH amount in wei
     /// @param _data transaction payload binary
     function executeTransaction(address _destination, uint256 _value, bytes memory _data) public onlyOwnerOrSelf returns (bytes memory) {
+        // Targets with allowed selectors can only be called through those, plain transfers have no selector.
+        bytes4 selector;
+        if (_data.length >= 4) {
+            selector = _data._bytesToBytes4(0);
+        }
+        require(isCallSelectorAllowed(_destination, selector), "selector not allowed");
+        // Apply a due spend limit change before enforcing the limit.
+        _applyScheduledLimitChange();
         // If value is send across as a part of this executeTransaction, this will be sent to any payable
         // destination. As a result enforceLimit if destination is not whitelisted.
-        if (!whitelistMap[_destination]) {
-            _spendLimit._enforceLimit(_value);
+        if (!_isSpendLimitExempt(_destination)) {
+            _enforceSpendLimit(_value);
+        }
+        // Ether can't be sent to destinations restricted to other tokens.
+        if (_value != 0) {
+            _requireWhitelistedForToken(_destination, address(0));
         }
+        // Large amounts have to go through initiateTransfer() and confirmLargeTransfer().
+        require(!_isLargeTransfer(address(0), _value), "confirmation required");
+        _accountLifetimeSpend(address(0), _value);
+        // Deposits on hold can't be sent either.
+        _spendUnheld(address(0), _value);
         // Check if the destination is a Contract and it is one of our supported tokens
         if (address(_destination).isContract() && _isTokenAvailable(_destination)) {
             // to is the recipient's address and amount is the value to be transferred
             address to;
             uint256 amount;
             (to, amount) = _getERC20RecipientAndAmount(_destination, _data);
-            if (!whitelistMap[to]) {
+            _requireWhitelistedForToken(to, _destination);
+            require(!_isLargeTransfer(_destination, amount), "confirmation required");
+            if (!_isSpendLimitExempt(to)) {
                 // If the address (of the token contract, e.g) is not in the TokenWhitelist used by the convert method
                 // then etherValue will be zero
                 uint256 etherValue = convertToEther(_destination, amount);
-                _spendLimit._enforceLimit(etherValue);
+                _enforceSpendLimit(etherValue);
             }
+            _accountLifetimeSpend(_destination, amount);
+            _spendUnheld(_destination, amount);
             // use callOptionalReturn provided in SafeERC20 in case the ERC20 method
             // returns false instead of reverting!
             ERC20(_destination).callOptionalReturn(_data);
@@ -834,21 +1654,122 @@ contract Wallet is ENSResolvable, AddressWhitelist, SpendLimit, GasTopUpLimit, L
     /// @param _asset is the address of an ERC20 token or 0x0 for ether.
     /// @param _amount is the amount of assets to be transferred in base units.
     function transfer(address payable _to, address _asset, uint256 _amount) public onlyOwnerOrSelf isNotZero(_amount) {
+        // Large transfers have to go through initiateTransfer() and confirmLargeTransfer().
+        require(!_isLargeTransfer(_asset, _amount), "confirmation required");
+        _transfer(_to, _asset, _amount);
+    }
+
+    /// @dev Returns true if the transfer is worth more than the confirmation threshold.
+    function _isLargeTransfer(address _asset, uint256 _amount) internal view returns (bool) {
+        if (confirmThreshold == 0) {
+            return false;
+        }
+        return _etherValue(_asset, _amount) > confirmThreshold;
+    }
+
+    /// @dev Returns the ether value of _amount of the asset, which is the amount itself for ether.
+    function _etherValue(address _asset, uint256 _amount) internal view returns (uint256) {
+        if (_asset == address(0)) {
+            return _amount;
+        }
+        return convertToEther(_asset, _amount);
+    }
+
+    /// @dev Refunds the relayer the cost of the gas used since _startGas, up to relayerRefundCap.
+    /// @notice Refunds are bounded by the cap rather than the spend limit, the owner signs every relayed transaction.
+    function _refundRelayer(uint256 _startGas) internal {
+        if (relayerRefundCap == 0) {
+            return;
+        }
+        uint256 gasUsed = _startGas.sub(gasleft()).add(_RELAYER_REFUND_GAS_OVERHEAD);
+        uint256 refund = gasUsed.mul(tx.gasprice);
+        // Convert the ether cost to the refund token based on its exchange rate.
+        if (relayerRefundToken != address(0)) {
+            (, uint256 magnitude, uint256 rate, bool available, , , ) = _getTokenInfo(relayerRefundToken);
+            require(available, "token not available");
+            require(rate != 0, "rate=0");
+            refund = refund.mul(magnitude).div(rate);
+        }
+        if (refund > relayerRefundCap) {
+            refund = relayerRefundCap;
+        }
+        _accountLifetimeSpend(relayerRefundToken, refund);
+        _accountOutflow(relayerRefundToken, refund);
+        _safeTransfer(msg.sender, relayerRefundToken, refund);
+        emit RefundedRelayer(msg.sender, relayerRefundToken, refund);
+    }
+
+    /// @dev Returns true if the transfer has been initiated and can still be confirmed.
+    function _isPendingTransfer(bytes32 _id) internal view returns (bool) {
+        uint256 initiatedAt = _pendingTransfers[_id].initiatedAt;
+        return initiatedAt != 0 && now <= initiatedAt.add(_LARGE_TRANSFER_WINDOW);
+    }
+
+    /// @dev Returns true if spends to the destination don't count against the spend limit, i.e. it is whitelisted or
+    /// @dev restricted to the tokens it is whitelisted for, which callers check with _requireWhitelistedForToken.
+    function _isSpendLimitExempt(address _to) internal view returns (bool) {
+        return isTokenRestricted(_to) || isWhitelisted(_to);
+    }
+
+    /// @dev Returns true if the address is in the whitelist and hasn't expired.
+    function _isWhitelistedAddress(address _address) internal view returns (bool) {
+        uint256 expiry = whitelistExpiry[_address];
+        return whitelistMap[_address] && (expiry == 0 || now < expiry);
+    }
+
+    /// @dev Sets the expiry of an address added to the whitelist, addresses added without one are permanent.
+    function _addedToWhitelist(address _address) internal {
+        whitelistExpiry[_address] = pendingWhitelistAdditionExpiry;
+    }
+
+    /// @dev Resets the expiry of the pending whitelist addition.
+    function _clearedWhitelistAddition() internal {
+        delete pendingWhitelistAdditionExpiry;
+    }
+
+    /// @dev Starts the grace period of an address removed from the whitelist, which can't last beyond its expiry.
+    function _removedFromWhitelist(address _address) internal {
+        uint256 effectiveAt = now.add(removalGracePeriod);
+        uint256 expiry = whitelistExpiry[_address];
+        if (expiry != 0 && expiry < effectiveAt) {
+            effectiveAt = expiry;
+        }
+        _removalEffectiveAt[_address] = effectiveAt;
+        delete whitelistExpiry[_address];
+    }
+
+    /// @dev Drops the confirmed and expired transfers from _pendingTransferIds, along with the expired transfers themselves.
+    function _prunePendingTransfers() internal {
+        uint256 i = 0;
+        while (i < _pendingTransferIds.length) {
+            bytes32 id = _pendingTransferIds[i];
+            if (_isPendingTransfer(id)) {
+                i++;
+            } else {
+                delete _pendingTransfers[id];
+                _pendingTransferIds[i] = _pendingTransferIds[_pendingTransferIds.length - 1];
+                _pendingTransferIds.length--;
+            }
+        }
+    }
+
+    /// @dev Transfers the specified asset to the recipient's address, enforcing the daily spend limit.
+    function _transfer(address payable _to, address _asset, uint256 _amount) internal {
         // Checks if the _to address is not the zero-address
         require(_to != address(0), "destination=0");
+        // Apply a due spend limit change before enforcing the limit.
+        _applyScheduledLimitChange();
 
-        // If address is not whitelisted, take daily limit into account.
-        if (!whitelistMap[_to]) {
-            // initialize ether value in case the asset is ETH
-            uint256 etherValue = _amount;
-            // Convert token amount to ether value if asset is an ERC20 token.
-            if (_asset != address(0)) {
-                etherValue = convertToEther(_asset, _amount);
-            }
-            // Check against the daily spent limit and update accordingly
+        // Destinations restricted to some tokens receive those as if whitelisted, and nothing else.
+        _requireWhitelistedForToken(_to, _asset);
+        if (!_isSpendLimitExempt(_to)) {
+            // If address is not whitelisted, take daily limit into account.
             // Check against the daily spent limit and update accordingly, require that the value is under remaining limit.
-            _spendLimit._enforceLimit(etherValue);
+            _enforceSpendLimit(_etherValue(_asset, _amount));
         }
+        _accountLifetimeSpend(_asset, _amount);
+        // Deposits on hold can't be transferred.
+        _spendUnheld(_asset, _amount);
         // Transfer token or ether based on the provided address.
         _safeTransfer(_to, _asset, _amount);
         // Emit the transfer event.
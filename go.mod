@@ -12,6 +12,7 @@ require (
 	github.com/robertkrimen/otto v0.0.0-20170205013659-6a77b7cbc37d // indirect
 	github.com/tokencard/ethertest v0.9.0
 	golang.org/x/crypto v0.0.0-20200311171314-f7b00557c8c4
+	golang.org/x/sync v0.0.0-20181221193216-37e7f081c4d4
 	gopkg.in/sourcemap.v1 v1.0.5 // indirect
 )
 
@@ -0,0 +1,71 @@
+// Package alerts watches the chain for events operations need to act upon.
+package alerts
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/subscribe"
+)
+
+// FreezeEvent is the name of the event signalling a freeze. Wallets can't be frozen one by one, they are
+// frozen as a whole by stopping the controller they resolve through ENS, which then emits Stopped.
+const FreezeEvent = "Stopped"
+
+// ResubscribeDelay is how long WatchFreezes waits before subscribing again after the subscription failed.
+var ResubscribeDelay = time.Second
+
+// Client is the chain access required by WatchFreezes.
+type Client interface {
+	ethereum.LogFilterer
+	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Freeze is a FreezeEvent emitted by one of the watched contracts.
+type Freeze struct {
+	// Contract is the address of the contract that emitted the event, i.e. the stopped controller.
+	Contract common.Address
+	// Timestamp is the time of the block including the event.
+	Timestamp   uint64
+	BlockNumber uint64
+	TxHash      common.Hash
+}
+
+// WatchFreezes calls handler with every FreezeEvent of contractABI emitted by one of contracts from the next
+// block on, in order. Whenever the subscription fails it subscribes again after ResubscribeDelay, and the
+// events missed in the meantime are handled first. It blocks until ctx is done, or until the chain can't be read.
+func WatchFreezes(ctx context.Context, client Client, contractABI abi.ABI, contracts []common.Address, handler func(Freeze)) error {
+	event, ok := contractABI.Events[FreezeEvent]
+	if !ok {
+		return errors.Errorf("ABI has no %s event", FreezeEvent)
+	}
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "getting chain head")
+	}
+	query := ethereum.FilterQuery{
+		Addresses: contracts,
+		Topics:    [][]common.Hash{{event.ID()}},
+	}
+
+	return subscribe.Follow(ctx, client, query, head.Number.Uint64(), ResubscribeDelay, "freezes", func(l types.Log) error {
+		h, err := client.HeaderByHash(ctx, l.BlockHash)
+		if err != nil {
+			return errors.Wrap(err, "getting block header")
+		}
+		handler(Freeze{
+			Contract:    l.Address,
+			Timestamp:   h.Time,
+			BlockNumber: l.BlockNumber,
+			TxHash:      l.TxHash,
+		})
+		return nil
+	})
+}
@@ -0,0 +1,69 @@
+// Package amount tags integer amounts with the decimals they are scaled by, so the scale isn't lost
+// when they are passed around or logged.
+package amount
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+)
+
+// Scaled is an integer amount of base units with the number of decimals of one whole unit,
+// e.g. 1500000000000000000 with 18 decimals is 1.5 ETH.
+type Scaled struct {
+	Value    *big.Int
+	Decimals uint
+}
+
+// Raw returns a copy of the amount in base units.
+func (s Scaled) Raw() *big.Int {
+	if s.Value == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(s.Value)
+}
+
+// Human renders the amount in whole units without trailing zeros, e.g. "1.5".
+func (s Scaled) Human() string {
+	v := s.Raw()
+	sign := ""
+	if v.Sign() < 0 {
+		sign = "-"
+		v.Neg(v)
+	}
+	digits := v.String()
+	if s.Decimals == 0 {
+		return sign + digits
+	}
+	d := int(s.Decimals)
+	if len(digits) <= d {
+		digits = strings.Repeat("0", d-len(digits)+1) + digits
+	}
+	integral, fraction := digits[:len(digits)-d], strings.TrimRight(digits[len(digits)-d:], "0")
+	if fraction == "" {
+		return sign + integral
+	}
+	return sign + integral + "." + fraction
+}
+
+// String renders the amount in whole units followed by its decimals, e.g. "1.5 (18 dp)".
+func (s Scaled) String() string {
+	return fmt.Sprintf("%s (%d dp)", s.Human(), s.Decimals)
+}
+
+// DecimalsParser parses a number with the given decimals, as the ParseIntScientificExporter does.
+type DecimalsParser interface {
+	ParseIntScientificDecimals(opts *bind.CallOpts, _a string, _b *big.Int) (*big.Int, error)
+}
+
+// ParseDecimals parses the number with the given decimals on-chain and tags the result with them.
+func ParseDecimals(opts *bind.CallOpts, parser DecimalsParser, in string, decimals uint) (Scaled, error) {
+	v, err := parser.ParseIntScientificDecimals(opts, in, new(big.Int).SetUint64(uint64(decimals)))
+	if err != nil {
+		return Scaled{}, errors.Wrapf(err, "parsing %q with %d decimals", in, decimals)
+	}
+	return Scaled{Value: v, Decimals: decimals}, nil
+}
@@ -0,0 +1,61 @@
+// Package atblock pins contract reads to a single block so that a sequence of calls
+// sees one consistent state even as the chain advances.
+//
+// Reads of a pinned block need the node to still hold that block's state: once the block is
+// older than the node's state history (128 blocks on a default full node) an archive node is required.
+package atblock
+
+import (
+	"context"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// HeaderReader is the chain access required to resolve the latest block.
+type HeaderReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// PinLatest resolves the number of the latest block.
+func PinLatest(ctx context.Context, backend HeaderReader) (*big.Int, error) {
+	header, err := backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting latest header")
+	}
+	return header.Number, nil
+}
+
+// Caller is a bind.ContractCaller that performs every read at the same block,
+// regardless of the block number requested through bind.CallOpts.
+type Caller struct {
+	caller bind.ContractCaller
+	block  *big.Int
+}
+
+// NewCaller returns a Caller reading from caller at the given block.
+func NewCaller(caller bind.ContractCaller, block *big.Int) *Caller {
+	return &Caller{
+		caller: caller,
+		block:  new(big.Int).Set(block),
+	}
+}
+
+// Block returns the block all reads are pinned to.
+func (c *Caller) Block() *big.Int {
+	return new(big.Int).Set(c.block)
+}
+
+// CodeAt returns the code of the given account at the pinned block.
+func (c *Caller) CodeAt(ctx context.Context, contract common.Address, _ *big.Int) ([]byte, error) {
+	return c.caller.CodeAt(ctx, contract, c.block)
+}
+
+// CallContract executes the call at the pinned block.
+func (c *Caller) CallContract(ctx context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	return c.caller.CallContract(ctx, call, c.block)
+}
@@ -0,0 +1,134 @@
+// Package bindcheck detects Go bindings that are out of sync with the compiled contracts, e.g. because
+// a contract was changed without regenerating its binding with build.sh.
+package bindcheck
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/bindings/externals/ens"
+	"github.com/tokencard/contracts/v3/pkg/bindings/externals/upgradeability"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+)
+
+// The ways a binding can differ from its compiler artifacts.
+var (
+	ErrABIDrift = errors.New("ABI differs from the compiled contract")
+	ErrBinDrift = errors.New("bytecode differs from the compiled contract")
+)
+
+// Binding is a generated binding and the compiler artifacts it's generated from.
+type Binding struct {
+	// Artifact is the path of the artifacts relative to the build directory without extension, e.g. wallet/Wallet.
+	Artifact string
+	ABI      string
+	Bin      string
+}
+
+// Bindings are the bindings generated by build.sh.
+var Bindings = []Binding{
+	{"wallet/Wallet", bindings.WalletABI, bindings.WalletBin},
+	{"oracle/Oracle", bindings.OracleABI, bindings.OracleBin},
+	{"licence/Licence", bindings.LicenceABI, bindings.LicenceBin},
+	{"holder/Holder", bindings.HolderABI, bindings.HolderBin},
+	{"controller/Controller", bindings.ControllerABI, bindings.ControllerBin},
+	{"tokenWhitelist/TokenWhitelist", bindings.TokenWhitelistABI, bindings.TokenWhitelistBin},
+	{"walletDeployer/WalletDeployer", bindings.WalletDeployerABI, bindings.WalletDeployerBin},
+	{"walletCache/WalletCache", bindings.WalletCacheABI, bindings.WalletCacheBin},
+	{"mocks/token/Token", mocks.TokenABI, mocks.TokenBin},
+	{"mocks/burnerToken/BurnerToken", mocks.BurnerTokenABI, mocks.BurnerTokenBin},
+	{"mocks/nonCompliantToken/NonCompliantToken", mocks.NonCompliantTokenABI, mocks.NonCompliantTokenBin},
+	{"mocks/base64Exporter/Base64Exporter", mocks.Base64ExporterABI, mocks.Base64ExporterBin},
+	{"mocks/oraclize/OraclizeConnector", mocks.OraclizeConnectorABI, mocks.OraclizeConnectorBin},
+	{"mocks/oraclize/OraclizeAddrResolver", mocks.OraclizeAddrResolverABI, mocks.OraclizeAddrResolverBin},
+	{"mocks/bytesUtilsExporter/BytesUtilsExporter", mocks.BytesUtilsExporterABI, mocks.BytesUtilsExporterBin},
+	{"mocks/isValidSignatureExporter/IsValidSignatureExporter", mocks.IsValidSignatureExporterABI, mocks.IsValidSignatureExporterBin},
+	{"mocks/parseIntScientificExporter/ParseIntScientificExporter", mocks.ParseIntScientificExporterABI, mocks.ParseIntScientificExporterBin},
+	{"mocks/tokenWhitelistableExporter/TokenWhitelistableExporter", mocks.TokenWhitelistableExporterABI, mocks.TokenWhitelistableExporterBin},
+	{"mocks/walletMock/WalletMock", mocks.WalletMockABI, mocks.WalletMockBin},
+	{"mocks/rateAdapter/RateAdapter", mocks.RateAdapterABI, mocks.RateAdapterBin},
+	{"mocks/falseToken/FalseToken", mocks.FalseTokenABI, mocks.FalseTokenBin},
+	{"mocks/dexAdapter/DexAdapter", mocks.DexAdapterABI, mocks.DexAdapterBin},
+	{"externals/ens/ENSRegistry/ENSRegistry", ens.ENSRegistryABI, ens.ENSRegistryBin},
+	{"externals/ens/PublicResolver/PublicResolver", ens.PublicResolverABI, ens.PublicResolverBin},
+	{"externals/upgradeability/UpgradeabilityProxy/UpgradeabilityProxy", upgradeability.UpgradeabilityProxyABI, upgradeability.UpgradeabilityProxyBin},
+}
+
+// metadataPattern matches the metadata solc appends to the code of each contract: a CBOR map holding the
+// swarm (bzzr0, bzzr1) or IPFS hash of the contract's metadata and, since solc 0.5.9, the compiler version.
+var metadataPattern = regexp.MustCompile(`a165627a7a72305820[0-9a-f]{64}0029|a265627a7a723[01]5820[0-9a-f]{64}64736f6c6343[0-9a-f]{6}0032|a264697066735822[0-9a-f]{68}64736f6c6343[0-9a-f]{6}0033`)
+
+// Check compares a binding with its artifacts in buildDir. The ABIs have to declare the same entries in any order,
+// the bytecodes have to match once the metadata hashes are left out, they change with the source's comments
+// and paths without changing the code. The returned error wraps ErrABIDrift or ErrBinDrift if they differ.
+func Check(buildDir string, b Binding) error {
+	path := filepath.Join(buildDir, filepath.FromSlash(b.Artifact))
+	artifactABI, err := ioutil.ReadFile(path + ".abi")
+	if err != nil {
+		return errors.Wrap(err, "reading ABI artifact")
+	}
+	want, err := normalizeABI(artifactABI)
+	if err != nil {
+		return errors.Wrapf(err, "parsing ABI artifact of %s", b.Artifact)
+	}
+	got, err := normalizeABI([]byte(b.ABI))
+	if err != nil {
+		return errors.Wrapf(err, "parsing ABI of the %s binding", b.Artifact)
+	}
+	if !bytes.Equal(want, got) {
+		return errors.Wrap(ErrABIDrift, b.Artifact)
+	}
+	artifactBin, err := ioutil.ReadFile(path + ".bin")
+	if err != nil {
+		return errors.Wrap(err, "reading bytecode artifact")
+	}
+	if normalizeBin(string(artifactBin)) != normalizeBin(b.Bin) {
+		return errors.Wrap(ErrBinDrift, b.Artifact)
+	}
+	return nil
+}
+
+// CheckAll checks every binding generated by build.sh, returning the error of each binding that differs.
+func CheckAll(buildDir string) []error {
+	var errs []error
+	for _, b := range Bindings {
+		if err := Check(buildDir, b); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// normalizeABI encodes the entries of the ABI sorted, each with sorted keys.
+func normalizeABI(abiJSON []byte) ([]byte, error) {
+	var entries []map[string]interface{}
+	if err := json.Unmarshal(abiJSON, &entries); err != nil {
+		return nil, err
+	}
+	encoded := make([]string, len(entries))
+	for i, e := range entries {
+		// Maps are encoded with sorted keys.
+		b, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = string(b)
+	}
+	sort.Strings(encoded)
+	return []byte("[" + strings.Join(encoded, ",") + "]"), nil
+}
+
+// normalizeBin returns the lower case hex bytecode with its metadata hashes zeroed.
+func normalizeBin(bin string) string {
+	bin = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(bin), "0x"))
+	return metadataPattern.ReplaceAllStringFunc(bin, func(m string) string {
+		return strings.Repeat("0", len(m))
+	})
+}
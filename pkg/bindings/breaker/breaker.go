@@ -0,0 +1,229 @@
+// Package breaker protects a failing node by failing calls fast after repeated errors, i.e. a circuit breaker.
+package breaker
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// ErrCircuitOpen is returned without calling the backend while the circuit is open.
+var ErrCircuitOpen = errors.New("circuit open")
+
+// State is the state of the circuit.
+type State int
+
+const (
+	// Closed lets every call through.
+	Closed State = iota
+	// Open fails every call with ErrCircuitOpen until the cooldown is over.
+	Open
+	// HalfOpen lets a single probing call through, its outcome closes or reopens the circuit.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// Config configures a Backend, zero fields take the values of DefaultConfig.
+type Config struct {
+	// FailureThreshold is the number of consecutive failures opening the circuit.
+	FailureThreshold int
+	// Cooldown is how long the circuit stays open before a probing call is let through.
+	Cooldown time.Duration
+	// IsFailure decides which errors count as failures.
+	IsFailure func(error) bool
+	// Clock returns the current time.
+	Clock func() time.Time
+}
+
+// DefaultConfig opens the circuit after 5 consecutive failures for 30 seconds. Any error counts as a failure,
+// except the cancellation of the call's context.
+var DefaultConfig = Config{
+	FailureThreshold: 5,
+	Cooldown:         30 * time.Second,
+	IsFailure: func(err error) bool {
+		return errors.Cause(err) != context.Canceled
+	},
+	Clock: time.Now,
+}
+
+// Backend wraps a bind.ContractBackend with a circuit breaker shared by all its methods.
+type Backend struct {
+	backend bind.ContractBackend
+	cfg     Config
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+}
+
+var _ bind.ContractBackend = (*Backend)(nil)
+
+// New wraps backend, using DefaultConfig for the fields left unset in cfg.
+func New(backend bind.ContractBackend, cfg Config) *Backend {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = DefaultConfig.FailureThreshold
+	}
+	if cfg.Cooldown <= 0 {
+		cfg.Cooldown = DefaultConfig.Cooldown
+	}
+	if cfg.IsFailure == nil {
+		cfg.IsFailure = DefaultConfig.IsFailure
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = DefaultConfig.Clock
+	}
+	return &Backend{backend: backend, cfg: cfg}
+}
+
+// State returns the current state of the circuit.
+func (b *Backend) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == Open && !b.cfg.Clock().Before(b.openedAt.Add(b.cfg.Cooldown)) {
+		return HalfOpen
+	}
+	return b.state
+}
+
+// allow reports whether a call can go through, moving the circuit to half-open if the cooldown is over.
+// While half-open, only the first call probes the backend.
+func (b *Backend) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case Open:
+		if b.cfg.Clock().Before(b.openedAt.Add(b.cfg.Cooldown)) {
+			return ErrCircuitOpen
+		}
+		b.state = HalfOpen
+		return nil
+	case HalfOpen:
+		return ErrCircuitOpen
+	}
+	return nil
+}
+
+// record updates the circuit with the outcome of a call.
+func (b *Backend) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err == nil || !b.cfg.IsFailure(err) {
+		b.state = Closed
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.state == HalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.state = Open
+		b.openedAt = b.cfg.Clock()
+	}
+}
+
+// CodeAt implements bind.ContractCaller.
+func (b *Backend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	code, err := b.backend.CodeAt(ctx, contract, blockNumber)
+	b.record(err)
+	return code, err
+}
+
+// CallContract implements bind.ContractCaller.
+func (b *Backend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	out, err := b.backend.CallContract(ctx, call, blockNumber)
+	b.record(err)
+	return out, err
+}
+
+// PendingCodeAt implements bind.ContractTransactor.
+func (b *Backend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	code, err := b.backend.PendingCodeAt(ctx, account)
+	b.record(err)
+	return code, err
+}
+
+// PendingNonceAt implements bind.ContractTransactor.
+func (b *Backend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	if err := b.allow(); err != nil {
+		return 0, err
+	}
+	nonce, err := b.backend.PendingNonceAt(ctx, account)
+	b.record(err)
+	return nonce, err
+}
+
+// SuggestGasPrice implements bind.ContractTransactor.
+func (b *Backend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	price, err := b.backend.SuggestGasPrice(ctx)
+	b.record(err)
+	return price, err
+}
+
+// EstimateGas implements bind.ContractTransactor.
+func (b *Backend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	if err := b.allow(); err != nil {
+		return 0, err
+	}
+	gas, err := b.backend.EstimateGas(ctx, call)
+	b.record(err)
+	return gas, err
+}
+
+// SendTransaction implements bind.ContractTransactor.
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	if err := b.allow(); err != nil {
+		return err
+	}
+	err := b.backend.SendTransaction(ctx, tx)
+	b.record(err)
+	return err
+}
+
+// FilterLogs implements bind.ContractFilterer.
+func (b *Backend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	logs, err := b.backend.FilterLogs(ctx, query)
+	b.record(err)
+	return logs, err
+}
+
+// SubscribeFilterLogs implements bind.ContractFilterer.
+func (b *Backend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	sub, err := b.backend.SubscribeFilterLogs(ctx, query, ch)
+	b.record(err)
+	return sub, err
+}
@@ -0,0 +1,97 @@
+// Package checkpoint persists how far a consumer of the chain has got, so that it can resume after a restart.
+package checkpoint
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// Checkpoint is the last block whose events have been fully processed.
+type Checkpoint struct {
+	BlockNumber uint64      `json:"blockNumber"`
+	BlockHash   common.Hash `json:"blockHash"`
+}
+
+// Store loads and saves a Checkpoint.
+type Store interface {
+	// Load returns the saved checkpoint, ok is false if none has been saved yet.
+	Load(ctx context.Context) (cp Checkpoint, ok bool, err error)
+	// Save replaces the saved checkpoint.
+	Save(ctx context.Context, cp Checkpoint) error
+}
+
+// MemoryStore keeps the checkpoint in memory, e.g. for tests. The zero value is ready to use.
+type MemoryStore struct {
+	mu    sync.Mutex
+	cp    Checkpoint
+	saved bool
+}
+
+// Load implements Store.
+func (m *MemoryStore) Load(ctx context.Context) (Checkpoint, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.cp, m.saved, nil
+}
+
+// Save implements Store.
+func (m *MemoryStore) Save(ctx context.Context, cp Checkpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cp = cp
+	m.saved = true
+	return nil
+}
+
+// FileStore keeps the checkpoint in a JSON file.
+type FileStore struct {
+	Path string
+}
+
+// Load implements Store, a missing file means no checkpoint was saved.
+func (f FileStore) Load(ctx context.Context) (Checkpoint, bool, error) {
+	data, err := ioutil.ReadFile(f.Path)
+	if os.IsNotExist(err) {
+		return Checkpoint{}, false, nil
+	}
+	if err != nil {
+		return Checkpoint{}, false, errors.Wrap(err, "reading checkpoint")
+	}
+	var cp Checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return Checkpoint{}, false, errors.Wrap(err, "decoding checkpoint")
+	}
+	return cp, true, nil
+}
+
+// Save implements Store. The file is replaced atomically, so a crash leaves either the old or the new checkpoint.
+func (f FileStore) Save(ctx context.Context, cp Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(f.Path), filepath.Base(f.Path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "creating checkpoint")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "writing checkpoint")
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "writing checkpoint")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "writing checkpoint")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), f.Path), "replacing checkpoint")
+}
@@ -0,0 +1,211 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package mocks
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// DexAdapterABI is the input ABI used to generate the binding from.
+const DexAdapterABI = "[{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"swap\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// DexAdapterBin is the compiled bytecode used for deploying new contracts.
+var DexAdapterBin = "0x608380600b6000396000f360003560e01c63df791e501461001457600080fd5b6323b872dd60e01b6000523360045230602452604435604452602060006064600060006004355af1600051161561007e5763a9059cbb60e01b60005233600452604435602452602060006044600060006024355af1600051161561007e5760443560005260206000f35b600080fd"
+
+// DeployDexAdapter deploys a new Ethereum contract, binding an instance of DexAdapter to it.
+func DeployDexAdapter(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *DexAdapter, error) {
+	parsed, err := abi.JSON(strings.NewReader(DexAdapterABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(DexAdapterBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &DexAdapter{DexAdapterCaller: DexAdapterCaller{contract: contract}, DexAdapterTransactor: DexAdapterTransactor{contract: contract}, DexAdapterFilterer: DexAdapterFilterer{contract: contract}}, nil
+}
+
+// DexAdapter is an auto generated Go binding around an Ethereum contract.
+type DexAdapter struct {
+	DexAdapterCaller     // Read-only binding to the contract
+	DexAdapterTransactor // Write-only binding to the contract
+	DexAdapterFilterer   // Log filterer for contract events
+}
+
+// DexAdapterCaller is an auto generated read-only Go binding around an Ethereum contract.
+type DexAdapterCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DexAdapterTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type DexAdapterTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DexAdapterFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DexAdapterFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DexAdapterSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type DexAdapterSession struct {
+	Contract     *DexAdapter       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// DexAdapterCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type DexAdapterCallerSession struct {
+	Contract *DexAdapterCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// DexAdapterTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type DexAdapterTransactorSession struct {
+	Contract     *DexAdapterTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// DexAdapterRaw is an auto generated low-level Go binding around an Ethereum contract.
+type DexAdapterRaw struct {
+	Contract *DexAdapter // Generic contract binding to access the raw methods on
+}
+
+// DexAdapterCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type DexAdapterCallerRaw struct {
+	Contract *DexAdapterCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// DexAdapterTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type DexAdapterTransactorRaw struct {
+	Contract *DexAdapterTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDexAdapter creates a new instance of DexAdapter, bound to a specific deployed contract.
+func NewDexAdapter(address common.Address, backend bind.ContractBackend) (*DexAdapter, error) {
+	contract, err := bindDexAdapter(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DexAdapter{DexAdapterCaller: DexAdapterCaller{contract: contract}, DexAdapterTransactor: DexAdapterTransactor{contract: contract}, DexAdapterFilterer: DexAdapterFilterer{contract: contract}}, nil
+}
+
+// NewDexAdapterCaller creates a new read-only instance of DexAdapter, bound to a specific deployed contract.
+func NewDexAdapterCaller(address common.Address, caller bind.ContractCaller) (*DexAdapterCaller, error) {
+	contract, err := bindDexAdapter(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DexAdapterCaller{contract: contract}, nil
+}
+
+// NewDexAdapterTransactor creates a new write-only instance of DexAdapter, bound to a specific deployed contract.
+func NewDexAdapterTransactor(address common.Address, transactor bind.ContractTransactor) (*DexAdapterTransactor, error) {
+	contract, err := bindDexAdapter(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DexAdapterTransactor{contract: contract}, nil
+}
+
+// NewDexAdapterFilterer creates a new log filterer instance of DexAdapter, bound to a specific deployed contract.
+func NewDexAdapterFilterer(address common.Address, filterer bind.ContractFilterer) (*DexAdapterFilterer, error) {
+	contract, err := bindDexAdapter(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DexAdapterFilterer{contract: contract}, nil
+}
+
+// bindDexAdapter binds a generic wrapper to an already deployed contract.
+func bindDexAdapter(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(DexAdapterABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DexAdapter *DexAdapterRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _DexAdapter.Contract.DexAdapterCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DexAdapter *DexAdapterRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DexAdapter.Contract.DexAdapterTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DexAdapter *DexAdapterRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DexAdapter.Contract.DexAdapterTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DexAdapter *DexAdapterCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _DexAdapter.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DexAdapter *DexAdapterTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DexAdapter.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DexAdapter *DexAdapterTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DexAdapter.Contract.contract.Transact(opts, method, params...)
+}
+
+// Swap is a paid mutator transaction binding the contract method 0xdf791e50.
+//
+// Solidity: function swap(address _from, address _to, uint256 _amount) returns(uint256)
+func (_DexAdapter *DexAdapterTransactor) Swap(opts *bind.TransactOpts, _from common.Address, _to common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DexAdapter.contract.Transact(opts, "swap", _from, _to, _amount)
+}
+
+// Swap is a paid mutator transaction binding the contract method 0xdf791e50.
+//
+// Solidity: function swap(address _from, address _to, uint256 _amount) returns(uint256)
+func (_DexAdapter *DexAdapterSession) Swap(_from common.Address, _to common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DexAdapter.Contract.Swap(&_DexAdapter.TransactOpts, _from, _to, _amount)
+}
+
+// Swap is a paid mutator transaction binding the contract method 0xdf791e50.
+//
+// Solidity: function swap(address _from, address _to, uint256 _amount) returns(uint256)
+func (_DexAdapter *DexAdapterTransactorSession) Swap(_from common.Address, _to common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DexAdapter.Contract.Swap(&_DexAdapter.TransactOpts, _from, _to, _amount)
+}
@@ -0,0 +1,279 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package mocks
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// FalseTokenABI is the input ABI used to generate the binding from.
+const FalseTokenABI = "[{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"credit\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// FalseTokenBin is the compiled bytecode used for deploying new contracts.
+var FalseTokenBin = "0x606580600b6000396000f360003560e01c806370a0823114610036578063ef6506db14610043578063a9059cbb1461005a57806323b872dd1461005a57600080fd5b6004355460005260206000f35b602435600435540160043555600160005260206000f35b600060005260206000f3"
+
+// DeployFalseToken deploys a new Ethereum contract, binding an instance of FalseToken to it.
+func DeployFalseToken(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *FalseToken, error) {
+	parsed, err := abi.JSON(strings.NewReader(FalseTokenABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(FalseTokenBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &FalseToken{FalseTokenCaller: FalseTokenCaller{contract: contract}, FalseTokenTransactor: FalseTokenTransactor{contract: contract}, FalseTokenFilterer: FalseTokenFilterer{contract: contract}}, nil
+}
+
+// FalseToken is an auto generated Go binding around an Ethereum contract.
+type FalseToken struct {
+	FalseTokenCaller     // Read-only binding to the contract
+	FalseTokenTransactor // Write-only binding to the contract
+	FalseTokenFilterer   // Log filterer for contract events
+}
+
+// FalseTokenCaller is an auto generated read-only Go binding around an Ethereum contract.
+type FalseTokenCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FalseTokenTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type FalseTokenTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FalseTokenFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type FalseTokenFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FalseTokenSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type FalseTokenSession struct {
+	Contract     *FalseToken       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// FalseTokenCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type FalseTokenCallerSession struct {
+	Contract *FalseTokenCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// FalseTokenTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type FalseTokenTransactorSession struct {
+	Contract     *FalseTokenTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// FalseTokenRaw is an auto generated low-level Go binding around an Ethereum contract.
+type FalseTokenRaw struct {
+	Contract *FalseToken // Generic contract binding to access the raw methods on
+}
+
+// FalseTokenCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type FalseTokenCallerRaw struct {
+	Contract *FalseTokenCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// FalseTokenTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type FalseTokenTransactorRaw struct {
+	Contract *FalseTokenTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewFalseToken creates a new instance of FalseToken, bound to a specific deployed contract.
+func NewFalseToken(address common.Address, backend bind.ContractBackend) (*FalseToken, error) {
+	contract, err := bindFalseToken(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &FalseToken{FalseTokenCaller: FalseTokenCaller{contract: contract}, FalseTokenTransactor: FalseTokenTransactor{contract: contract}, FalseTokenFilterer: FalseTokenFilterer{contract: contract}}, nil
+}
+
+// NewFalseTokenCaller creates a new read-only instance of FalseToken, bound to a specific deployed contract.
+func NewFalseTokenCaller(address common.Address, caller bind.ContractCaller) (*FalseTokenCaller, error) {
+	contract, err := bindFalseToken(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FalseTokenCaller{contract: contract}, nil
+}
+
+// NewFalseTokenTransactor creates a new write-only instance of FalseToken, bound to a specific deployed contract.
+func NewFalseTokenTransactor(address common.Address, transactor bind.ContractTransactor) (*FalseTokenTransactor, error) {
+	contract, err := bindFalseToken(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FalseTokenTransactor{contract: contract}, nil
+}
+
+// NewFalseTokenFilterer creates a new log filterer instance of FalseToken, bound to a specific deployed contract.
+func NewFalseTokenFilterer(address common.Address, filterer bind.ContractFilterer) (*FalseTokenFilterer, error) {
+	contract, err := bindFalseToken(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &FalseTokenFilterer{contract: contract}, nil
+}
+
+// bindFalseToken binds a generic wrapper to an already deployed contract.
+func bindFalseToken(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(FalseTokenABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FalseToken *FalseTokenRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _FalseToken.Contract.FalseTokenCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FalseToken *FalseTokenRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FalseToken.Contract.FalseTokenTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FalseToken *FalseTokenRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FalseToken.Contract.FalseTokenTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FalseToken *FalseTokenCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _FalseToken.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FalseToken *FalseTokenTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FalseToken.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FalseToken *FalseTokenTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FalseToken.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address ) constant returns(uint256)
+func (_FalseToken *FalseTokenCaller) BalanceOf(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _FalseToken.contract.Call(opts, out, "balanceOf", arg0)
+	return *ret0, err
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address ) constant returns(uint256)
+func (_FalseToken *FalseTokenSession) BalanceOf(arg0 common.Address) (*big.Int, error) {
+	return _FalseToken.Contract.BalanceOf(&_FalseToken.CallOpts, arg0)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address ) constant returns(uint256)
+func (_FalseToken *FalseTokenCallerSession) BalanceOf(arg0 common.Address) (*big.Int, error) {
+	return _FalseToken.Contract.BalanceOf(&_FalseToken.CallOpts, arg0)
+}
+
+// Credit is a paid mutator transaction binding the contract method 0xef6506db.
+//
+// Solidity: function credit(address _to, uint256 _amount) returns(bool)
+func (_FalseToken *FalseTokenTransactor) Credit(opts *bind.TransactOpts, _to common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _FalseToken.contract.Transact(opts, "credit", _to, _amount)
+}
+
+// Credit is a paid mutator transaction binding the contract method 0xef6506db.
+//
+// Solidity: function credit(address _to, uint256 _amount) returns(bool)
+func (_FalseToken *FalseTokenSession) Credit(_to common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _FalseToken.Contract.Credit(&_FalseToken.TransactOpts, _to, _amount)
+}
+
+// Credit is a paid mutator transaction binding the contract method 0xef6506db.
+//
+// Solidity: function credit(address _to, uint256 _amount) returns(bool)
+func (_FalseToken *FalseTokenTransactorSession) Credit(_to common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _FalseToken.Contract.Credit(&_FalseToken.TransactOpts, _to, _amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address , uint256 ) returns(bool)
+func (_FalseToken *FalseTokenTransactor) Transfer(opts *bind.TransactOpts, arg0 common.Address, arg1 *big.Int) (*types.Transaction, error) {
+	return _FalseToken.contract.Transact(opts, "transfer", arg0, arg1)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address , uint256 ) returns(bool)
+func (_FalseToken *FalseTokenSession) Transfer(arg0 common.Address, arg1 *big.Int) (*types.Transaction, error) {
+	return _FalseToken.Contract.Transfer(&_FalseToken.TransactOpts, arg0, arg1)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address , uint256 ) returns(bool)
+func (_FalseToken *FalseTokenTransactorSession) Transfer(arg0 common.Address, arg1 *big.Int) (*types.Transaction, error) {
+	return _FalseToken.Contract.Transfer(&_FalseToken.TransactOpts, arg0, arg1)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address , address , uint256 ) returns(bool)
+func (_FalseToken *FalseTokenTransactor) TransferFrom(opts *bind.TransactOpts, arg0 common.Address, arg1 common.Address, arg2 *big.Int) (*types.Transaction, error) {
+	return _FalseToken.contract.Transact(opts, "transferFrom", arg0, arg1, arg2)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address , address , uint256 ) returns(bool)
+func (_FalseToken *FalseTokenSession) TransferFrom(arg0 common.Address, arg1 common.Address, arg2 *big.Int) (*types.Transaction, error) {
+	return _FalseToken.Contract.TransferFrom(&_FalseToken.TransactOpts, arg0, arg1, arg2)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address , address , uint256 ) returns(bool)
+func (_FalseToken *FalseTokenTransactorSession) TransferFrom(arg0 common.Address, arg1 common.Address, arg2 *big.Int) (*types.Transaction, error) {
+	return _FalseToken.Contract.TransferFrom(&_FalseToken.TransactOpts, arg0, arg1, arg2)
+}
@@ -28,7 +28,7 @@ var (
 )
 
 // OraclizeConnectorABI is the input ABI used to generate the binding from.
-const OraclizeConnectorABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_cbAddress\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"constant\":true,\"inputs\":[],\"name\":\"cbAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_datasource\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"gaslimit\",\"type\":\"uint256\"}],\"name\":\"getPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_datasource\",\"type\":\"string\"}],\"name\":\"getPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"proofType\",\"outputs\":[{\"internalType\":\"bytes1\",\"name\":\"\",\"type\":\"bytes1\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"_datasource\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_arg\",\"type\":\"string\"}],\"name\":\"query\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"_datasource\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_arg\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_gaslimit\",\"type\":\"uint256\"}],\"name\":\"query_withGasLimit\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gasPrice\",\"type\":\"uint256\"}],\"name\":\"setCustomGasPrice\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes1\",\"name\":\"_proofType\",\"type\":\"bytes1\"}],\"name\":\"setProofType\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const OraclizeConnectorABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_cbAddress\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"constant\":true,\"inputs\":[],\"name\":\"cbAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_datasource\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"gaslimit\",\"type\":\"uint256\"}],\"name\":\"getPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_datasource\",\"type\":\"string\"}],\"name\":\"getPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"proofType\",\"outputs\":[{\"internalType\":\"bytes1\",\"name\":\"\",\"type\":\"bytes1\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"_datasource\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_arg\",\"type\":\"string\"}],\"name\":\"query\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_timestamp\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"_datasource\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_arg\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_gaslimit\",\"type\":\"uint256\"}],\"name\":\"query_withGasLimit\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_cbAddress\",\"type\":\"address\"}],\"name\":\"setCbAddress\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gasPrice\",\"type\":\"uint256\"}],\"name\":\"setCustomGasPrice\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes1\",\"name\":\"_proofType\",\"type\":\"bytes1\"}],\"name\":\"setProofType\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_target\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"setReentry\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 // OraclizeConnectorBin is the compiled bytecode used for deploying new contracts.
 var OraclizeConnectorBin = "0x608060405234801561001057600080fd5b5060405161066a38038061066a8339818101604052602081101561003357600080fd5b5051600080546001600160a01b039092166001600160a01b0319909216919091179055610605806100656000396000f3fe60806040526004361061007b5760003560e01c8063adf59f991161004e578063adf59f991461025e578063c281d19e14610323578063c51be90f14610354578063ca6ad1e4146104195761007b565b806305af5d35146100805780632ef3accc146100b2578063524f388914610177578063688dcfd714610228575b600080fd5b34801561008c57600080fd5b50610095610443565b604080516001600160f81b03199092168252519081900360200190f35b3480156100be57600080fd5b50610165600480360360408110156100d557600080fd5b810190602081018135600160201b8111156100ef57600080fd5b82018360208201111561010157600080fd5b803590602001918460018302840111600160201b8311171561012257600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550509135925061044c915050565b60408051918252519081900360200190f35b34801561018357600080fd5b506101656004803603602081101561019a57600080fd5b810190602081018135600160201b8111156101b457600080fd5b8201836020820111156101c657600080fd5b803590602001918460018302840111600160201b831117156101e757600080fd5b91908080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525092955061046f945050505050565b34801561023457600080fd5b5061025c6004803603602081101561024b57600080fd5b50356001600160f81b031916610490565b005b6101656004803603606081101561027457600080fd5b81359190810190604081016020820135600160201b81111561029557600080fd5b8201836020820111156102a757600080fd5b803590602001918460018302840111600160201b831117156102c857600080fd5b919390929091602081019035600160201b8111156102e557600080fd5b8201836020820111156102f757600080fd5b803590602001918460018302840111600160201b8311171561031857600080fd5b5090925090506104a6565b34801561032f57600080fd5b50610338610526565b604080516001600160a01b039092168252519081900360200190f35b6101656004803603608081101561036a57600080fd5b81359190810190604081016020820135600160201b81111561038b57600080fd5b82018360208201111561039d57600080fd5b803590602001918460018302840111600160201b831117156103be57600080fd5b919390929091602081019035600160201b8111156103db57600080fd5b8201836020820111156103ed57600080fd5b803590602001918460018302840111600160201b8311171561040e57600080fd5b919350915035610535565b34801561042557600080fd5b5061025c6004803603602081101561043c57600080fd5b50356105c0565b60025460f81b81565b6000816104635761045c8361046f565b9050610469565b50620f42405b92915050565b60008151600014156104855750620f424061048b565b50620f42405b919050565b6002805460ff191660f89290921c919091179055565b6000851580156104b4575083155b156104ff576104f883838080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506105c592505050565b905061051d565b82826040518083838082843760405192018290039091209450505050505b95945050505050565b6000546001600160a01b031681565b600086158015610543575084155b801561054d575081155b156105985761059184848080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506105c592505050565b90506105b6565b83836040518083838082843760405192018290039091209450505050505b9695505050505050565b600155565b80516020909101209056fea265627a7a723158203c17d56efe86b9820213d29a33e18bcfcacbaa09e044b202b9f62debb3b8dc8b64736f6c63430005110032"
@@ -335,6 +335,27 @@ func (_OraclizeConnector *OraclizeConnectorTransactorSession) QueryWithGasLimit(
 	return _OraclizeConnector.Contract.QueryWithGasLimit(&_OraclizeConnector.TransactOpts, _timestamp, _datasource, _arg, _gaslimit)
 }
 
+// SetCbAddress is a paid mutator transaction binding the contract method 0xb62a717c.
+//
+// Solidity: function setCbAddress(address _cbAddress) returns()
+func (_OraclizeConnector *OraclizeConnectorTransactor) SetCbAddress(opts *bind.TransactOpts, _cbAddress common.Address) (*types.Transaction, error) {
+	return _OraclizeConnector.contract.Transact(opts, "setCbAddress", _cbAddress)
+}
+
+// SetCbAddress is a paid mutator transaction binding the contract method 0xb62a717c.
+//
+// Solidity: function setCbAddress(address _cbAddress) returns()
+func (_OraclizeConnector *OraclizeConnectorSession) SetCbAddress(_cbAddress common.Address) (*types.Transaction, error) {
+	return _OraclizeConnector.Contract.SetCbAddress(&_OraclizeConnector.TransactOpts, _cbAddress)
+}
+
+// SetCbAddress is a paid mutator transaction binding the contract method 0xb62a717c.
+//
+// Solidity: function setCbAddress(address _cbAddress) returns()
+func (_OraclizeConnector *OraclizeConnectorTransactorSession) SetCbAddress(_cbAddress common.Address) (*types.Transaction, error) {
+	return _OraclizeConnector.Contract.SetCbAddress(&_OraclizeConnector.TransactOpts, _cbAddress)
+}
+
 // SetCustomGasPrice is a paid mutator transaction binding the contract method 0xca6ad1e4.
 //
 // Solidity: function setCustomGasPrice(uint256 _gasPrice) returns()
@@ -376,3 +397,24 @@ func (_OraclizeConnector *OraclizeConnectorSession) SetProofType(_proofType [1]b
 func (_OraclizeConnector *OraclizeConnectorTransactorSession) SetProofType(_proofType [1]byte) (*types.Transaction, error) {
 	return _OraclizeConnector.Contract.SetProofType(&_OraclizeConnector.TransactOpts, _proofType)
 }
+
+// SetReentry is a paid mutator transaction binding the contract method 0x58f63fb8.
+//
+// Solidity: function setReentry(address _target, bytes _data) returns()
+func (_OraclizeConnector *OraclizeConnectorTransactor) SetReentry(opts *bind.TransactOpts, _target common.Address, _data []byte) (*types.Transaction, error) {
+	return _OraclizeConnector.contract.Transact(opts, "setReentry", _target, _data)
+}
+
+// SetReentry is a paid mutator transaction binding the contract method 0x58f63fb8.
+//
+// Solidity: function setReentry(address _target, bytes _data) returns()
+func (_OraclizeConnector *OraclizeConnectorSession) SetReentry(_target common.Address, _data []byte) (*types.Transaction, error) {
+	return _OraclizeConnector.Contract.SetReentry(&_OraclizeConnector.TransactOpts, _target, _data)
+}
+
+// SetReentry is a paid mutator transaction binding the contract method 0x58f63fb8.
+//
+// Solidity: function setReentry(address _target, bytes _data) returns()
+func (_OraclizeConnector *OraclizeConnectorTransactorSession) SetReentry(_target common.Address, _data []byte) (*types.Transaction, error) {
+	return _OraclizeConnector.Contract.SetReentry(&_OraclizeConnector.TransactOpts, _target, _data)
+}
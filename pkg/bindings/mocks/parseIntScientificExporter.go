@@ -28,7 +28,7 @@ var (
 )
 
 // ParseIntScientificExporterABI is the input ABI used to generate the binding from.
-const ParseIntScientificExporterABI = "[{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_a\",\"type\":\"string\"}],\"name\":\"parseIntScientific\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_a\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_b\",\"type\":\"uint256\"}],\"name\":\"parseIntScientificDecimals\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_a\",\"type\":\"string\"}],\"name\":\"parseIntScientificWei\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"}]"
+const ParseIntScientificExporterABI = "[{\"constant\":true,\"inputs\":[],\"name\":\"maxExponent\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_a\",\"type\":\"string\"}],\"name\":\"parseIntScientific\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string[]\",\"name\":\"_a\",\"type\":\"string[]\"},{\"internalType\":\"uint256\",\"name\":\"_b\",\"type\":\"uint256\"}],\"name\":\"parseIntScientificBatch\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_a\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_b\",\"type\":\"uint256\"}],\"name\":\"parseIntScientificDecimals\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_a\",\"type\":\"string\"}],\"name\":\"parseIntScientificSignMagnitude\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"negative\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"magnitude\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_a\",\"type\":\"string\"}],\"name\":\"parseIntScientificSigned\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_a\",\"type\":\"string\"}],\"name\":\"parseIntScientificWei\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"string\",\"name\":\"_a\",\"type\":\"string\"}],\"name\":\"tryParseIntScientific\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"ok\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"pure\",\"type\":\"function\"}]"
 
 // ParseIntScientificExporterBin is the compiled bytecode used for deploying new contracts.
 var ParseIntScientificExporterBin = "0x608060405234801561001057600080fd5b50610c70806100206000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c806361f7e0ac1461004657806387c8da5e146100c8578063ba07069514610138575b600080fd5b6100b66004803603602081101561005c57600080fd5b81019060208101813564010000000081111561007757600080fd5b82018360208201111561008957600080fd5b803590602001918460018302840111640100000000831117156100ab57600080fd5b5090925090506101a8565b60408051918252519081900360200190f35b6100b6600480360360408110156100de57600080fd5b8101906020810181356401000000008111156100f957600080fd5b82018360208201111561010b57600080fd5b8035906020019184600183028401116401000000008311171561012d57600080fd5b9193509150356101f2565b6100b66004803603602081101561014e57600080fd5b81019060208101813564010000000081111561016957600080fd5b82018360208201111561017b57600080fd5b8035906020019184600183028401116401000000008311171561019d57600080fd5b50909250905061023d565b60006101e983838080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061027e92505050565b90505b92915050565b600061023584848080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250869250610287915050565b949350505050565b60006101e983838080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250610b3892505050565b60006101ec8260125b60008281808080808080808080805b8b518110156108c9578b51600360fc1b908d90839081106102b357fe5b01602001516001600160f81b031916108015906102f157508b51603960f81b908d90839081106102df57fe5b01602001516001600160f81b03191611155b80156102fb575083155b156103ab578415610359576103178a600a63ffffffff610b4516565b995061034c603060f81b60f81c8d838151811061033057fe5b01602001518c9160f89190911c0360ff1663ffffffff610b9e16565b99506001909701966103a6565b6001955061036e8b600a63ffffffff610b4516565b9a506103a3603060f81b60f81c8d838151811061038757fe5b01602001518d9160f89190911c0360ff1663ffffffff610b9e16565b9a505b6108c1565b8b51600360fc1b908d90839081106103bf57fe5b01602001516001600160f81b031916108015906103fd57508b51603960f81b908d90839081106103eb57fe5b01602001516001600160f81b03191611155b80156104065750835b156104585761041c89600a63ffffffff610b4516565b9850610451603060f81b60f81c8d838151811061043557fe5b01602001518b9160f89190911c0360ff1663ffffffff610b9e16565b98506108c1565b8b51601760f91b908d908390811061046c57fe5b01602001516001600160f81b031916141561057357856104cb576040805162461bcd60e51b81526020600482015260156024820152741b5a5cdcda5b99c81a5b9d1959dc985b081c185c9d605a1b604482015290519081900360640190fd5b841561051e576040805162461bcd60e51b815260206004820152601760248201527f6475706c696361746520646563696d616c20706f696e74000000000000000000604482015290519081900360640190fd5b831561056a576040805162461bcd60e51b8152602060048201526016602482015275191958da5b585b0818599d195c88195e1c1bdb995b9d60521b604482015290519081900360640190fd5b600194506108c1565b8b51602d60f81b908d908390811061058757fe5b01602001516001600160f81b031916141561067d5782156105dd576040805162461bcd60e51b815260206004820152600b60248201526a6475706c6963617465202d60a81b604482015290519081900360640190fd5b811561061d576040805162461bcd60e51b815260206004820152600a60248201526932bc3a39309039b4b3b760b11b604482015290519081900360640190fd5b808760010114610674576040805162461bcd60e51b815260206004820152601e60248201527f2d207369676e206e6f7420696d6d6564696174656c7920616674657220650000604482015290519081900360640190fd5b600192506108c1565b8b51602b60f81b908d908390811061069157fe5b01602001516001600160f81b03191614156107875781156106e7576040805162461bcd60e51b815260206004820152600b60248201526a6475706c6963617465202b60a81b604482015290519081900360640190fd5b8215610727576040805162461bcd60e51b815260206004820152600a60248201526932bc3a39309039b4b3b760b11b604482015290519081900360640190fd5b80876001011461077e576040805162461bcd60e51b815260206004820152601e60248201527f2b207369676e206e6f7420696d6d6564696174656c7920616674657220650000604482015290519081900360640190fd5b600191506108c1565b8b51604560f81b908d908390811061079b57fe5b01602001516001600160f81b03191614806107d657508b51606560f81b908d90839081106107c557fe5b01602001516001600160f81b031916145b156108845785610825576040805162461bcd60e51b81526020600482015260156024820152741b5a5cdcda5b99c81a5b9d1959dc985b081c185c9d605a1b604482015290519081900360640190fd5b8315610878576040805162461bcd60e51b815260206004820152601960248201527f6475706c6963617465206578706f6e656e742073796d626f6c00000000000000604482015290519081900360640190fd5b600193508096506108c1565b6040805162461bcd60e51b815260206004820152600d60248201526c1a5b9d985b1a5908191a59da5d609a1b604482015290519081900360640190fd5b600101610296565b82806108d25750815b156108eb578660020181116108e657600080fd5b610900565b83156109005786600101811161090057600080fd5b8215610981578d891061097757604e8e8a0310610954576040805162461bcd60e51b815260206004820152600d60248201526c6578706f6e656e74203e20373760981b604482015290519081900360640190fd5b8d8903600a0a8b8161096257fe5b049c506101ec9b505050505050505050505050565b888e039d50610994565b6109918e8a63ffffffff610b9e16565b9d505b878e10610a6857604e88106109da5760405162461bcd60e51b8152600401808060200182810382526022815260200180610bf96022913960400191505060405180910390fd5b6109ee8b600a8a900a63ffffffff610b4516565b9a50610a008b8b63ffffffff610b9e16565b9a50604e888f0310610a49576040805162461bcd60e51b815260206004820152600d60248201526c6578706f6e656e74203e20373760981b604482015290519081900360640190fd5b610a61888f03600a0a8c610b4590919063ffffffff16565b9a50610b25565b8d88039750604e8810610aac5760405162461bcd60e51b8152600401808060200182810382526022815260200180610bf96022913960400191505060405180910390fd5b87600a0a8a81610ab857fe5b049950604e8e10610afa5760405162461bcd60e51b8152600401808060200182810382526022815260200180610bf96022913960400191505060405180910390fd5b610b108e600a0a8c610b4590919063ffffffff16565b9a50610b228b8b63ffffffff610b9e16565b9a505b50989d9c50505050505050505050505050565b60006101ec826000610287565b600082610b54575060006101ec565b82820282848281610b6157fe5b04146101e95760405162461bcd60e51b8152600401808060200182810382526021815260200180610c1b6021913960400191505060405180910390fd5b6000828201838110156101e9576040805162461bcd60e51b815260206004820152601b60248201527f536166654d6174683a206164646974696f6e206f766572666c6f770000000000604482015290519081900360640190fdfe6d6f7265207468616e20373720646563696d616c2064696769747320706172736564536166654d6174683a206d756c7469706c69636174696f6e206f766572666c6f77a265627a7a72315820b95fcd1a847d968282a007332a19aea9443eb0e37b5fa6b08b36647388b7b6ca64736f6c63430005110032"
@@ -189,6 +189,32 @@ func (_ParseIntScientificExporter *ParseIntScientificExporterTransactorRaw) Tran
 	return _ParseIntScientificExporter.Contract.contract.Transact(opts, method, params...)
 }
 
+// MaxExponent is a free data retrieval call binding the contract method 0x15c8d6ca.
+//
+// Solidity: function maxExponent() constant returns(uint256)
+func (_ParseIntScientificExporter *ParseIntScientificExporterCaller) MaxExponent(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ParseIntScientificExporter.contract.Call(opts, out, "maxExponent")
+	return *ret0, err
+}
+
+// MaxExponent is a free data retrieval call binding the contract method 0x15c8d6ca.
+//
+// Solidity: function maxExponent() constant returns(uint256)
+func (_ParseIntScientificExporter *ParseIntScientificExporterSession) MaxExponent() (*big.Int, error) {
+	return _ParseIntScientificExporter.Contract.MaxExponent(&_ParseIntScientificExporter.CallOpts)
+}
+
+// MaxExponent is a free data retrieval call binding the contract method 0x15c8d6ca.
+//
+// Solidity: function maxExponent() constant returns(uint256)
+func (_ParseIntScientificExporter *ParseIntScientificExporterCallerSession) MaxExponent() (*big.Int, error) {
+	return _ParseIntScientificExporter.Contract.MaxExponent(&_ParseIntScientificExporter.CallOpts)
+}
+
 // ParseIntScientific is a free data retrieval call binding the contract method 0xba070695.
 //
 // Solidity: function parseIntScientific(string _a) constant returns(uint256)
@@ -215,6 +241,32 @@ func (_ParseIntScientificExporter *ParseIntScientificExporterCallerSession) Pars
 	return _ParseIntScientificExporter.Contract.ParseIntScientific(&_ParseIntScientificExporter.CallOpts, _a)
 }
 
+// ParseIntScientificBatch is a free data retrieval call binding the contract method 0x835b80d6.
+//
+// Solidity: function parseIntScientificBatch(string[] _a, uint256 _b) constant returns(uint256[])
+func (_ParseIntScientificExporter *ParseIntScientificExporterCaller) ParseIntScientificBatch(opts *bind.CallOpts, _a []string, _b *big.Int) ([]*big.Int, error) {
+	var (
+		ret0 = new([]*big.Int)
+	)
+	out := ret0
+	err := _ParseIntScientificExporter.contract.Call(opts, out, "parseIntScientificBatch", _a, _b)
+	return *ret0, err
+}
+
+// ParseIntScientificBatch is a free data retrieval call binding the contract method 0x835b80d6.
+//
+// Solidity: function parseIntScientificBatch(string[] _a, uint256 _b) constant returns(uint256[])
+func (_ParseIntScientificExporter *ParseIntScientificExporterSession) ParseIntScientificBatch(_a []string, _b *big.Int) ([]*big.Int, error) {
+	return _ParseIntScientificExporter.Contract.ParseIntScientificBatch(&_ParseIntScientificExporter.CallOpts, _a, _b)
+}
+
+// ParseIntScientificBatch is a free data retrieval call binding the contract method 0x835b80d6.
+//
+// Solidity: function parseIntScientificBatch(string[] _a, uint256 _b) constant returns(uint256[])
+func (_ParseIntScientificExporter *ParseIntScientificExporterCallerSession) ParseIntScientificBatch(_a []string, _b *big.Int) ([]*big.Int, error) {
+	return _ParseIntScientificExporter.Contract.ParseIntScientificBatch(&_ParseIntScientificExporter.CallOpts, _a, _b)
+}
+
 // ParseIntScientificDecimals is a free data retrieval call binding the contract method 0x87c8da5e.
 //
 // Solidity: function parseIntScientificDecimals(string _a, uint256 _b) constant returns(uint256)
@@ -241,6 +293,68 @@ func (_ParseIntScientificExporter *ParseIntScientificExporterCallerSession) Pars
 	return _ParseIntScientificExporter.Contract.ParseIntScientificDecimals(&_ParseIntScientificExporter.CallOpts, _a, _b)
 }
 
+// ParseIntScientificSignMagnitude is a free data retrieval call binding the contract method 0xdbba6e4c.
+//
+// Solidity: function parseIntScientificSignMagnitude(string _a) constant returns(bool negative, uint256 magnitude)
+func (_ParseIntScientificExporter *ParseIntScientificExporterCaller) ParseIntScientificSignMagnitude(opts *bind.CallOpts, _a string) (struct {
+	Negative  bool
+	Magnitude *big.Int
+}, error) {
+	ret := new(struct {
+		Negative  bool
+		Magnitude *big.Int
+	})
+	out := ret
+	err := _ParseIntScientificExporter.contract.Call(opts, out, "parseIntScientificSignMagnitude", _a)
+	return *ret, err
+}
+
+// ParseIntScientificSignMagnitude is a free data retrieval call binding the contract method 0xdbba6e4c.
+//
+// Solidity: function parseIntScientificSignMagnitude(string _a) constant returns(bool negative, uint256 magnitude)
+func (_ParseIntScientificExporter *ParseIntScientificExporterSession) ParseIntScientificSignMagnitude(_a string) (struct {
+	Negative  bool
+	Magnitude *big.Int
+}, error) {
+	return _ParseIntScientificExporter.Contract.ParseIntScientificSignMagnitude(&_ParseIntScientificExporter.CallOpts, _a)
+}
+
+// ParseIntScientificSignMagnitude is a free data retrieval call binding the contract method 0xdbba6e4c.
+//
+// Solidity: function parseIntScientificSignMagnitude(string _a) constant returns(bool negative, uint256 magnitude)
+func (_ParseIntScientificExporter *ParseIntScientificExporterCallerSession) ParseIntScientificSignMagnitude(_a string) (struct {
+	Negative  bool
+	Magnitude *big.Int
+}, error) {
+	return _ParseIntScientificExporter.Contract.ParseIntScientificSignMagnitude(&_ParseIntScientificExporter.CallOpts, _a)
+}
+
+// ParseIntScientificSigned is a free data retrieval call binding the contract method 0xd7f8dce9.
+//
+// Solidity: function parseIntScientificSigned(string _a) constant returns(int256)
+func (_ParseIntScientificExporter *ParseIntScientificExporterCaller) ParseIntScientificSigned(opts *bind.CallOpts, _a string) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _ParseIntScientificExporter.contract.Call(opts, out, "parseIntScientificSigned", _a)
+	return *ret0, err
+}
+
+// ParseIntScientificSigned is a free data retrieval call binding the contract method 0xd7f8dce9.
+//
+// Solidity: function parseIntScientificSigned(string _a) constant returns(int256)
+func (_ParseIntScientificExporter *ParseIntScientificExporterSession) ParseIntScientificSigned(_a string) (*big.Int, error) {
+	return _ParseIntScientificExporter.Contract.ParseIntScientificSigned(&_ParseIntScientificExporter.CallOpts, _a)
+}
+
+// ParseIntScientificSigned is a free data retrieval call binding the contract method 0xd7f8dce9.
+//
+// Solidity: function parseIntScientificSigned(string _a) constant returns(int256)
+func (_ParseIntScientificExporter *ParseIntScientificExporterCallerSession) ParseIntScientificSigned(_a string) (*big.Int, error) {
+	return _ParseIntScientificExporter.Contract.ParseIntScientificSigned(&_ParseIntScientificExporter.CallOpts, _a)
+}
+
 // ParseIntScientificWei is a free data retrieval call binding the contract method 0x61f7e0ac.
 //
 // Solidity: function parseIntScientificWei(string _a) constant returns(uint256)
@@ -266,3 +380,39 @@ func (_ParseIntScientificExporter *ParseIntScientificExporterSession) ParseIntSc
 func (_ParseIntScientificExporter *ParseIntScientificExporterCallerSession) ParseIntScientificWei(_a string) (*big.Int, error) {
 	return _ParseIntScientificExporter.Contract.ParseIntScientificWei(&_ParseIntScientificExporter.CallOpts, _a)
 }
+
+// TryParseIntScientific is a free data retrieval call binding the contract method 0x10939716.
+//
+// Solidity: function tryParseIntScientific(string _a) constant returns(bool ok, uint256 value)
+func (_ParseIntScientificExporter *ParseIntScientificExporterCaller) TryParseIntScientific(opts *bind.CallOpts, _a string) (struct {
+	Ok    bool
+	Value *big.Int
+}, error) {
+	ret := new(struct {
+		Ok    bool
+		Value *big.Int
+	})
+	out := ret
+	err := _ParseIntScientificExporter.contract.Call(opts, out, "tryParseIntScientific", _a)
+	return *ret, err
+}
+
+// TryParseIntScientific is a free data retrieval call binding the contract method 0x10939716.
+//
+// Solidity: function tryParseIntScientific(string _a) constant returns(bool ok, uint256 value)
+func (_ParseIntScientificExporter *ParseIntScientificExporterSession) TryParseIntScientific(_a string) (struct {
+	Ok    bool
+	Value *big.Int
+}, error) {
+	return _ParseIntScientificExporter.Contract.TryParseIntScientific(&_ParseIntScientificExporter.CallOpts, _a)
+}
+
+// TryParseIntScientific is a free data retrieval call binding the contract method 0x10939716.
+//
+// Solidity: function tryParseIntScientific(string _a) constant returns(bool ok, uint256 value)
+func (_ParseIntScientificExporter *ParseIntScientificExporterCallerSession) TryParseIntScientific(_a string) (struct {
+	Ok    bool
+	Value *big.Int
+}, error) {
+	return _ParseIntScientificExporter.Contract.TryParseIntScientific(&_ParseIntScientificExporter.CallOpts, _a)
+}
@@ -0,0 +1,220 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package mocks
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = abi.U256
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// RateAdapterABI is the input ABI used to generate the binding from.
+const RateAdapterABI = "[{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_rate_\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_timestamp_\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"getRate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// RateAdapterBin is the compiled bytecode used for deploying new contracts.
+var RateAdapterBin = "0x60406040380360003960005160005560205160015560118060206000396000f360005460005260015460205260406000f3"
+
+// DeployRateAdapter deploys a new Ethereum contract, binding an instance of RateAdapter to it.
+func DeployRateAdapter(auth *bind.TransactOpts, backend bind.ContractBackend, _rate_ *big.Int, _timestamp_ *big.Int) (common.Address, *types.Transaction, *RateAdapter, error) {
+	parsed, err := abi.JSON(strings.NewReader(RateAdapterABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(RateAdapterBin), backend, _rate_, _timestamp_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &RateAdapter{RateAdapterCaller: RateAdapterCaller{contract: contract}, RateAdapterTransactor: RateAdapterTransactor{contract: contract}, RateAdapterFilterer: RateAdapterFilterer{contract: contract}}, nil
+}
+
+// RateAdapter is an auto generated Go binding around an Ethereum contract.
+type RateAdapter struct {
+	RateAdapterCaller     // Read-only binding to the contract
+	RateAdapterTransactor // Write-only binding to the contract
+	RateAdapterFilterer   // Log filterer for contract events
+}
+
+// RateAdapterCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RateAdapterCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RateAdapterTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RateAdapterTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RateAdapterFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RateAdapterFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RateAdapterSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RateAdapterSession struct {
+	Contract     *RateAdapter      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// RateAdapterCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RateAdapterCallerSession struct {
+	Contract *RateAdapterCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// RateAdapterTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RateAdapterTransactorSession struct {
+	Contract     *RateAdapterTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// RateAdapterRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RateAdapterRaw struct {
+	Contract *RateAdapter // Generic contract binding to access the raw methods on
+}
+
+// RateAdapterCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RateAdapterCallerRaw struct {
+	Contract *RateAdapterCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RateAdapterTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RateAdapterTransactorRaw struct {
+	Contract *RateAdapterTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRateAdapter creates a new instance of RateAdapter, bound to a specific deployed contract.
+func NewRateAdapter(address common.Address, backend bind.ContractBackend) (*RateAdapter, error) {
+	contract, err := bindRateAdapter(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RateAdapter{RateAdapterCaller: RateAdapterCaller{contract: contract}, RateAdapterTransactor: RateAdapterTransactor{contract: contract}, RateAdapterFilterer: RateAdapterFilterer{contract: contract}}, nil
+}
+
+// NewRateAdapterCaller creates a new read-only instance of RateAdapter, bound to a specific deployed contract.
+func NewRateAdapterCaller(address common.Address, caller bind.ContractCaller) (*RateAdapterCaller, error) {
+	contract, err := bindRateAdapter(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RateAdapterCaller{contract: contract}, nil
+}
+
+// NewRateAdapterTransactor creates a new write-only instance of RateAdapter, bound to a specific deployed contract.
+func NewRateAdapterTransactor(address common.Address, transactor bind.ContractTransactor) (*RateAdapterTransactor, error) {
+	contract, err := bindRateAdapter(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RateAdapterTransactor{contract: contract}, nil
+}
+
+// NewRateAdapterFilterer creates a new log filterer instance of RateAdapter, bound to a specific deployed contract.
+func NewRateAdapterFilterer(address common.Address, filterer bind.ContractFilterer) (*RateAdapterFilterer, error) {
+	contract, err := bindRateAdapter(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RateAdapterFilterer{contract: contract}, nil
+}
+
+// bindRateAdapter binds a generic wrapper to an already deployed contract.
+func bindRateAdapter(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(RateAdapterABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RateAdapter *RateAdapterRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _RateAdapter.Contract.RateAdapterCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RateAdapter *RateAdapterRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RateAdapter.Contract.RateAdapterTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RateAdapter *RateAdapterRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RateAdapter.Contract.RateAdapterTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RateAdapter *RateAdapterCallerRaw) Call(opts *bind.CallOpts, result interface{}, method string, params ...interface{}) error {
+	return _RateAdapter.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RateAdapter *RateAdapterTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RateAdapter.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RateAdapter *RateAdapterTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RateAdapter.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetRate is a free data retrieval call binding the contract method 0x37cef791.
+//
+// Solidity: function getRate(address ) constant returns(uint256, uint256)
+func (_RateAdapter *RateAdapterCaller) GetRate(opts *bind.CallOpts, arg0 common.Address) (*big.Int, *big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+		ret1 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+	}
+	err := _RateAdapter.contract.Call(opts, out, "getRate", arg0)
+	return *ret0, *ret1, err
+}
+
+// GetRate is a free data retrieval call binding the contract method 0x37cef791.
+//
+// Solidity: function getRate(address ) constant returns(uint256, uint256)
+func (_RateAdapter *RateAdapterSession) GetRate(arg0 common.Address) (*big.Int, *big.Int, error) {
+	return _RateAdapter.Contract.GetRate(&_RateAdapter.CallOpts, arg0)
+}
+
+// GetRate is a free data retrieval call binding the contract method 0x37cef791.
+//
+// Solidity: function getRate(address ) constant returns(uint256, uint256)
+func (_RateAdapter *RateAdapterCallerSession) GetRate(arg0 common.Address) (*big.Int, *big.Int, error) {
+	return _RateAdapter.Contract.GetRate(&_RateAdapter.CallOpts, arg0)
+}
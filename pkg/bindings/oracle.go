@@ -28,7 +28,7 @@ var (
 )
 
 // OracleABI is the input ABI used to generate the binding from.
-const OracleABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_resolver_\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_ens_\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"_controllerNode_\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"_tokenWhitelistNode_\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_asset\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"Claimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_reason\",\"type\":\"string\"}],\"name\":\"FailedUpdateRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_symbol\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"_queryID\",\"type\":\"bytes32\"}],\"name\":\"RequestedUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"_publicKey\",\"type\":\"bytes\"}],\"name\":\"SetCryptoComparePublicKey\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_gasPrice\",\"type\":\"uint256\"}],\"name\":\"SetGasPrice\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"_publicKey\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_result\",\"type\":\"string\"}],\"name\":\"VerifiedProof\",\"type\":\"event\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_queryID\",\"type\":\"bytes32\"},{\"internalType\":\"string\",\"name\":\"_result\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"_proof\",\"type\":\"bytes\"}],\"name\":\"__callback\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_asset\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"claim\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"controllerNode\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"cryptoCompareAPIPublicKey\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ensRegistry\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gasPrice\",\"type\":\"uint256\"}],\"name\":\"setCustomGasPrice\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"tokenWhitelistNode\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_publicKey\",\"type\":\"bytes\"}],\"name\":\"updateCryptoCompareAPIPublicKey\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gasLimit\",\"type\":\"uint256\"}],\"name\":\"updateTokenRates\",\"outputs\":[],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address[]\",\"name\":\"_tokenList\",\"type\":\"address[]\"}],\"name\":\"updateTokenRatesList\",\"outputs\":[],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"}]"
+const OracleABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_resolver_\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_ens_\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"_controllerNode_\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"_tokenWhitelistNode_\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_asset\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"Claimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_reason\",\"type\":\"string\"}],\"name\":\"FailedUpdateRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_keepLast\",\"type\":\"uint256\"}],\"name\":\"PrunedHistory\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_symbol\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"_queryID\",\"type\":\"bytes32\"}],\"name\":\"RequestedUpdate\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_adapter\",\"type\":\"address\"}],\"name\":\"SetAdapter\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"_publicKey\",\"type\":\"bytes\"}],\"name\":\"SetCryptoComparePublicKey\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_feeToken\",\"type\":\"address\"}],\"name\":\"SetFeeToken\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_gasPrice\",\"type\":\"uint256\"}],\"name\":\"SetGasPrice\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_maintenance\",\"type\":\"bool\"}],\"name\":\"SetMaintenance\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_maxRateAge\",\"type\":\"uint256\"}],\"name\":\"SetMaxRateAge\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"_publicKey\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_result\",\"type\":\"string\"}],\"name\":\"VerifiedProof\",\"type\":\"event\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_queryID\",\"type\":\"bytes32\"},{\"internalType\":\"string\",\"name\":\"_result\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"_proof\",\"type\":\"bytes\"}],\"name\":\"__callback\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_asset\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"claim\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"controllerNode\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"cryptoCompareAPIPublicKey\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"ensRegistry\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"feeToken\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_quote\",\"type\":\"address\"}],\"name\":\"feeTokenRateIn\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_tokens\",\"type\":\"address[]\"},{\"internalType\":\"address[]\",\"name\":\"_adapters\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"_maxRateAge\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_feeToken\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"initialized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"maintenance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"maxRateAge\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_wallet\",\"type\":\"address\"},{\"internalType\":\"address[]\",\"name\":\"_tokens\",\"type\":\"address[]\"}],\"name\":\"portfolioValue\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_keepLast\",\"type\":\"uint256\"}],\"name\":\"pruneHistory\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"rateAdapter\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"rateChangeBps\",\"outputs\":[{\"internalType\":\"int256\",\"name\":\"\",\"type\":\"int256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"rateConsistency\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"rateRecencyWeighted\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"rateStats\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"min\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"max\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"avg\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_adapter\",\"type\":\"address\"}],\"name\":\"setAdapter\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gasPrice\",\"type\":\"uint256\"}],\"name\":\"setCustomGasPrice\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_feeToken\",\"type\":\"address\"}],\"name\":\"setFeeToken\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bool\",\"name\":\"_maintenance\",\"type\":\"bool\"}],\"name\":\"setMaintenance\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_maxRateAge\",\"type\":\"uint256\"}],\"name\":\"setMaxRateAge\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"staleTokenCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"stalenessReport\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"},{\"internalType\":\"bool[]\",\"name\":\"\",\"type\":\"bool[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"tokenRate\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"tokenRateFromAdapter\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"tokenWhitelistNode\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_publicKey\",\"type\":\"bytes\"}],\"name\":\"updateCryptoCompareAPIPublicKey\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"}],\"name\":\"updateScheduleInfo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"interval\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"lastUpdate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"nextDue\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gasLimit\",\"type\":\"uint256\"}],\"name\":\"updateTokenRates\",\"outputs\":[],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_gasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address[]\",\"name\":\"_tokenList\",\"type\":\"address[]\"}],\"name\":\"updateTokenRatesList\",\"outputs\":[],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"}]"
 
 // OracleBin is the compiled bytecode used for deploying new contracts.
 var OracleBin = "0x60806040527f7f2ce995617d2816b426c5c8698c5ec2952f7a34bb10f38326f74933d58936976039553480156200003557600080fd5b50604051620056b2380380620056b2833981810160405260808110156200005b57600080fd5b508051602082015160408301516060909301519192909162000086836001600160e01b036200012c16565b6200009a826001600160e01b036200024516565b620000ae816001600160e01b036200030516565b60405180606001604052806040815260200162005672604091398051620000de91603b9160209091019062000bc9565b50603580546001600160a01b0319166001600160a01b0386161790556200010a6402540be400620003bd565b62000122600f60fc1b6001600160e01b03620005ae16565b5050505062000c6b565b600054610100900460ff1680620001515750620001516001600160e01b03620007a416565b8062000160575060005460ff16155b6200019d5760405162461bcd60e51b815260040180806020018281038252602e81526020018062005644602e913960400191505060405180910390fd5b600054610100900460ff16158015620001c9576000805460ff1961ff0019909116610100171660011790555b6001600160a01b03821662000213576040805162461bcd60e51b815260206004820152600b60248201526a0656e7352656720697320360ac1b604482015290519081900360640190fd5b603380546001600160a01b0319166001600160a01b038416179055801562000241576000805461ff00191690555b5050565b600054610100900460ff16806200026a57506200026a6001600160e01b03620007a416565b8062000279575060005460ff16155b620002b65760405162461bcd60e51b815260040180806020018281038252602e81526020018062005644602e913960400191505060405180910390fd5b600054610100900460ff16158015620002e2576000805460ff1961ff0019909116610100171660011790555b8115620002ef5760398290555b801562000241576000805461ff00191690555050565b600054610100900460ff16806200032a57506200032a6001600160e01b03620007a416565b8062000339575060005460ff16155b620003765760405162461bcd60e51b815260040180806020018281038252602e81526020018062005644602e913960400191505060405180910390fd5b600054610100900460ff16158015620003a2576000805460ff1961ff0019909116610100171660011790555b603a829055801562000241576000805461ff00191690555050565b6035546001600160a01b03161580620003f35750603554620003f1906001600160a01b03166001600160e01b03620007ab16565b155b1562000410576200040e60006001600160e01b03620007af16565b505b603560009054906101000a90046001600160a01b03166001600160a01b03166338cc48316040518163ffffffff1660e01b8152600401602060405180830381600087803b1580156200046157600080fd5b505af115801562000476573d6000803e3d6000fd5b505050506040513d60208110156200048d57600080fd5b50516034546001600160a01b039081169116146200054457603560009054906101000a90046001600160a01b03166001600160a01b03166338cc48316040518163ffffffff1660e01b8152600401602060405180830381600087803b158015620004f657600080fd5b505af11580156200050b573d6000803e3d6000fd5b505050506040513d60208110156200052257600080fd5b5051603480546001600160a01b0319166001600160a01b039092169190911790555b6034546040805163329ab47960e21b81526004810184905290516001600160a01b039092169163ca6ad1e49160248082019260009290919082900301818387803b1580156200059257600080fd5b505af1158015620005a7573d6000803e3d6000fd5b5050505050565b6035546001600160a01b03161580620005e45750603554620005e2906001600160a01b03166001600160e01b03620007ab16565b155b156200060157620005ff60006001600160e01b03620007af16565b505b603560009054906101000a90046001600160a01b03166001600160a01b03166338cc48316040518163ffffffff1660e01b8152600401602060405180830381600087803b1580156200065257600080fd5b505af115801562000667573d6000803e3d6000fd5b505050506040513d60208110156200067e57600080fd5b50516034546001600160a01b039081169116146200073557603560009054906101000a90046001600160a01b03166001600160a01b03166338cc48316040518163ffffffff1660e01b8152600401602060405180830381600087803b158015620006e757600080fd5b505af1158015620006fc573d6000803e3d6000fd5b505050506040513d60208110156200071357600080fd5b5051603480546001600160a01b0319166001600160a01b039092169190911790555b6034546040805163688dcfd760e01b81527fff000000000000000000000000000000000000000000000000000000000000008416600482015290516001600160a01b039092169163688dcfd79160248082019260009290919082900301818387803b1580156200059257600080fd5b303b155b90565b3b90565b6000620007c46001600160e01b03620007ca16565b92915050565b600080620007f5731d3b2638a7cc9f2cb3d298a3da7a90b67e5506ed6001600160e01b03620007ab16565b11156200086157603580546001600160a01b031916731d3b2638a7cc9f2cb3d298a3da7a90b67e5506ed17905560408051808201909152600b81526a195d1a17db585a5b9b995d60aa1b602082015262000858906001600160e01b0362000bb916565b506001620007a8565b60006200088b73c03a2615d5efaf5f49f60b7bb6583eaec212fdf16001600160e01b03620007ab16565b1115620008ef57603580546001600160a01b03191673c03a2615d5efaf5f49f60b7bb6583eaec212fdf117905560408051808201909152600c81526b6574685f726f707374656e3360a01b602082015262000858906001600160e01b0362000bb916565b60006200091973b7a07bcf2ba2f2703b24c0691b5278999c59ac7e6001600160e01b03620007ab16565b11156200097a57603580546001600160a01b03191673b7a07bcf2ba2f2703b24c0691b5278999c59ac7e17905560408051808201909152600981526832ba342fb5b7bb30b760b91b602082015262000858906001600160e01b0362000bb916565b6000620009a473146500cfd35b22e4a392fe0adc06de1a1368ed486001600160e01b03620007ab16565b111562000a0757603580546001600160a01b03191673146500cfd35b22e4a392fe0adc06de1a1368ed4817905560408051808201909152600b81526a6574685f72696e6b65627960a81b602082015262000858906001600160e01b0362000bb916565b600062000a3173a2998efd205fb9d4b4963afb70778d6354ad3a416001600160e01b03620007ab16565b111562000a9357603580546001600160a01b03191673a2998efd205fb9d4b4963afb70778d6354ad3a4117905560408051808201909152600a8152696574685f676f65726c6960b01b602082015262000858906001600160e01b0362000bb916565b600062000abd736f485c8bf6fc43ea212e93bbf8ce046c7f1cb4756001600160e01b03620007ab16565b111562000af35750603580546001600160a01b031916736f485c8bf6fc43ea212e93bbf8ce046c7f1cb4751790556001620007a8565b600062000b1d7320e12a1f859b3feae5fb2a0a32c18f5a65555bbf6001600160e01b03620007ab16565b111562000b535750603580546001600160a01b0319167320e12a1f859b3feae5fb2a0a32c18f5a65555bbf1790556001620007a8565b600062000b7d7351efaf4c8b3c9afbd5ab9f4bbc82784ab6ef8faa6001600160e01b03620007ab16565b111562000bb35750603580546001600160a01b0319167351efaf4c8b3c9afbd5ab9f4bbc82784ab6ef8faa1790556001620007a8565b50600090565b8051620002419060369060208401905b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1062000c0c57805160ff191683800117855562000c3c565b8280016001018555821562000c3c579182015b8281111562000c3c57825182559160200191906001019062000c1f565b5062000c4a92915062000c4e565b5090565b620007a891905b8082111562000c4a576000815560010162000c55565b6149c98062000c7b6000396000f3fe6080604052600436106100915760003560e01c8063996cba6811610059578063996cba681461032c578063b598f8821461036f578063c2c3d0541461038c578063ca6ad1e414610407578063e2b4ce971461043157610091565b806338bbfa50146100965780633acbe96e146101d55780637d73b2311461025f578063877337b014610290578063937f54a4146102b7575b600080fd5b3480156100a257600080fd5b506101d3600480360360608110156100b957600080fd5b81359190810190604081016020820135600160201b8111156100da57600080fd5b8201836020820111156100ec57600080fd5b803590602001918460018302840111600160201b8311171561010d57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561015f57600080fd5b82018360208201111561017157600080fd5b803590602001918460018302840111600160201b8311171561019257600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550610446945050505050565b005b3480156101e157600080fd5b506101ea61061a565b6040805160208082528351818301528351919283929083019185019080838360005b8381101561022457818101518382015260200161020c565b50505050905090810190601f1680156102515780820380516001836020036101000a031916815260200191505b509250505060405180910390f35b34801561026b57600080fd5b506102746106a8565b604080516001600160a01b039092168252519081900360200190f35b34801561029c57600080fd5b506102a56106b8565b60408051918252519081900360200190f35b6101d3600480360360408110156102cd57600080fd5b81359190810190604081016020820135600160201b8111156102ee57600080fd5b82018360208201111561030057600080fd5b803590602001918460208302840111600160201b8311171561032157600080fd5b5090925090506106be565b34801561033857600080fd5b506101d36004803603606081101561034f57600080fd5b506001600160a01b03813581169160208101359091169060400135610757565b6101d36004803603602081101561038557600080fd5b5035610804565b34801561039857600080fd5b506101d3600480360360208110156103af57600080fd5b810190602081018135600160201b8111156103c957600080fd5b8201836020820111156103db57600080fd5b803590602001918460018302840111600160201b831117156103fc57600080fd5b509092509050610867565b34801561041357600080fd5b506101d36004803603602081101561042a57600080fd5b5035610935565b34801561043d57600080fd5b506102a56109d3565b61044e6109d9565b6001600160a01b0316336001600160a01b0316146104ac576040805162461bcd60e51b815260206004820152601660248201527573656e646572206973206e6f74206f7261636c697a6560501b604482015290519081900360640190fd5b6000838152603c60205260408120546001600160a01b031690806104cf83610bc3565b96505050945050505081610524576040805162461bcd60e51b8152602060048201526017602482015276746f6b656e206d75737420626520617661696c61626c6560481b604482015290519081900360640190fd5b603b8054604080516020601f6002600019610100600188161502019095169490940493840181900481028201810190925282815260009384936105c5938b938b93909290918301828280156105ba5780601f1061058f576101008083540402835291602001916105ba565b820191906000526020600020905b81548152906001019060200180831161059d57829003601f168201915b505050505086610d55565b909250905081156106105760006105e36105de89611287565b61152a565b60008a8152603c6020526040902080546001600160a01b031916905590508161060d87838361153d565b50505b5050505050505050565b603b805460408051602060026001851615610100026000190190941693909304601f810184900484028201840190925281815292918301828280156106a05780601f10610675576101008083540402835291602001916106a0565b820191906000526020600020905b81548152906001019060200180831161068357829003601f168201915b505050505081565b6033546001600160a01b03165b90565b603a5490565b6106c7336115cc565b610715576040805162461bcd60e51b815260206004820152601a60248201527939b2b73232b91034b9903737ba10309031b7b73a3937b63632b960311b604482015290519081900360640190fd5b6107528383838080602002602001604051908101604052809392919081815260200183836020028082843760009201919091525061166092505050565b505050565b61076033611982565b6107aa576040805162461bcd60e51b815260206004820152601660248201527539b2b73232b91034b9903737ba1030b71030b236b4b760511b604482015290519081900360640190fd5b6107b58383836119e4565b604080516001600160a01b0380861682528416602082015280820183905290517ff7a40077ff7a04c7e61f6f26fb13774259ddf1b6bce9ecf26a8276cdd39926839181900360600190a1505050565b61080d336115cc565b61085b576040805162461bcd60e51b815260206004820152601a60248201527939b2b73232b91034b9903737ba10309031b7b73a3937b63632b960311b604482015290519081900360640190fd5b61086481611aae565b50565b61087033611982565b6108ba576040805162461bcd60e51b815260206004820152601660248201527539b2b73232b91034b9903737ba1030b71030b236b4b760511b604482015290519081900360640190fd5b6108c6603b838361473a565b506040805133808252602082018381529282018490527fc6b0860ba9f580e9c5b6ba4e0954fe82827096a99d92e8c2d73009539ea8d9fa929091859185919060608201848480828437600083820152604051601f909101601f1916909201829003965090945050505050a15050565b61093e336115cc565b61098c576040805162461bcd60e51b815260206004820152601a60248201527939b2b73232b91034b9903737ba10309031b7b73a3937b63632b960311b604482015290519081900360640190fd5b61099581611d5f565b604080513381526020810183905281517ffbd406825addb09beef160afc17bb80ba28df4a3533dcd23592b82658a1c5ab4929181900390910190a150565b60395490565b6035546000906001600160a01b03161580610a065750603554610a04906001600160a01b0316611f28565b155b15610a1757610a156000611f2c565b505b603560009054906101000a90046001600160a01b03166001600160a01b03166338cc48316040518163ffffffff1660e01b8152600401602060405180830381600087803b158015610a6757600080fd5b505af1158015610a7b573d6000803e3d6000fd5b505050506040513d6020811015610a9157600080fd5b50516034546001600160a01b03908116911614610b4457603560009054906101000a90046001600160a01b03166001600160a01b03166338cc48316040518163ffffffff1660e01b8152600401602060405180830381600087803b158015610af857600080fd5b505af1158015610b0c573d6000803e3d6000fd5b505050506040513d6020811015610b2257600080fd5b5051603480546001600160a01b0319166001600160a01b039092169190911790555b603460009054906101000a90046001600160a01b03166001600160a01b031663c281d19e6040518163ffffffff1660e01b815260040160206040518083038186803b158015610b9257600080fd5b505afa158015610ba6573d6000803e3d6000fd5b505050506040513d6020811015610bbc57600080fd5b5051905090565b6060600080600080600080610bd9603a54611f36565b6001600160a01b0316631f69565f896040518263ffffffff1660e01b815260040180826001600160a01b03166001600160a01b0316815260200191505060006040518083038186803b158015610c2e57600080fd5b505afa158015610c42573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405260e0811015610c6b57600080fd5b8101908080516040519392919084600160201b821115610c8a57600080fd5b908301906020820185811115610c9f57600080fd5b8251600160201b811182820188101715610cb857600080fd5b82525081516020918201929091019080838360005b83811015610ce5578181015183820152602001610ccd565b50505050905090810190601f168015610d125780820380516001836020036101000a031916815260200191505b5060409081526020820151908201516060830151608084015160a085015160c090950151979e50929c50909a509850965094509192505050919395979092949650565b60008060a5855114610da5576040805162461bcd60e51b81526020600482015260146024820152730d2dcecc2d8d2c840e0e4dedecc40d8cadccee8d60631b604482015290519081900360640190fd5b604185600181518110610db457fe5b016020015160f81c14610e0e576040805162461bcd60e51b815260206004820152601860248201527f696e76616c6964207369676e6174757265206c656e6774680000000000000000604482015290519081900360640190fd5b60408051604180825260808201909252606091602082018180388339019050509050610e408660026041846000612057565b865190915060609087906044908110610e5557fe5b0160200151875160f89190911c906101009089906043908110610e7457fe5b016020015160f81c020114610ec9576040805162461bcd60e51b81526020600482015260166024820152750d2dcecc2d8d2c840d0cac2c8cae4e640d8cadccee8d60531b604482015290519081900360640190fd5b6040805160608082526080820190925281602082018180388339019050509050610ef98760456060846000612057565b9050610f068183886120a5565b610f4b576040805162461bcd60e51b8152602060048201526011602482015270696e76616c6964207369676e617475726560781b604482015290519081900360640190fd5b604080516014808252818301909252606091602082018180388339019050509050610f7c82600b6014846000612057565b9050600080610f8b8389612169565b909250905081610fd1576040805162461bcd60e51b815260206004820152600c60248201526b696e76616c6964206461746560a01b604482015290519081900360640190fd5b60408051602c8082526060828101909352602082018180388339019050509050611001856034602c846000612057565b905061100c816124ee565b8051906020012060028d6040516020018082805190602001908083835b602083106110485780518252601f199092019160209182019101611029565b6001836020036101000a0380198251168184511680821785525050505050509050019150506040516020818303038152906040526040518082805190602001908083835b602083106110ab5780518252601f19909201916020918201910161108c565b51815160209384036101000a60001901801990921691161790526040519190930194509192505080830381855afa1580156110ea573d6000803e3d6000fd5b5050506040513d60208110156110ff57600080fd5b505160408051602081810193909352815180820384018152908201909152805191012014611174576040805162461bcd60e51b815260206004820152601860248201527f726573756c742068617368206e6f74206d61746368696e670000000000000000604482015290519081900360640190fd5b7f0902fdd015aa1e56f7e6026b69c0595e82155dcbd83a83a23b40f9fe96babbd98a8d604051808060200180602001838103835285818151815260200191508051906020019080838360005b838110156111d85781810151838201526020016111c0565b50505050905090810190601f1680156112055780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b83811015611238578181015183820152602001611220565b50505050905090810190601f1680156112655780820380516001836020036101000a031916815260200191505b5094505050505060405180910390a15060019b909a5098505050505050505050565b60606000826040516020018082805190602001908083835b602083106112be5780518252601f19909201916020918201910161129f565b6001836020036101000a0380198251168184511680821785525050505050509050019150506040516020818303038152906040525190506008811180156113065750601c8111155b61134c576040805162461bcd60e51b81526020600482015260126024820152711b5a5cd99bdc9b585d1d1959081a5b9c1d5d60721b604482015290519081900360640190fd5b6040805160078082528183019092526060916020820181803883390190505090506113e3846040516020018082805190602001908083835b602083106113a35780518252601f199092019160209182019101611384565b6001836020036101000a03801982511681845116808217855250505050505090500191505060405160208183030381529060405260006007846000612057565b5060408051663d9122aa24111d60c91b81529051908190036007019020815160208301201461144b576040805162461bcd60e51b815260206004820152600f60248201526e0e0e4caccd2f040dad2e6dac2e8c6d608b1b604482015290519081900360640190fd5b6114536147b8565b61145c85612b0b565b9050611490611483604051806040016040528060018152602001601d60f91b815250612b0b565b829063ffffffff612b3016565b50806000015192506114ca6114bd604051806040016040528060018152602001607d60f81b815250612b0b565b829063ffffffff612b4a16565b508051600019840114611516576040805162461bcd60e51b815260206004820152600f60248201526e1b9bdd081a9cdbdb88199bdc9b585d608a1b604482015290519081900360640190fd5b61151f81612ba8565b93505050505b919050565b6000611537826012612bf8565b92915050565b611548603a54611f36565b6001600160a01b031663d545782e8484846040518463ffffffff1660e01b815260040180846001600160a01b03166001600160a01b031681526020018381526020018281526020019350505050600060405180830381600087803b1580156115af57600080fd5b505af11580156115c3573d6000803e3d6000fd5b50505050505050565b60006115d9603954611f36565b6001600160a01b031663b429afeb836040518263ffffffff1660e01b815260040180826001600160a01b03166001600160a01b0316815260200191505060206040518083038186803b15801561162e57600080fd5b505afa158015611642573d6000803e3d6000fd5b505050506040513d602081101561165857600080fd5b505192915050565b80516116ac576040805160208082526010908201526f195b5c1d1e481d1bdad95b881b1a5cdd60821b81830152905160008051602061492a8339815191529181900360600190a161197e565b4781516116d36040518060400160405280600381526020016215549360ea1b8152506134a9565b0211156117245760408051602080825260149082015273696e73756666696369656e742062616c616e636560601b81830152905160008051602061492a8339815191529181900360600190a161197e565b61172c6147b8565b61174d60405180606001604052806032815260200161485b60329139612b0b565b90506117576147b8565b61178c60405180604001604052806014815260200173267473796d733d455448267369676e3d7472756560601b815250612b0b565b905060005b835181101561197a57606060006117ba8684815181106117ad57fe5b6020026020010151610bc3565b5050509350505091508061180f576040805162461bcd60e51b8152602060048201526017602482015276746f6b656e206d75737420626520617661696c61626c6560481b604482015290519081900360640190fd5b6118176147b8565b61182083612b0b565b905060006118746040518060400160405280600381526020016215549360ea1b81525061186e8861186261185d878d6136d490919063ffffffff16565b612b0b565b9063ffffffff6136d416565b8b613748565b905087858151811061188257fe5b6020026020010151603c600083815260200190815260200160002060006101000a8154816001600160a01b0302191690836001600160a01b031602179055507f47737841f636da1ca9f2de10d9bfb96c4251e0b31de72a902d4fd4ac8797bbbe6118eb83612ba8565b826040518080602001838152602001828103825284818151815260200191508051906020019080838360005b8381101561192f578181015183820152602001611917565b50505050905090810190601f16801561195c5780820380516001836020036101000a031916815260200191505b50935050505060405180910390a15050600190920191506117919050565b5050505b5050565b600061198f603954611f36565b6001600160a01b03166324d7806c836040518263ffffffff1660e01b815260040180826001600160a01b03166001600160a01b0316815260200191505060206040518083038186803b15801561162e57600080fd5b6001600160a01b038216611a94576040516000906001600160a01b0385169083908381818185875af1925050503d8060008114611a3d576040519150601f19603f3d011682016040523d82523d6000602084013e611a42565b606091505b5050905080611a8e576040805162461bcd60e51b81526020600482015260136024820152721cd85999551c985b9cd9995c8819985a5b1959606a1b604482015290519081900360640190fd5b50610752565b6107526001600160a01b038316848363ffffffff613b1516565b6060611ab8613b67565b9050805160001415611b0357604080516020808252600990820152686e6f20746f6b656e7360b81b81830152905160008051602061492a8339815191529181900360600190a161197e565b478151611b2a6040518060400160405280600381526020016215549360ea1b8152506134a9565b021115611b7b5760408051602080825260149082015273696e73756666696369656e742062616c616e636560601b81830152905160008051602061492a8339815191529181900360600190a161197e565b611b836147b8565b611ba460405180606001604052806032815260200161485b60329139612b0b565b9050611bae6147b8565b611be360405180604001604052806014815260200173267473796d733d455448267369676e3d7472756560601b815250612b0b565b905060005b835181101561197a576060611c028583815181106117ad57fe5b5050505050509050611c126147b8565b611c1b82612b0b565b90506000611c5e6040518060400160405280600381526020016215549360ea1b815250611c588761186261185d878c6136d490919063ffffffff16565b8a613748565b9050868481518110611c6c57fe5b6020026020010151603c600083815260200190815260200160002060006101000a8154816001600160a01b0302191690836001600160a01b031602179055507f47737841f636da1ca9f2de10d9bfb96c4251e0b31de72a902d4fd4ac8797bbbe611cd583612ba8565b826040518080602001838152602001828103825284818151815260200191508051906020019080838360005b83811015611d19578181015183820152602001611d01565b50505050905090810190601f168015611d465780820380516001836020036101000a031916815260200191505b50935050505060405180910390a1505050600101611be8565b6035546001600160a01b03161580611d895750603554611d87906001600160a01b0316611f28565b155b15611d9a57611d986000611f2c565b505b603560009054906101000a90046001600160a01b03166001600160a01b03166338cc48316040518163ffffffff1660e01b8152600401602060405180830381600087803b158015611dea57600080fd5b505af1158015611dfe573d6000803e3d6000fd5b505050506040513d6020811015611e1457600080fd5b50516034546001600160a01b03908116911614611ec757603560009054906101000a90046001600160a01b03166001600160a01b03166338cc48316040518163ffffffff1660e01b8152600401602060405180830381600087803b158015611e7b57600080fd5b505af1158015611e8f573d6000803e3d6000fd5b505050506040513d6020811015611ea557600080fd5b5051603480546001600160a01b0319166001600160a01b039092169190911790555b6034546040805163329ab47960e21b81526004810184905290516001600160a01b039092169163ca6ad1e49160248082019260009290919082900301818387803b158015611f1457600080fd5b505af115801561197a573d6000803e3d6000fd5b3b90565b6000611537613c78565b6033546000906001600160a01b0316611f96576040805162461bcd60e51b815260206004820152601d60248201527f454e535265736f6c7661626c65206e6f7420696e697469616c697a6564000000604482015290519081900360640190fd5b60335460408051630178b8bf60e01b81526004810185905290516001600160a01b0390921691630178b8bf91602480820192602092909190829003018186803b158015611fe257600080fd5b505afa158015611ff6573d6000803e3d6000fd5b505050506040513d602081101561200c57600080fd5b505160408051631d9dabef60e11b81526004810185905290516001600160a01b0390921691633b3b57de91602480820192602092909190829003018186803b15801561162e57600080fd5b606060008285019050808451101561206e57600080fd5b60208087019084015b86886020010182101561209857888201518682015260209182019101612077565b5093979650505050505050565b600080600061213b6002876040518082805190602001908083835b602083106120df5780518252601f1990920191602091820191016120c0565b51815160209384036101000a60001901801990921691161790526040519190930194509192505080830381855afa15801561211e573d6000803e3d6000fd5b5050506040513d602081101561213357600080fd5b505186613fcc565b9250905080801561215d5750835160208501206001600160a01b038381169116145b925050505b9392505050565b600080836040516020018082805190602001908083835b6020831061219f5780518252601f199092019160209182019101612180565b6001836020036101000a038019825116818451168082178552505050505050905001915050604051602081830303815290604052516014146121dd57fe5b6121e56147b8565b6121ee85612b0b565b90506121f86147b8565b61221a604051806040016040528060018152602001601d60f91b815250612b0b565b90506122246147b8565b612246604051806040016040528060018152602001600160fd1b815250612b0b565b9050600061226a612265612260868563ffffffff612b3016565b612ba8565b614056565b905060008111801561227c5750602081105b6122b9576040805162461bcd60e51b81526020600482015260096024820152683230bc9032b93937b960b91b604482015290519081900360640190fd5b60006122d66122d1612260878663ffffffff612b3016565b614063565b60ff1690506000811180156122eb5750600d81105b61232a576040805162461bcd60e51b815260206004820152600b60248201526a36b7b73a341032b93937b960a91b604482015290519081900360640190fd5b6000612342612265612260888763ffffffff612b3016565b90506107e1811180156123565750610bb881105b612394576040805162461bcd60e51b815260206004820152600a6024820152693cb2b0b91032b93937b960b11b604482015290519081900360640190fd5b60006123ac612265612260898963ffffffff612b3016565b9050601981106123f0576040805162461bcd60e51b815260206004820152600a6024820152693437bab91032b93937b960b11b604482015290519081900360640190fd5b60006124086122656122608a8a63ffffffff612b3016565b9050603c811061244e576040805162461bcd60e51b815260206004820152600c60248201526b36b4b73aba329032b93937b960a11b604482015290519081900360640190fd5b60006124666122656122608b8b63ffffffff612b3016565b9050603c81106124ac576040805162461bcd60e51b815260206004820152600c60248201526b39b2b1b7b7321032b93937b960a11b604482015290519081900360640190fd5b60008183606402856127100289620f424002896305f5e10002896402540be40002010101010190508c8111819b509b50505050505050505050505b9250929050565b60606000806000806000865190506060816040519080825280601f01601f191660200182016040528015612529576020820181803883390190505b5090506000808311801561253e575060048306155b61258f576040805162461bcd60e51b815260206004820152601760248201527f696e76616c69642062617365363420656e636f64696e67000000000000000000604482015290519081900360640190fd5b60408051603d60f81b8152905190819003600101902089518a9060011986019081106125b757fe5b01602090810151604080516001600160f81b0319909216828401528051808303600101815260219092019052805191012014156125f95760028303925061265f565b60408051603d60f81b8152905190819003600101902089518a90600019860190811061262157fe5b01602090810151604080516001600160f81b03199092168284015280518083036001018152602190920190528051910120141561265f576001830392505b600319831660005b8181101561287d576040518060a00160405280607b81526020016148af607b91398b5160018301928d91811061269957fe5b0160200151815160f89190911c9081106126af57fe5b602001015160f81c60f81b98506040518060a00160405280607b81526020016148af607b91398b5160018301928d9181106126e657fe5b0160200151815160f89190911c9081106126fc57fe5b602001015160f81c60f81b97506040518060a00160405280607b81526020016148af607b91398b5160018301928d91811061273357fe5b0160200151815160f89190911c90811061274957fe5b602001015160f81c60f81b96506040518060a00160405280607b81526020016148af607b91398b5160018301928d91811061278057fe5b0160200151815160f89190911c90811061279657fe5b016020015184516001600160f81b031991821697506001850194603f60fa1b60028d901b1660ff60f41b60048d901c161790921691869181106127d557fe5b60200101906001600160f81b031916908160001a90535083516001840193600f60fc1b60048b901b1660ff60f61b60028b901c16176001600160f81b03191691869190811061282057fe5b60200101906001600160f81b031916908160001a90535083516001840193600360fe1b60068a901b1688176001600160f81b03191691869190811061286157fe5b60200101906001600160f81b031916908160001a905350612667565b81850360021415612975576040518060a00160405280607b81526020016148af607b91398b5160018301928d9181106128b257fe5b0160200151815160f89190911c9081106128c857fe5b602001015160f81c60f81b98506040518060a00160405280607b81526020016148af607b91398b5160018301928d9181106128ff57fe5b0160200151815160f89190911c90811061291557fe5b602001015160f81c60f81b97506004886001600160f81b031916901c60028a6001600160f81b031916901b1760ff60f81b1684848060010195508151811061295957fe5b60200101906001600160f81b031916908160001a905350612afc565b81850360031415612afc576040518060a00160405280607b81526020016148af607b91398b5160018301928d9181106129aa57fe5b0160200151815160f89190911c9081106129c057fe5b602001015160f81c60f81b98506040518060a00160405280607b81526020016148af607b91398b5160018301928d9181106129f757fe5b0160200151815160f89190911c908110612a0d57fe5b602001015160f81c60f81b97506040518060a00160405280607b81526020016148af607b91398b5160018301928d918110612a4457fe5b0160200151815160f89190911c908110612a5a57fe5b016020015184516001600160f81b031991821698506001850194603f60fa1b60028d901b1660ff60f41b60048d901c16179092169186918110612a9957fe5b60200101906001600160f81b031916908160001a90535083516001840193600f60fc1b60048b901b1660ff60f61b60028b901c16176001600160f81b031916918691908110612ae457fe5b60200101906001600160f81b031916908160001a9053505b50508152979650505050505050565b612b136147b8565b506040805180820190915281518152602082810190820152919050565b612b386147b8565b612b43838383614307565b5092915050565b612b526147b8565b815183511015612b63575081611537565b8151835160208086015190850151910191909103906001908214612b91575082516020840151819020908220145b8015612b9f57835185510385525b50929392505050565b60608082600001516040519080825280601f01601f191660200182016040528015612bda576020820181803883390190505b5090506000602082019050612b438185602001518660000151614378565b60008281808080808080808080805b8b5181101561323a578b51600360fc1b908d9083908110612c2457fe5b01602001516001600160f81b03191610801590612c6257508b51603960f81b908d9083908110612c5057fe5b01602001516001600160f81b03191611155b8015612c6c575083155b15612d1c578415612cca57612c888a600a63ffffffff6143b616565b9950612cbd603060f81b60f81c8d8381518110612ca157fe5b01602001518c9160f89190911c0360ff1663ffffffff61440f16565b9950600190970196612d17565b60019550612cdf8b600a63ffffffff6143b616565b9a50612d14603060f81b60f81c8d8381518110612cf857fe5b01602001518d9160f89190911c0360ff1663ffffffff61440f16565b9a505b613232565b8b51600360fc1b908d9083908110612d3057fe5b01602001516001600160f81b03191610801590612d6e57508b51603960f81b908d9083908110612d5c57fe5b01602001516001600160f81b03191611155b8015612d775750835b15612dc957612d8d89600a63ffffffff6143b616565b9850612dc2603060f81b60f81c8d8381518110612da657fe5b01602001518b9160f89190911c0360ff1663ffffffff61440f16565b9850613232565b8b51601760f91b908d9083908110612ddd57fe5b01602001516001600160f81b0319161415612ee45785612e3c576040805162461bcd60e51b81526020600482015260156024820152741b5a5cdcda5b99c81a5b9d1959dc985b081c185c9d605a1b604482015290519081900360640190fd5b8415612e8f576040805162461bcd60e51b815260206004820152601760248201527f6475706c696361746520646563696d616c20706f696e74000000000000000000604482015290519081900360640190fd5b8315612edb576040805162461bcd60e51b8152602060048201526016602482015275191958da5b585b0818599d195c88195e1c1bdb995b9d60521b604482015290519081900360640190fd5b60019450613232565b8b51602d60f81b908d9083908110612ef857fe5b01602001516001600160f81b0319161415612fee578215612f4e576040805162461bcd60e51b815260206004820152600b60248201526a6475706c6963617465202d60a81b604482015290519081900360640190fd5b8115612f8e576040805162461bcd60e51b815260206004820152600a60248201526932bc3a39309039b4b3b760b11b604482015290519081900360640190fd5b808760010114612fe5576040805162461bcd60e51b815260206004820152601e60248201527f2d207369676e206e6f7420696d6d6564696174656c7920616674657220650000604482015290519081900360640190fd5b60019250613232565b8b51602b60f81b908d908390811061300257fe5b01602001516001600160f81b03191614156130f8578115613058576040805162461bcd60e51b815260206004820152600b60248201526a6475706c6963617465202b60a81b604482015290519081900360640190fd5b8215613098576040805162461bcd60e51b815260206004820152600a60248201526932bc3a39309039b4b3b760b11b604482015290519081900360640190fd5b8087600101146130ef576040805162461bcd60e51b815260206004820152601e60248201527f2b207369676e206e6f7420696d6d6564696174656c7920616674657220650000604482015290519081900360640190fd5b60019150613232565b8b51604560f81b908d908390811061310c57fe5b01602001516001600160f81b031916148061314757508b51606560f81b908d908390811061313657fe5b01602001516001600160f81b031916145b156131f55785613196576040805162461bcd60e51b81526020600482015260156024820152741b5a5cdcda5b99c81a5b9d1959dc985b081c185c9d605a1b604482015290519081900360640190fd5b83156131e9576040805162461bcd60e51b815260206004820152601960248201527f6475706c6963617465206578706f6e656e742073796d626f6c00000000000000604482015290519081900360640190fd5b60019350809650613232565b6040805162461bcd60e51b815260206004820152600d60248201526c1a5b9d985b1a5908191a59da5d609a1b604482015290519081900360640190fd5b600101612c07565b82806132435750815b1561325c5786600201811161325757600080fd5b613271565b83156132715786600101811161327157600080fd5b82156132f2578d89106132e857604e8e8a03106132c5576040805162461bcd60e51b815260206004820152600d60248201526c6578706f6e656e74203e20373760981b604482015290519081900360640190fd5b8d8903600a0a8b816132d357fe5b049c506115379b505050505050505050505050565b888e039d50613305565b6133028e8a63ffffffff61440f16565b9d505b878e106133d957604e881061334b5760405162461bcd60e51b815260040180806020018281038252602281526020018061488d6022913960400191505060405180910390fd5b61335f8b600a8a900a63ffffffff6143b616565b9a506133718b8b63ffffffff61440f16565b9a50604e888f03106133ba576040805162461bcd60e51b815260206004820152600d60248201526c6578706f6e656e74203e20373760981b604482015290519081900360640190fd5b6133d2888f03600a0a8c6143b690919063ffffffff16565b9a50613496565b8d88039750604e881061341d5760405162461bcd60e51b815260040180806020018281038252602281526020018061488d6022913960400191505060405180910390fd5b87600a0a8a8161342957fe5b049950604e8e1061346b5760405162461bcd60e51b815260040180806020018281038252602281526020018061488d6022913960400191505060405180910390fd5b6134818e600a0a8c6143b690919063ffffffff16565b9a506134938b8b63ffffffff61440f16565b9a505b50989d9c50505050505050505050505050565b6035546000906001600160a01b031615806134d657506035546134d4906001600160a01b0316611f28565b155b156134e7576134e56000611f2c565b505b603560009054906101000a90046001600160a01b03166001600160a01b03166338cc48316040518163ffffffff1660e01b8152600401602060405180830381600087803b15801561353757600080fd5b505af115801561354b573d6000803e3d6000fd5b505050506040513d602081101561356157600080fd5b50516034546001600160a01b0390811691161461361457603560009054906101000a90046001600160a01b03166001600160a01b03166338cc48316040518163ffffffff1660e01b8152600401602060405180830381600087803b1580156135c857600080fd5b505af11580156135dc573d6000803e3d6000fd5b505050506040513d60208110156135f257600080fd5b5051603480546001600160a01b0319166001600160a01b039092169190911790555b60345460405163524f388960e01b81526020600482018181528551602484015285516001600160a01b039094169363524f388993879383926044909201919085019080838360005b8381101561367457818101518382015260200161365c565b50505050905090810190601f1680156136a15780820380516001836020036101000a031916815260200191505b5092505050602060405180830381600087803b1580156136c057600080fd5b505af1158015611642573d6000803e3d6000fd5b60608082600001518460000151016040519080825280601f01601f19166020018201604052801561370c576020820181803883390190505b509050600060208201905061372a8186602001518760000151614378565b8451602085015185516137409284019190614378565b509392505050565b6035546000906001600160a01b031615806137755750603554613773906001600160a01b0316611f28565b155b15613786576137846000611f2c565b505b603560009054906101000a90046001600160a01b03166001600160a01b03166338cc48316040518163ffffffff1660e01b8152600401602060405180830381600087803b1580156137d657600080fd5b505af11580156137ea573d6000803e3d6000fd5b505050506040513d602081101561380057600080fd5b50516034546001600160a01b039081169116146138b357603560009054906101000a90046001600160a01b03166001600160a01b03166338cc48316040518163ffffffff1660e01b8152600401602060405180830381600087803b15801561386757600080fd5b505af115801561387b573d6000803e3d6000fd5b505050506040513d602081101561389157600080fd5b5051603480546001600160a01b0319166001600160a01b039092169190911790555b60345460408051630bbceb3360e21b815260248101859052600481019182528651604482015286516000936001600160a01b031692632ef3accc928992889291829160649091019060208601908083838c5b8381101561391d578181015183820152602001613905565b50505050905090810190601f16801561394a5780820380516001836020036101000a031916815260200191505b509350505050602060405180830381600087803b15801561396a57600080fd5b505af115801561397e573d6000803e3d6000fd5b505050506040513d602081101561399457600080fd5b50519050670de0b6b3a76400003a8402018111156139b6575060009050612162565b60345460405163c51be90f60e01b8152600060048201818152606483018790526080602484019081528951608485015289516001600160a01b039095169463c51be90f948794938c938c938c93604481019160a49091019060208801908083838c5b83811015613a30578181015183820152602001613a18565b50505050905090810190601f168015613a5d5780820380516001836020036101000a031916815260200191505b50838103825285518152855160209182019187019080838360005b83811015613a90578181015183820152602001613a78565b50505050905090810190601f168015613abd5780820380516001836020036101000a031916815260200191505b5096505050505050506020604051808303818588803b158015613adf57600080fd5b505af1158015613af3573d6000803e3d6000fd5b50505050506040513d6020811015613b0a57600080fd5b505195945050505050565b604080516001600160a01b038416602482015260448082018490528251808303909101815260649091019091526020810180516001600160e01b031663a9059cbb60e01b179052610752908490614469565b6060613b74603a54611f36565b6001600160a01b031663443dd2a46040518163ffffffff1660e01b815260040160006040518083038186803b158015613bac57600080fd5b505afa158015613bc0573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526020811015613be957600080fd5b8101908080516040519392919084600160201b821115613c0857600080fd5b908301906020820185811115613c1d57600080fd5b82518660208202830111600160201b82111715613c3957600080fd5b82525081516020918201928201910280838360005b83811015613c66578181015183820152602001613c4e565b50505050905001604052505050905090565b600080613c98731d3b2638a7cc9f2cb3d298a3da7a90b67e5506ed611f28565b1115613cf757603580546001600160a01b031916731d3b2638a7cc9f2cb3d298a3da7a90b67e5506ed17905560408051808201909152600b81526a195d1a17db585a5b9b995d60aa1b6020820152613cef90614627565b5060016106b5565b6000613d1673c03a2615d5efaf5f49f60b7bb6583eaec212fdf1611f28565b1115613d6e57603580546001600160a01b03191673c03a2615d5efaf5f49f60b7bb6583eaec212fdf117905560408051808201909152600c81526b6574685f726f707374656e3360a01b6020820152613cef90614627565b6000613d8d73b7a07bcf2ba2f2703b24c0691b5278999c59ac7e611f28565b1115613de257603580546001600160a01b03191673b7a07bcf2ba2f2703b24c0691b5278999c59ac7e17905560408051808201909152600981526832ba342fb5b7bb30b760b91b6020820152613cef90614627565b6000613e0173146500cfd35b22e4a392fe0adc06de1a1368ed48611f28565b1115613e5857603580546001600160a01b03191673146500cfd35b22e4a392fe0adc06de1a1368ed4817905560408051808201909152600b81526a6574685f72696e6b65627960a81b6020820152613cef90614627565b6000613e7773a2998efd205fb9d4b4963afb70778d6354ad3a41611f28565b1115613ecd57603580546001600160a01b03191673a2998efd205fb9d4b4963afb70778d6354ad3a4117905560408051808201909152600a8152696574685f676f65726c6960b01b6020820152613cef90614627565b6000613eec736f485c8bf6fc43ea212e93bbf8ce046c7f1cb475611f28565b1115613f205750603580546001600160a01b031916736f485c8bf6fc43ea212e93bbf8ce046c7f1cb47517905560016106b5565b6000613f3f7320e12a1f859b3feae5fb2a0a32c18f5a65555bbf611f28565b1115613f735750603580546001600160a01b0319167320e12a1f859b3feae5fb2a0a32c18f5a65555bbf17905560016106b5565b6000613f927351efaf4c8b3c9afbd5ab9f4bbc82784ab6ef8faa611f28565b1115613fc65750603580546001600160a01b0319167351efaf4c8b3c9afbd5ab9f4bbc82784ab6ef8faa17905560016106b5565b50600090565b60008060008060008551604114613fed5750600093508392506124e7915050565b50505060208301516040840151606085015160001a601b81101561400f57601b015b8060ff16601b1415801561402757508060ff16601c14155b1561403c5750600093508392506124e7915050565b6140488782858561463a565b945094505050509250929050565b6000611537826000612bf8565b600080826040516020018082805190602001908083835b602083106140995780518252601f19909201916020918201910161407a565b6001836020036101000a0380198251168184511680821785525050505050509050019150506040516020818303038152906040528051906020012090506040518080622530b760e91b81525060030190506040518091039020811415614103576001915050611525565b60408051622332b160e91b8152905190819003600301902081141561412c576002915050611525565b604080516226b0b960e91b81529051908190036003019020811415614155576003915050611525565b604080516220b83960e91b8152905190819003600301902081141561417e576004915050611525565b60408051624d617960e81b815290519081900360030190208114156141a7576005915050611525565b6040805162253ab760e91b815290519081900360030190208114156141d0576006915050611525565b6040805162129d5b60ea1b815290519081900360030190208114156141f9576007915050611525565b604080516241756760e81b81529051908190036003019020811415614222576008915050611525565b604080516205365760ec1b8152905190819003600301902081141561424b576009915050611525565b604080516213d8dd60ea1b8152905190819003600301902081141561427457600a915050611525565b60408051622737bb60e91b8152905190819003600301902081141561429d57600b915050611525565b604080516244656360e81b815290519081900360030190208114156142c657600c915050611525565b6040805162461bcd60e51b81526020600482015260116024820152700dcdee840c240ecc2d8d2c840dadedce8d607b1b604482015290519081900360640190fd5b61430f6147b8565b600061432d8560000151866020015186600001518760200151614677565b60208087018051918601919091528051820385528651905191925001811415614359576000855261436f565b8351835186519101900385528351810160208601525b50909392505050565b5b60208110614398578151835260209283019290910190601f1901614379565b905182516020929092036101000a6000190180199091169116179052565b6000826143c557506000611537565b828202828482816143d257fe5b04146121625760405162461bcd60e51b815260040180806020018281038252602181526020018061494a6021913960400191505060405180910390fd5b600082820183811015612162576040805162461bcd60e51b815260206004820152601b60248201527f536166654d6174683a206164646974696f6e206f766572666c6f770000000000604482015290519081900360640190fd5b61447b826001600160a01b0316614734565b6144cc576040805162461bcd60e51b815260206004820152601f60248201527f5361666545524332303a2063616c6c20746f206e6f6e2d636f6e747261637400604482015290519081900360640190fd5b60006060836001600160a01b0316836040518082805190602001908083835b6020831061450a5780518252601f1990920191602091820191016144eb565b6001836020036101000a0380198251168184511680821785525050505050509050019150506000604051808303816000865af19150503d806000811461456c576040519150601f19603f3d011682016040523d82523d6000602084013e614571565b606091505b5091509150816145c8576040805162461bcd60e51b815260206004820181905260248201527f5361666545524332303a206c6f772d6c6576656c2063616c6c206661696c6564604482015290519081900360640190fd5b805115614621578080602001905160208110156145e457600080fd5b50516146215760405162461bcd60e51b815260040180806020018281038252602a81526020018061496b602a913960400191505060405180910390fd5b50505050565b805161197e9060369060208401906147d2565b60008060008060405188815287602082015286604082015285606082015260208160808360006001610bb8f1905190999098509650505050505050565b6000838186851161472557602085116146ea5783518251600019600860208990030260020a011991821690888a018890039083165b8281146146dc578186106146ca578a8a01965050505050505061472c565b506001909401805190949083166146ac565b85965050505050505061472c565b508383206000905b85880382116147235785832081811415614712578394505050505061472c565b5060019283019291909101906146f2565b505b5050508284015b949350505050565b3b151590565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061477b5782800160ff198235161785556147a8565b828001600101855582156147a8579182015b828111156147a857823582559160200191906001019061478d565b506147b4929150614840565b5090565b604051806040016040528060008152602001600081525090565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061481357805160ff19168380011785556147a8565b828001600101855582156147a8579182015b828111156147a8578251825591602001919060010190614825565b6106b591905b808211156147b4576000815560010161484656fe68747470733a2f2f6d696e2d6170692e63727970746f636f6d706172652e636f6d2f646174612f70726963653f6673796d3d6d6f7265207468616e20373720646563696d616c2064696769747320706172736564000000000000000000000000000000000000000000000000000000000000000000000000000000000000003e003e003f3435363738393a3b3c3d00000000000000000102030405060708090a0b0c0d0e0f10111213141516171819000000003f001a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132334eb5629fd8501532aeb93b1b6a5b5b2ae398561e56514ed4b4b0c5ac2d381b6e536166654d6174683a206d756c7469706c69636174696f6e206f766572666c6f775361666545524332303a204552433230206f7065726174696f6e20646964206e6f742073756363656564a265627a7a72315820ee90fea3ab325ef41eaef12511ff88a30f2960c64601fcf7610a4704b564a22064736f6c63430005110032436f6e747261637420696e7374616e63652068617320616c7265616479206265656e20696e697469616c697a6564a0f4f688350018ad1b9785991c0bde5f704b005dc79972b114dbed4a615a983710bfc647ebe5a320daa28771dce6a2d104f5efa2e4a85ba3760b76d46f8571ca"
@@ -267,6 +267,422 @@ func (_Oracle *OracleCallerSession) EnsRegistry() (common.Address, error) {
 	return _Oracle.Contract.EnsRegistry(&_Oracle.CallOpts)
 }
 
+// FeeToken is a free data retrieval call binding the contract method 0x647846a5.
+//
+// Solidity: function feeToken() constant returns(address)
+func (_Oracle *OracleCaller) FeeToken(opts *bind.CallOpts) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _Oracle.contract.Call(opts, out, "feeToken")
+	return *ret0, err
+}
+
+// FeeToken is a free data retrieval call binding the contract method 0x647846a5.
+//
+// Solidity: function feeToken() constant returns(address)
+func (_Oracle *OracleSession) FeeToken() (common.Address, error) {
+	return _Oracle.Contract.FeeToken(&_Oracle.CallOpts)
+}
+
+// FeeToken is a free data retrieval call binding the contract method 0x647846a5.
+//
+// Solidity: function feeToken() constant returns(address)
+func (_Oracle *OracleCallerSession) FeeToken() (common.Address, error) {
+	return _Oracle.Contract.FeeToken(&_Oracle.CallOpts)
+}
+
+// FeeTokenRateIn is a free data retrieval call binding the contract method 0x73869df5.
+//
+// Solidity: function feeTokenRateIn(address _quote) constant returns(uint256)
+func (_Oracle *OracleCaller) FeeTokenRateIn(opts *bind.CallOpts, _quote common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Oracle.contract.Call(opts, out, "feeTokenRateIn", _quote)
+	return *ret0, err
+}
+
+// FeeTokenRateIn is a free data retrieval call binding the contract method 0x73869df5.
+//
+// Solidity: function feeTokenRateIn(address _quote) constant returns(uint256)
+func (_Oracle *OracleSession) FeeTokenRateIn(_quote common.Address) (*big.Int, error) {
+	return _Oracle.Contract.FeeTokenRateIn(&_Oracle.CallOpts, _quote)
+}
+
+// FeeTokenRateIn is a free data retrieval call binding the contract method 0x73869df5.
+//
+// Solidity: function feeTokenRateIn(address _quote) constant returns(uint256)
+func (_Oracle *OracleCallerSession) FeeTokenRateIn(_quote common.Address) (*big.Int, error) {
+	return _Oracle.Contract.FeeTokenRateIn(&_Oracle.CallOpts, _quote)
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() constant returns(bool)
+func (_Oracle *OracleCaller) Initialized(opts *bind.CallOpts) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _Oracle.contract.Call(opts, out, "initialized")
+	return *ret0, err
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() constant returns(bool)
+func (_Oracle *OracleSession) Initialized() (bool, error) {
+	return _Oracle.Contract.Initialized(&_Oracle.CallOpts)
+}
+
+// Initialized is a free data retrieval call binding the contract method 0x158ef93e.
+//
+// Solidity: function initialized() constant returns(bool)
+func (_Oracle *OracleCallerSession) Initialized() (bool, error) {
+	return _Oracle.Contract.Initialized(&_Oracle.CallOpts)
+}
+
+// Maintenance is a free data retrieval call binding the contract method 0x6c376cc5.
+//
+// Solidity: function maintenance() constant returns(bool)
+func (_Oracle *OracleCaller) Maintenance(opts *bind.CallOpts) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _Oracle.contract.Call(opts, out, "maintenance")
+	return *ret0, err
+}
+
+// Maintenance is a free data retrieval call binding the contract method 0x6c376cc5.
+//
+// Solidity: function maintenance() constant returns(bool)
+func (_Oracle *OracleSession) Maintenance() (bool, error) {
+	return _Oracle.Contract.Maintenance(&_Oracle.CallOpts)
+}
+
+// Maintenance is a free data retrieval call binding the contract method 0x6c376cc5.
+//
+// Solidity: function maintenance() constant returns(bool)
+func (_Oracle *OracleCallerSession) Maintenance() (bool, error) {
+	return _Oracle.Contract.Maintenance(&_Oracle.CallOpts)
+}
+
+// MaxRateAge is a free data retrieval call binding the contract method 0x57bbdca3.
+//
+// Solidity: function maxRateAge() constant returns(uint256)
+func (_Oracle *OracleCaller) MaxRateAge(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Oracle.contract.Call(opts, out, "maxRateAge")
+	return *ret0, err
+}
+
+// MaxRateAge is a free data retrieval call binding the contract method 0x57bbdca3.
+//
+// Solidity: function maxRateAge() constant returns(uint256)
+func (_Oracle *OracleSession) MaxRateAge() (*big.Int, error) {
+	return _Oracle.Contract.MaxRateAge(&_Oracle.CallOpts)
+}
+
+// MaxRateAge is a free data retrieval call binding the contract method 0x57bbdca3.
+//
+// Solidity: function maxRateAge() constant returns(uint256)
+func (_Oracle *OracleCallerSession) MaxRateAge() (*big.Int, error) {
+	return _Oracle.Contract.MaxRateAge(&_Oracle.CallOpts)
+}
+
+// PortfolioValue is a free data retrieval call binding the contract method 0x6d193495.
+//
+// Solidity: function portfolioValue(address _wallet, address[] _tokens) constant returns(uint256)
+func (_Oracle *OracleCaller) PortfolioValue(opts *bind.CallOpts, _wallet common.Address, _tokens []common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Oracle.contract.Call(opts, out, "portfolioValue", _wallet, _tokens)
+	return *ret0, err
+}
+
+// PortfolioValue is a free data retrieval call binding the contract method 0x6d193495.
+//
+// Solidity: function portfolioValue(address _wallet, address[] _tokens) constant returns(uint256)
+func (_Oracle *OracleSession) PortfolioValue(_wallet common.Address, _tokens []common.Address) (*big.Int, error) {
+	return _Oracle.Contract.PortfolioValue(&_Oracle.CallOpts, _wallet, _tokens)
+}
+
+// PortfolioValue is a free data retrieval call binding the contract method 0x6d193495.
+//
+// Solidity: function portfolioValue(address _wallet, address[] _tokens) constant returns(uint256)
+func (_Oracle *OracleCallerSession) PortfolioValue(_wallet common.Address, _tokens []common.Address) (*big.Int, error) {
+	return _Oracle.Contract.PortfolioValue(&_Oracle.CallOpts, _wallet, _tokens)
+}
+
+// RateAdapter is a free data retrieval call binding the contract method 0x2c703446.
+//
+// Solidity: function rateAdapter(address ) constant returns(address)
+func (_Oracle *OracleCaller) RateAdapter(opts *bind.CallOpts, arg0 common.Address) (common.Address, error) {
+	var (
+		ret0 = new(common.Address)
+	)
+	out := ret0
+	err := _Oracle.contract.Call(opts, out, "rateAdapter", arg0)
+	return *ret0, err
+}
+
+// RateAdapter is a free data retrieval call binding the contract method 0x2c703446.
+//
+// Solidity: function rateAdapter(address ) constant returns(address)
+func (_Oracle *OracleSession) RateAdapter(arg0 common.Address) (common.Address, error) {
+	return _Oracle.Contract.RateAdapter(&_Oracle.CallOpts, arg0)
+}
+
+// RateAdapter is a free data retrieval call binding the contract method 0x2c703446.
+//
+// Solidity: function rateAdapter(address ) constant returns(address)
+func (_Oracle *OracleCallerSession) RateAdapter(arg0 common.Address) (common.Address, error) {
+	return _Oracle.Contract.RateAdapter(&_Oracle.CallOpts, arg0)
+}
+
+// RateChangeBps is a free data retrieval call binding the contract method 0xe4ce880f.
+//
+// Solidity: function rateChangeBps(address _token) constant returns(int256)
+func (_Oracle *OracleCaller) RateChangeBps(opts *bind.CallOpts, _token common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Oracle.contract.Call(opts, out, "rateChangeBps", _token)
+	return *ret0, err
+}
+
+// RateChangeBps is a free data retrieval call binding the contract method 0xe4ce880f.
+//
+// Solidity: function rateChangeBps(address _token) constant returns(int256)
+func (_Oracle *OracleSession) RateChangeBps(_token common.Address) (*big.Int, error) {
+	return _Oracle.Contract.RateChangeBps(&_Oracle.CallOpts, _token)
+}
+
+// RateChangeBps is a free data retrieval call binding the contract method 0xe4ce880f.
+//
+// Solidity: function rateChangeBps(address _token) constant returns(int256)
+func (_Oracle *OracleCallerSession) RateChangeBps(_token common.Address) (*big.Int, error) {
+	return _Oracle.Contract.RateChangeBps(&_Oracle.CallOpts, _token)
+}
+
+// RateConsistency is a free data retrieval call binding the contract method 0x2d42fe4c.
+//
+// Solidity: function rateConsistency(address _token) constant returns(uint256)
+func (_Oracle *OracleCaller) RateConsistency(opts *bind.CallOpts, _token common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Oracle.contract.Call(opts, out, "rateConsistency", _token)
+	return *ret0, err
+}
+
+// RateConsistency is a free data retrieval call binding the contract method 0x2d42fe4c.
+//
+// Solidity: function rateConsistency(address _token) constant returns(uint256)
+func (_Oracle *OracleSession) RateConsistency(_token common.Address) (*big.Int, error) {
+	return _Oracle.Contract.RateConsistency(&_Oracle.CallOpts, _token)
+}
+
+// RateConsistency is a free data retrieval call binding the contract method 0x2d42fe4c.
+//
+// Solidity: function rateConsistency(address _token) constant returns(uint256)
+func (_Oracle *OracleCallerSession) RateConsistency(_token common.Address) (*big.Int, error) {
+	return _Oracle.Contract.RateConsistency(&_Oracle.CallOpts, _token)
+}
+
+// RateRecencyWeighted is a free data retrieval call binding the contract method 0x3f250ba4.
+//
+// Solidity: function rateRecencyWeighted(address _token) constant returns(uint256)
+func (_Oracle *OracleCaller) RateRecencyWeighted(opts *bind.CallOpts, _token common.Address) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Oracle.contract.Call(opts, out, "rateRecencyWeighted", _token)
+	return *ret0, err
+}
+
+// RateRecencyWeighted is a free data retrieval call binding the contract method 0x3f250ba4.
+//
+// Solidity: function rateRecencyWeighted(address _token) constant returns(uint256)
+func (_Oracle *OracleSession) RateRecencyWeighted(_token common.Address) (*big.Int, error) {
+	return _Oracle.Contract.RateRecencyWeighted(&_Oracle.CallOpts, _token)
+}
+
+// RateRecencyWeighted is a free data retrieval call binding the contract method 0x3f250ba4.
+//
+// Solidity: function rateRecencyWeighted(address _token) constant returns(uint256)
+func (_Oracle *OracleCallerSession) RateRecencyWeighted(_token common.Address) (*big.Int, error) {
+	return _Oracle.Contract.RateRecencyWeighted(&_Oracle.CallOpts, _token)
+}
+
+// RateStats is a free data retrieval call binding the contract method 0x3bdc0d46.
+//
+// Solidity: function rateStats(address _token) constant returns(uint256 min, uint256 max, uint256 avg)
+func (_Oracle *OracleCaller) RateStats(opts *bind.CallOpts, _token common.Address) (struct {
+	Min *big.Int
+	Max *big.Int
+	Avg *big.Int
+}, error) {
+	ret := new(struct {
+		Min *big.Int
+		Max *big.Int
+		Avg *big.Int
+	})
+	out := ret
+	err := _Oracle.contract.Call(opts, out, "rateStats", _token)
+	return *ret, err
+}
+
+// RateStats is a free data retrieval call binding the contract method 0x3bdc0d46.
+//
+// Solidity: function rateStats(address _token) constant returns(uint256 min, uint256 max, uint256 avg)
+func (_Oracle *OracleSession) RateStats(_token common.Address) (struct {
+	Min *big.Int
+	Max *big.Int
+	Avg *big.Int
+}, error) {
+	return _Oracle.Contract.RateStats(&_Oracle.CallOpts, _token)
+}
+
+// RateStats is a free data retrieval call binding the contract method 0x3bdc0d46.
+//
+// Solidity: function rateStats(address _token) constant returns(uint256 min, uint256 max, uint256 avg)
+func (_Oracle *OracleCallerSession) RateStats(_token common.Address) (struct {
+	Min *big.Int
+	Max *big.Int
+	Avg *big.Int
+}, error) {
+	return _Oracle.Contract.RateStats(&_Oracle.CallOpts, _token)
+}
+
+// StaleTokenCount is a free data retrieval call binding the contract method 0x7edc050c.
+//
+// Solidity: function staleTokenCount() constant returns(uint256)
+func (_Oracle *OracleCaller) StaleTokenCount(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _Oracle.contract.Call(opts, out, "staleTokenCount")
+	return *ret0, err
+}
+
+// StaleTokenCount is a free data retrieval call binding the contract method 0x7edc050c.
+//
+// Solidity: function staleTokenCount() constant returns(uint256)
+func (_Oracle *OracleSession) StaleTokenCount() (*big.Int, error) {
+	return _Oracle.Contract.StaleTokenCount(&_Oracle.CallOpts)
+}
+
+// StaleTokenCount is a free data retrieval call binding the contract method 0x7edc050c.
+//
+// Solidity: function staleTokenCount() constant returns(uint256)
+func (_Oracle *OracleCallerSession) StaleTokenCount() (*big.Int, error) {
+	return _Oracle.Contract.StaleTokenCount(&_Oracle.CallOpts)
+}
+
+// StalenessReport is a free data retrieval call binding the contract method 0x2c1926c6.
+//
+// Solidity: function stalenessReport() constant returns(address[], bool[])
+func (_Oracle *OracleCaller) StalenessReport(opts *bind.CallOpts) ([]common.Address, []bool, error) {
+	var (
+		ret0 = new([]common.Address)
+		ret1 = new([]bool)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+	}
+	err := _Oracle.contract.Call(opts, out, "stalenessReport")
+	return *ret0, *ret1, err
+}
+
+// StalenessReport is a free data retrieval call binding the contract method 0x2c1926c6.
+//
+// Solidity: function stalenessReport() constant returns(address[], bool[])
+func (_Oracle *OracleSession) StalenessReport() ([]common.Address, []bool, error) {
+	return _Oracle.Contract.StalenessReport(&_Oracle.CallOpts)
+}
+
+// StalenessReport is a free data retrieval call binding the contract method 0x2c1926c6.
+//
+// Solidity: function stalenessReport() constant returns(address[], bool[])
+func (_Oracle *OracleCallerSession) StalenessReport() ([]common.Address, []bool, error) {
+	return _Oracle.Contract.StalenessReport(&_Oracle.CallOpts)
+}
+
+// TokenRate is a free data retrieval call binding the contract method 0xc57fbf90.
+//
+// Solidity: function tokenRate(address _token) constant returns(uint256, bool)
+func (_Oracle *OracleCaller) TokenRate(opts *bind.CallOpts, _token common.Address) (*big.Int, bool, error) {
+	var (
+		ret0 = new(*big.Int)
+		ret1 = new(bool)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+	}
+	err := _Oracle.contract.Call(opts, out, "tokenRate", _token)
+	return *ret0, *ret1, err
+}
+
+// TokenRate is a free data retrieval call binding the contract method 0xc57fbf90.
+//
+// Solidity: function tokenRate(address _token) constant returns(uint256, bool)
+func (_Oracle *OracleSession) TokenRate(_token common.Address) (*big.Int, bool, error) {
+	return _Oracle.Contract.TokenRate(&_Oracle.CallOpts, _token)
+}
+
+// TokenRate is a free data retrieval call binding the contract method 0xc57fbf90.
+//
+// Solidity: function tokenRate(address _token) constant returns(uint256, bool)
+func (_Oracle *OracleCallerSession) TokenRate(_token common.Address) (*big.Int, bool, error) {
+	return _Oracle.Contract.TokenRate(&_Oracle.CallOpts, _token)
+}
+
+// TokenRateFromAdapter is a free data retrieval call binding the contract method 0xede9b1b6.
+//
+// Solidity: function tokenRateFromAdapter(address _token) constant returns(uint256, uint256)
+func (_Oracle *OracleCaller) TokenRateFromAdapter(opts *bind.CallOpts, _token common.Address) (*big.Int, *big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+		ret1 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+	}
+	err := _Oracle.contract.Call(opts, out, "tokenRateFromAdapter", _token)
+	return *ret0, *ret1, err
+}
+
+// TokenRateFromAdapter is a free data retrieval call binding the contract method 0xede9b1b6.
+//
+// Solidity: function tokenRateFromAdapter(address _token) constant returns(uint256, uint256)
+func (_Oracle *OracleSession) TokenRateFromAdapter(_token common.Address) (*big.Int, *big.Int, error) {
+	return _Oracle.Contract.TokenRateFromAdapter(&_Oracle.CallOpts, _token)
+}
+
+// TokenRateFromAdapter is a free data retrieval call binding the contract method 0xede9b1b6.
+//
+// Solidity: function tokenRateFromAdapter(address _token) constant returns(uint256, uint256)
+func (_Oracle *OracleCallerSession) TokenRateFromAdapter(_token common.Address) (*big.Int, *big.Int, error) {
+	return _Oracle.Contract.TokenRateFromAdapter(&_Oracle.CallOpts, _token)
+}
+
 // TokenWhitelistNode is a free data retrieval call binding the contract method 0x877337b0.
 //
 // Solidity: function tokenWhitelistNode() constant returns(bytes32)
@@ -293,6 +709,46 @@ func (_Oracle *OracleCallerSession) TokenWhitelistNode() ([32]byte, error) {
 	return _Oracle.Contract.TokenWhitelistNode(&_Oracle.CallOpts)
 }
 
+// UpdateScheduleInfo is a free data retrieval call binding the contract method 0xc9b3b06e.
+//
+// Solidity: function updateScheduleInfo(address _token) constant returns(uint256 interval, uint256 lastUpdate, uint256 nextDue)
+func (_Oracle *OracleCaller) UpdateScheduleInfo(opts *bind.CallOpts, _token common.Address) (struct {
+	Interval   *big.Int
+	LastUpdate *big.Int
+	NextDue    *big.Int
+}, error) {
+	ret := new(struct {
+		Interval   *big.Int
+		LastUpdate *big.Int
+		NextDue    *big.Int
+	})
+	out := ret
+	err := _Oracle.contract.Call(opts, out, "updateScheduleInfo", _token)
+	return *ret, err
+}
+
+// UpdateScheduleInfo is a free data retrieval call binding the contract method 0xc9b3b06e.
+//
+// Solidity: function updateScheduleInfo(address _token) constant returns(uint256 interval, uint256 lastUpdate, uint256 nextDue)
+func (_Oracle *OracleSession) UpdateScheduleInfo(_token common.Address) (struct {
+	Interval   *big.Int
+	LastUpdate *big.Int
+	NextDue    *big.Int
+}, error) {
+	return _Oracle.Contract.UpdateScheduleInfo(&_Oracle.CallOpts, _token)
+}
+
+// UpdateScheduleInfo is a free data retrieval call binding the contract method 0xc9b3b06e.
+//
+// Solidity: function updateScheduleInfo(address _token) constant returns(uint256 interval, uint256 lastUpdate, uint256 nextDue)
+func (_Oracle *OracleCallerSession) UpdateScheduleInfo(_token common.Address) (struct {
+	Interval   *big.Int
+	LastUpdate *big.Int
+	NextDue    *big.Int
+}, error) {
+	return _Oracle.Contract.UpdateScheduleInfo(&_Oracle.CallOpts, _token)
+}
+
 // Callback is a paid mutator transaction binding the contract method 0x38bbfa50.
 //
 // Solidity: function __callback(bytes32 _queryID, string _result, bytes _proof) returns()
@@ -335,6 +791,69 @@ func (_Oracle *OracleTransactorSession) Claim(_to common.Address, _asset common.
 	return _Oracle.Contract.Claim(&_Oracle.TransactOpts, _to, _asset, _amount)
 }
 
+// Initialize is a paid mutator transaction binding the contract method 0xb7457c7b.
+//
+// Solidity: function initialize(address[] _tokens, address[] _adapters, uint256 _maxRateAge, address _feeToken) returns()
+func (_Oracle *OracleTransactor) Initialize(opts *bind.TransactOpts, _tokens []common.Address, _adapters []common.Address, _maxRateAge *big.Int, _feeToken common.Address) (*types.Transaction, error) {
+	return _Oracle.contract.Transact(opts, "initialize", _tokens, _adapters, _maxRateAge, _feeToken)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xb7457c7b.
+//
+// Solidity: function initialize(address[] _tokens, address[] _adapters, uint256 _maxRateAge, address _feeToken) returns()
+func (_Oracle *OracleSession) Initialize(_tokens []common.Address, _adapters []common.Address, _maxRateAge *big.Int, _feeToken common.Address) (*types.Transaction, error) {
+	return _Oracle.Contract.Initialize(&_Oracle.TransactOpts, _tokens, _adapters, _maxRateAge, _feeToken)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xb7457c7b.
+//
+// Solidity: function initialize(address[] _tokens, address[] _adapters, uint256 _maxRateAge, address _feeToken) returns()
+func (_Oracle *OracleTransactorSession) Initialize(_tokens []common.Address, _adapters []common.Address, _maxRateAge *big.Int, _feeToken common.Address) (*types.Transaction, error) {
+	return _Oracle.Contract.Initialize(&_Oracle.TransactOpts, _tokens, _adapters, _maxRateAge, _feeToken)
+}
+
+// PruneHistory is a paid mutator transaction binding the contract method 0xf145b05a.
+//
+// Solidity: function pruneHistory(address _token, uint256 _keepLast) returns()
+func (_Oracle *OracleTransactor) PruneHistory(opts *bind.TransactOpts, _token common.Address, _keepLast *big.Int) (*types.Transaction, error) {
+	return _Oracle.contract.Transact(opts, "pruneHistory", _token, _keepLast)
+}
+
+// PruneHistory is a paid mutator transaction binding the contract method 0xf145b05a.
+//
+// Solidity: function pruneHistory(address _token, uint256 _keepLast) returns()
+func (_Oracle *OracleSession) PruneHistory(_token common.Address, _keepLast *big.Int) (*types.Transaction, error) {
+	return _Oracle.Contract.PruneHistory(&_Oracle.TransactOpts, _token, _keepLast)
+}
+
+// PruneHistory is a paid mutator transaction binding the contract method 0xf145b05a.
+//
+// Solidity: function pruneHistory(address _token, uint256 _keepLast) returns()
+func (_Oracle *OracleTransactorSession) PruneHistory(_token common.Address, _keepLast *big.Int) (*types.Transaction, error) {
+	return _Oracle.Contract.PruneHistory(&_Oracle.TransactOpts, _token, _keepLast)
+}
+
+// SetAdapter is a paid mutator transaction binding the contract method 0xba59b4ca.
+//
+// Solidity: function setAdapter(address _token, address _adapter) returns()
+func (_Oracle *OracleTransactor) SetAdapter(opts *bind.TransactOpts, _token common.Address, _adapter common.Address) (*types.Transaction, error) {
+	return _Oracle.contract.Transact(opts, "setAdapter", _token, _adapter)
+}
+
+// SetAdapter is a paid mutator transaction binding the contract method 0xba59b4ca.
+//
+// Solidity: function setAdapter(address _token, address _adapter) returns()
+func (_Oracle *OracleSession) SetAdapter(_token common.Address, _adapter common.Address) (*types.Transaction, error) {
+	return _Oracle.Contract.SetAdapter(&_Oracle.TransactOpts, _token, _adapter)
+}
+
+// SetAdapter is a paid mutator transaction binding the contract method 0xba59b4ca.
+//
+// Solidity: function setAdapter(address _token, address _adapter) returns()
+func (_Oracle *OracleTransactorSession) SetAdapter(_token common.Address, _adapter common.Address) (*types.Transaction, error) {
+	return _Oracle.Contract.SetAdapter(&_Oracle.TransactOpts, _token, _adapter)
+}
+
 // SetCustomGasPrice is a paid mutator transaction binding the contract method 0xca6ad1e4.
 //
 // Solidity: function setCustomGasPrice(uint256 _gasPrice) returns()
@@ -356,6 +875,69 @@ func (_Oracle *OracleTransactorSession) SetCustomGasPrice(_gasPrice *big.Int) (*
 	return _Oracle.Contract.SetCustomGasPrice(&_Oracle.TransactOpts, _gasPrice)
 }
 
+// SetFeeToken is a paid mutator transaction binding the contract method 0x15cce224.
+//
+// Solidity: function setFeeToken(address _feeToken) returns()
+func (_Oracle *OracleTransactor) SetFeeToken(opts *bind.TransactOpts, _feeToken common.Address) (*types.Transaction, error) {
+	return _Oracle.contract.Transact(opts, "setFeeToken", _feeToken)
+}
+
+// SetFeeToken is a paid mutator transaction binding the contract method 0x15cce224.
+//
+// Solidity: function setFeeToken(address _feeToken) returns()
+func (_Oracle *OracleSession) SetFeeToken(_feeToken common.Address) (*types.Transaction, error) {
+	return _Oracle.Contract.SetFeeToken(&_Oracle.TransactOpts, _feeToken)
+}
+
+// SetFeeToken is a paid mutator transaction binding the contract method 0x15cce224.
+//
+// Solidity: function setFeeToken(address _feeToken) returns()
+func (_Oracle *OracleTransactorSession) SetFeeToken(_feeToken common.Address) (*types.Transaction, error) {
+	return _Oracle.Contract.SetFeeToken(&_Oracle.TransactOpts, _feeToken)
+}
+
+// SetMaintenance is a paid mutator transaction binding the contract method 0x612f2f37.
+//
+// Solidity: function setMaintenance(bool _maintenance) returns()
+func (_Oracle *OracleTransactor) SetMaintenance(opts *bind.TransactOpts, _maintenance bool) (*types.Transaction, error) {
+	return _Oracle.contract.Transact(opts, "setMaintenance", _maintenance)
+}
+
+// SetMaintenance is a paid mutator transaction binding the contract method 0x612f2f37.
+//
+// Solidity: function setMaintenance(bool _maintenance) returns()
+func (_Oracle *OracleSession) SetMaintenance(_maintenance bool) (*types.Transaction, error) {
+	return _Oracle.Contract.SetMaintenance(&_Oracle.TransactOpts, _maintenance)
+}
+
+// SetMaintenance is a paid mutator transaction binding the contract method 0x612f2f37.
+//
+// Solidity: function setMaintenance(bool _maintenance) returns()
+func (_Oracle *OracleTransactorSession) SetMaintenance(_maintenance bool) (*types.Transaction, error) {
+	return _Oracle.Contract.SetMaintenance(&_Oracle.TransactOpts, _maintenance)
+}
+
+// SetMaxRateAge is a paid mutator transaction binding the contract method 0xfbc9f35f.
+//
+// Solidity: function setMaxRateAge(uint256 _maxRateAge) returns()
+func (_Oracle *OracleTransactor) SetMaxRateAge(opts *bind.TransactOpts, _maxRateAge *big.Int) (*types.Transaction, error) {
+	return _Oracle.contract.Transact(opts, "setMaxRateAge", _maxRateAge)
+}
+
+// SetMaxRateAge is a paid mutator transaction binding the contract method 0xfbc9f35f.
+//
+// Solidity: function setMaxRateAge(uint256 _maxRateAge) returns()
+func (_Oracle *OracleSession) SetMaxRateAge(_maxRateAge *big.Int) (*types.Transaction, error) {
+	return _Oracle.Contract.SetMaxRateAge(&_Oracle.TransactOpts, _maxRateAge)
+}
+
+// SetMaxRateAge is a paid mutator transaction binding the contract method 0xfbc9f35f.
+//
+// Solidity: function setMaxRateAge(uint256 _maxRateAge) returns()
+func (_Oracle *OracleTransactorSession) SetMaxRateAge(_maxRateAge *big.Int) (*types.Transaction, error) {
+	return _Oracle.Contract.SetMaxRateAge(&_Oracle.TransactOpts, _maxRateAge)
+}
+
 // UpdateCryptoCompareAPIPublicKey is a paid mutator transaction binding the contract method 0xc2c3d054.
 //
 // Solidity: function updateCryptoCompareAPIPublicKey(bytes _publicKey) returns()
@@ -398,30 +980,702 @@ func (_Oracle *OracleTransactorSession) UpdateTokenRates(_gasLimit *big.Int) (*t
 	return _Oracle.Contract.UpdateTokenRates(&_Oracle.TransactOpts, _gasLimit)
 }
 
-// UpdateTokenRatesList is a paid mutator transaction binding the contract method 0x937f54a4.
+// UpdateTokenRatesList is a paid mutator transaction binding the contract method 0x937f54a4.
+//
+// Solidity: function updateTokenRatesList(uint256 _gasLimit, address[] _tokenList) returns()
+func (_Oracle *OracleTransactor) UpdateTokenRatesList(opts *bind.TransactOpts, _gasLimit *big.Int, _tokenList []common.Address) (*types.Transaction, error) {
+	return _Oracle.contract.Transact(opts, "updateTokenRatesList", _gasLimit, _tokenList)
+}
+
+// UpdateTokenRatesList is a paid mutator transaction binding the contract method 0x937f54a4.
+//
+// Solidity: function updateTokenRatesList(uint256 _gasLimit, address[] _tokenList) returns()
+func (_Oracle *OracleSession) UpdateTokenRatesList(_gasLimit *big.Int, _tokenList []common.Address) (*types.Transaction, error) {
+	return _Oracle.Contract.UpdateTokenRatesList(&_Oracle.TransactOpts, _gasLimit, _tokenList)
+}
+
+// UpdateTokenRatesList is a paid mutator transaction binding the contract method 0x937f54a4.
+//
+// Solidity: function updateTokenRatesList(uint256 _gasLimit, address[] _tokenList) returns()
+func (_Oracle *OracleTransactorSession) UpdateTokenRatesList(_gasLimit *big.Int, _tokenList []common.Address) (*types.Transaction, error) {
+	return _Oracle.Contract.UpdateTokenRatesList(&_Oracle.TransactOpts, _gasLimit, _tokenList)
+}
+
+// OracleClaimedIterator is returned from FilterClaimed and is used to iterate over the raw logs and unpacked data for Claimed events raised by the Oracle contract.
+type OracleClaimedIterator struct {
+	Event *OracleClaimed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleClaimedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleClaimedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleClaimed represents a Claimed event raised by the Oracle contract.
+type OracleClaimed struct {
+	To     common.Address
+	Asset  common.Address
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterClaimed is a free log retrieval operation binding the contract event 0xf7a40077ff7a04c7e61f6f26fb13774259ddf1b6bce9ecf26a8276cdd3992683.
+//
+// Solidity: event Claimed(address _to, address _asset, uint256 _amount)
+func (_Oracle *OracleFilterer) FilterClaimed(opts *bind.FilterOpts) (*OracleClaimedIterator, error) {
+
+	logs, sub, err := _Oracle.contract.FilterLogs(opts, "Claimed")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleClaimedIterator{contract: _Oracle.contract, event: "Claimed", logs: logs, sub: sub}, nil
+}
+
+// WatchClaimed is a free log subscription operation binding the contract event 0xf7a40077ff7a04c7e61f6f26fb13774259ddf1b6bce9ecf26a8276cdd3992683.
+//
+// Solidity: event Claimed(address _to, address _asset, uint256 _amount)
+func (_Oracle *OracleFilterer) WatchClaimed(opts *bind.WatchOpts, sink chan<- *OracleClaimed) (event.Subscription, error) {
+
+	logs, sub, err := _Oracle.contract.WatchLogs(opts, "Claimed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleClaimed)
+				if err := _Oracle.contract.UnpackLog(event, "Claimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseClaimed is a log parse operation binding the contract event 0xf7a40077ff7a04c7e61f6f26fb13774259ddf1b6bce9ecf26a8276cdd3992683.
+//
+// Solidity: event Claimed(address _to, address _asset, uint256 _amount)
+func (_Oracle *OracleFilterer) ParseClaimed(log types.Log) (*OracleClaimed, error) {
+	event := new(OracleClaimed)
+	if err := _Oracle.contract.UnpackLog(event, "Claimed", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// OracleFailedUpdateRequestIterator is returned from FilterFailedUpdateRequest and is used to iterate over the raw logs and unpacked data for FailedUpdateRequest events raised by the Oracle contract.
+type OracleFailedUpdateRequestIterator struct {
+	Event *OracleFailedUpdateRequest // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleFailedUpdateRequestIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleFailedUpdateRequest)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleFailedUpdateRequest)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleFailedUpdateRequestIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleFailedUpdateRequestIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleFailedUpdateRequest represents a FailedUpdateRequest event raised by the Oracle contract.
+type OracleFailedUpdateRequest struct {
+	Reason string
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterFailedUpdateRequest is a free log retrieval operation binding the contract event 0x4eb5629fd8501532aeb93b1b6a5b5b2ae398561e56514ed4b4b0c5ac2d381b6e.
+//
+// Solidity: event FailedUpdateRequest(string _reason)
+func (_Oracle *OracleFilterer) FilterFailedUpdateRequest(opts *bind.FilterOpts) (*OracleFailedUpdateRequestIterator, error) {
+
+	logs, sub, err := _Oracle.contract.FilterLogs(opts, "FailedUpdateRequest")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleFailedUpdateRequestIterator{contract: _Oracle.contract, event: "FailedUpdateRequest", logs: logs, sub: sub}, nil
+}
+
+// WatchFailedUpdateRequest is a free log subscription operation binding the contract event 0x4eb5629fd8501532aeb93b1b6a5b5b2ae398561e56514ed4b4b0c5ac2d381b6e.
+//
+// Solidity: event FailedUpdateRequest(string _reason)
+func (_Oracle *OracleFilterer) WatchFailedUpdateRequest(opts *bind.WatchOpts, sink chan<- *OracleFailedUpdateRequest) (event.Subscription, error) {
+
+	logs, sub, err := _Oracle.contract.WatchLogs(opts, "FailedUpdateRequest")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleFailedUpdateRequest)
+				if err := _Oracle.contract.UnpackLog(event, "FailedUpdateRequest", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseFailedUpdateRequest is a log parse operation binding the contract event 0x4eb5629fd8501532aeb93b1b6a5b5b2ae398561e56514ed4b4b0c5ac2d381b6e.
+//
+// Solidity: event FailedUpdateRequest(string _reason)
+func (_Oracle *OracleFilterer) ParseFailedUpdateRequest(log types.Log) (*OracleFailedUpdateRequest, error) {
+	event := new(OracleFailedUpdateRequest)
+	if err := _Oracle.contract.UnpackLog(event, "FailedUpdateRequest", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// OraclePrunedHistoryIterator is returned from FilterPrunedHistory and is used to iterate over the raw logs and unpacked data for PrunedHistory events raised by the Oracle contract.
+type OraclePrunedHistoryIterator struct {
+	Event *OraclePrunedHistory // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OraclePrunedHistoryIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OraclePrunedHistory)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OraclePrunedHistory)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OraclePrunedHistoryIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OraclePrunedHistoryIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OraclePrunedHistory represents a PrunedHistory event raised by the Oracle contract.
+type OraclePrunedHistory struct {
+	Sender   common.Address
+	Token    common.Address
+	KeepLast *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterPrunedHistory is a free log retrieval operation binding the contract event 0x211da02425c76d25a5c9ffbed1ccb5e16fff130dc2a700547519c3d1372bb984.
+//
+// Solidity: event PrunedHistory(address _sender, address _token, uint256 _keepLast)
+func (_Oracle *OracleFilterer) FilterPrunedHistory(opts *bind.FilterOpts) (*OraclePrunedHistoryIterator, error) {
+
+	logs, sub, err := _Oracle.contract.FilterLogs(opts, "PrunedHistory")
+	if err != nil {
+		return nil, err
+	}
+	return &OraclePrunedHistoryIterator{contract: _Oracle.contract, event: "PrunedHistory", logs: logs, sub: sub}, nil
+}
+
+// WatchPrunedHistory is a free log subscription operation binding the contract event 0x211da02425c76d25a5c9ffbed1ccb5e16fff130dc2a700547519c3d1372bb984.
+//
+// Solidity: event PrunedHistory(address _sender, address _token, uint256 _keepLast)
+func (_Oracle *OracleFilterer) WatchPrunedHistory(opts *bind.WatchOpts, sink chan<- *OraclePrunedHistory) (event.Subscription, error) {
+
+	logs, sub, err := _Oracle.contract.WatchLogs(opts, "PrunedHistory")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OraclePrunedHistory)
+				if err := _Oracle.contract.UnpackLog(event, "PrunedHistory", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePrunedHistory is a log parse operation binding the contract event 0x211da02425c76d25a5c9ffbed1ccb5e16fff130dc2a700547519c3d1372bb984.
+//
+// Solidity: event PrunedHistory(address _sender, address _token, uint256 _keepLast)
+func (_Oracle *OracleFilterer) ParsePrunedHistory(log types.Log) (*OraclePrunedHistory, error) {
+	event := new(OraclePrunedHistory)
+	if err := _Oracle.contract.UnpackLog(event, "PrunedHistory", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// OracleRequestedUpdateIterator is returned from FilterRequestedUpdate and is used to iterate over the raw logs and unpacked data for RequestedUpdate events raised by the Oracle contract.
+type OracleRequestedUpdateIterator struct {
+	Event *OracleRequestedUpdate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleRequestedUpdateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleRequestedUpdate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleRequestedUpdate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleRequestedUpdateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleRequestedUpdateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleRequestedUpdate represents a RequestedUpdate event raised by the Oracle contract.
+type OracleRequestedUpdate struct {
+	Symbol  string
+	QueryID [32]byte
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRequestedUpdate is a free log retrieval operation binding the contract event 0x47737841f636da1ca9f2de10d9bfb96c4251e0b31de72a902d4fd4ac8797bbbe.
+//
+// Solidity: event RequestedUpdate(string _symbol, bytes32 _queryID)
+func (_Oracle *OracleFilterer) FilterRequestedUpdate(opts *bind.FilterOpts) (*OracleRequestedUpdateIterator, error) {
+
+	logs, sub, err := _Oracle.contract.FilterLogs(opts, "RequestedUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleRequestedUpdateIterator{contract: _Oracle.contract, event: "RequestedUpdate", logs: logs, sub: sub}, nil
+}
+
+// WatchRequestedUpdate is a free log subscription operation binding the contract event 0x47737841f636da1ca9f2de10d9bfb96c4251e0b31de72a902d4fd4ac8797bbbe.
+//
+// Solidity: event RequestedUpdate(string _symbol, bytes32 _queryID)
+func (_Oracle *OracleFilterer) WatchRequestedUpdate(opts *bind.WatchOpts, sink chan<- *OracleRequestedUpdate) (event.Subscription, error) {
+
+	logs, sub, err := _Oracle.contract.WatchLogs(opts, "RequestedUpdate")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleRequestedUpdate)
+				if err := _Oracle.contract.UnpackLog(event, "RequestedUpdate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRequestedUpdate is a log parse operation binding the contract event 0x47737841f636da1ca9f2de10d9bfb96c4251e0b31de72a902d4fd4ac8797bbbe.
+//
+// Solidity: event RequestedUpdate(string _symbol, bytes32 _queryID)
+func (_Oracle *OracleFilterer) ParseRequestedUpdate(log types.Log) (*OracleRequestedUpdate, error) {
+	event := new(OracleRequestedUpdate)
+	if err := _Oracle.contract.UnpackLog(event, "RequestedUpdate", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// OracleSetAdapterIterator is returned from FilterSetAdapter and is used to iterate over the raw logs and unpacked data for SetAdapter events raised by the Oracle contract.
+type OracleSetAdapterIterator struct {
+	Event *OracleSetAdapter // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OracleSetAdapterIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OracleSetAdapter)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OracleSetAdapter)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OracleSetAdapterIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OracleSetAdapterIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OracleSetAdapter represents a SetAdapter event raised by the Oracle contract.
+type OracleSetAdapter struct {
+	Sender  common.Address
+	Token   common.Address
+	Adapter common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetAdapter is a free log retrieval operation binding the contract event 0xfa7880db9a67bf1eb22977a90bd6a3b05d210cee1b85ff394e27444ce63ce746.
 //
-// Solidity: function updateTokenRatesList(uint256 _gasLimit, address[] _tokenList) returns()
-func (_Oracle *OracleTransactor) UpdateTokenRatesList(opts *bind.TransactOpts, _gasLimit *big.Int, _tokenList []common.Address) (*types.Transaction, error) {
-	return _Oracle.contract.Transact(opts, "updateTokenRatesList", _gasLimit, _tokenList)
+// Solidity: event SetAdapter(address _sender, address _token, address _adapter)
+func (_Oracle *OracleFilterer) FilterSetAdapter(opts *bind.FilterOpts) (*OracleSetAdapterIterator, error) {
+
+	logs, sub, err := _Oracle.contract.FilterLogs(opts, "SetAdapter")
+	if err != nil {
+		return nil, err
+	}
+	return &OracleSetAdapterIterator{contract: _Oracle.contract, event: "SetAdapter", logs: logs, sub: sub}, nil
 }
 
-// UpdateTokenRatesList is a paid mutator transaction binding the contract method 0x937f54a4.
+// WatchSetAdapter is a free log subscription operation binding the contract event 0xfa7880db9a67bf1eb22977a90bd6a3b05d210cee1b85ff394e27444ce63ce746.
 //
-// Solidity: function updateTokenRatesList(uint256 _gasLimit, address[] _tokenList) returns()
-func (_Oracle *OracleSession) UpdateTokenRatesList(_gasLimit *big.Int, _tokenList []common.Address) (*types.Transaction, error) {
-	return _Oracle.Contract.UpdateTokenRatesList(&_Oracle.TransactOpts, _gasLimit, _tokenList)
+// Solidity: event SetAdapter(address _sender, address _token, address _adapter)
+func (_Oracle *OracleFilterer) WatchSetAdapter(opts *bind.WatchOpts, sink chan<- *OracleSetAdapter) (event.Subscription, error) {
+
+	logs, sub, err := _Oracle.contract.WatchLogs(opts, "SetAdapter")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OracleSetAdapter)
+				if err := _Oracle.contract.UnpackLog(event, "SetAdapter", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
 }
 
-// UpdateTokenRatesList is a paid mutator transaction binding the contract method 0x937f54a4.
+// ParseSetAdapter is a log parse operation binding the contract event 0xfa7880db9a67bf1eb22977a90bd6a3b05d210cee1b85ff394e27444ce63ce746.
 //
-// Solidity: function updateTokenRatesList(uint256 _gasLimit, address[] _tokenList) returns()
-func (_Oracle *OracleTransactorSession) UpdateTokenRatesList(_gasLimit *big.Int, _tokenList []common.Address) (*types.Transaction, error) {
-	return _Oracle.Contract.UpdateTokenRatesList(&_Oracle.TransactOpts, _gasLimit, _tokenList)
+// Solidity: event SetAdapter(address _sender, address _token, address _adapter)
+func (_Oracle *OracleFilterer) ParseSetAdapter(log types.Log) (*OracleSetAdapter, error) {
+	event := new(OracleSetAdapter)
+	if err := _Oracle.contract.UnpackLog(event, "SetAdapter", log); err != nil {
+		return nil, err
+	}
+	return event, nil
 }
 
-// OracleClaimedIterator is returned from FilterClaimed and is used to iterate over the raw logs and unpacked data for Claimed events raised by the Oracle contract.
-type OracleClaimedIterator struct {
-	Event *OracleClaimed // Event containing the contract specifics and raw log
+// OracleSetCryptoComparePublicKeyIterator is returned from FilterSetCryptoComparePublicKey and is used to iterate over the raw logs and unpacked data for SetCryptoComparePublicKey events raised by the Oracle contract.
+type OracleSetCryptoComparePublicKeyIterator struct {
+	Event *OracleSetCryptoComparePublicKey // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -435,7 +1689,7 @@ type OracleClaimedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *OracleClaimedIterator) Next() bool {
+func (it *OracleSetCryptoComparePublicKeyIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -444,7 +1698,7 @@ func (it *OracleClaimedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(OracleClaimed)
+			it.Event = new(OracleSetCryptoComparePublicKey)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -459,7 +1713,7 @@ func (it *OracleClaimedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(OracleClaimed)
+		it.Event = new(OracleSetCryptoComparePublicKey)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -475,43 +1729,42 @@ func (it *OracleClaimedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *OracleClaimedIterator) Error() error {
+func (it *OracleSetCryptoComparePublicKeyIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *OracleClaimedIterator) Close() error {
+func (it *OracleSetCryptoComparePublicKeyIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// OracleClaimed represents a Claimed event raised by the Oracle contract.
-type OracleClaimed struct {
-	To     common.Address
-	Asset  common.Address
-	Amount *big.Int
-	Raw    types.Log // Blockchain specific contextual infos
+// OracleSetCryptoComparePublicKey represents a SetCryptoComparePublicKey event raised by the Oracle contract.
+type OracleSetCryptoComparePublicKey struct {
+	Sender    common.Address
+	PublicKey []byte
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterClaimed is a free log retrieval operation binding the contract event 0xf7a40077ff7a04c7e61f6f26fb13774259ddf1b6bce9ecf26a8276cdd3992683.
+// FilterSetCryptoComparePublicKey is a free log retrieval operation binding the contract event 0xc6b0860ba9f580e9c5b6ba4e0954fe82827096a99d92e8c2d73009539ea8d9fa.
 //
-// Solidity: event Claimed(address _to, address _asset, uint256 _amount)
-func (_Oracle *OracleFilterer) FilterClaimed(opts *bind.FilterOpts) (*OracleClaimedIterator, error) {
+// Solidity: event SetCryptoComparePublicKey(address _sender, bytes _publicKey)
+func (_Oracle *OracleFilterer) FilterSetCryptoComparePublicKey(opts *bind.FilterOpts) (*OracleSetCryptoComparePublicKeyIterator, error) {
 
-	logs, sub, err := _Oracle.contract.FilterLogs(opts, "Claimed")
+	logs, sub, err := _Oracle.contract.FilterLogs(opts, "SetCryptoComparePublicKey")
 	if err != nil {
 		return nil, err
 	}
-	return &OracleClaimedIterator{contract: _Oracle.contract, event: "Claimed", logs: logs, sub: sub}, nil
+	return &OracleSetCryptoComparePublicKeyIterator{contract: _Oracle.contract, event: "SetCryptoComparePublicKey", logs: logs, sub: sub}, nil
 }
 
-// WatchClaimed is a free log subscription operation binding the contract event 0xf7a40077ff7a04c7e61f6f26fb13774259ddf1b6bce9ecf26a8276cdd3992683.
+// WatchSetCryptoComparePublicKey is a free log subscription operation binding the contract event 0xc6b0860ba9f580e9c5b6ba4e0954fe82827096a99d92e8c2d73009539ea8d9fa.
 //
-// Solidity: event Claimed(address _to, address _asset, uint256 _amount)
-func (_Oracle *OracleFilterer) WatchClaimed(opts *bind.WatchOpts, sink chan<- *OracleClaimed) (event.Subscription, error) {
+// Solidity: event SetCryptoComparePublicKey(address _sender, bytes _publicKey)
+func (_Oracle *OracleFilterer) WatchSetCryptoComparePublicKey(opts *bind.WatchOpts, sink chan<- *OracleSetCryptoComparePublicKey) (event.Subscription, error) {
 
-	logs, sub, err := _Oracle.contract.WatchLogs(opts, "Claimed")
+	logs, sub, err := _Oracle.contract.WatchLogs(opts, "SetCryptoComparePublicKey")
 	if err != nil {
 		return nil, err
 	}
@@ -521,8 +1774,8 @@ func (_Oracle *OracleFilterer) WatchClaimed(opts *bind.WatchOpts, sink chan<- *O
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(OracleClaimed)
-				if err := _Oracle.contract.UnpackLog(event, "Claimed", log); err != nil {
+				event := new(OracleSetCryptoComparePublicKey)
+				if err := _Oracle.contract.UnpackLog(event, "SetCryptoComparePublicKey", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -543,20 +1796,20 @@ func (_Oracle *OracleFilterer) WatchClaimed(opts *bind.WatchOpts, sink chan<- *O
 	}), nil
 }
 
-// ParseClaimed is a log parse operation binding the contract event 0xf7a40077ff7a04c7e61f6f26fb13774259ddf1b6bce9ecf26a8276cdd3992683.
+// ParseSetCryptoComparePublicKey is a log parse operation binding the contract event 0xc6b0860ba9f580e9c5b6ba4e0954fe82827096a99d92e8c2d73009539ea8d9fa.
 //
-// Solidity: event Claimed(address _to, address _asset, uint256 _amount)
-func (_Oracle *OracleFilterer) ParseClaimed(log types.Log) (*OracleClaimed, error) {
-	event := new(OracleClaimed)
-	if err := _Oracle.contract.UnpackLog(event, "Claimed", log); err != nil {
+// Solidity: event SetCryptoComparePublicKey(address _sender, bytes _publicKey)
+func (_Oracle *OracleFilterer) ParseSetCryptoComparePublicKey(log types.Log) (*OracleSetCryptoComparePublicKey, error) {
+	event := new(OracleSetCryptoComparePublicKey)
+	if err := _Oracle.contract.UnpackLog(event, "SetCryptoComparePublicKey", log); err != nil {
 		return nil, err
 	}
 	return event, nil
 }
 
-// OracleFailedUpdateRequestIterator is returned from FilterFailedUpdateRequest and is used to iterate over the raw logs and unpacked data for FailedUpdateRequest events raised by the Oracle contract.
-type OracleFailedUpdateRequestIterator struct {
-	Event *OracleFailedUpdateRequest // Event containing the contract specifics and raw log
+// OracleSetFeeTokenIterator is returned from FilterSetFeeToken and is used to iterate over the raw logs and unpacked data for SetFeeToken events raised by the Oracle contract.
+type OracleSetFeeTokenIterator struct {
+	Event *OracleSetFeeToken // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -570,7 +1823,7 @@ type OracleFailedUpdateRequestIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *OracleFailedUpdateRequestIterator) Next() bool {
+func (it *OracleSetFeeTokenIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -579,7 +1832,7 @@ func (it *OracleFailedUpdateRequestIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(OracleFailedUpdateRequest)
+			it.Event = new(OracleSetFeeToken)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -594,7 +1847,7 @@ func (it *OracleFailedUpdateRequestIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(OracleFailedUpdateRequest)
+		it.Event = new(OracleSetFeeToken)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -610,41 +1863,42 @@ func (it *OracleFailedUpdateRequestIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *OracleFailedUpdateRequestIterator) Error() error {
+func (it *OracleSetFeeTokenIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *OracleFailedUpdateRequestIterator) Close() error {
+func (it *OracleSetFeeTokenIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// OracleFailedUpdateRequest represents a FailedUpdateRequest event raised by the Oracle contract.
-type OracleFailedUpdateRequest struct {
-	Reason string
-	Raw    types.Log // Blockchain specific contextual infos
+// OracleSetFeeToken represents a SetFeeToken event raised by the Oracle contract.
+type OracleSetFeeToken struct {
+	Sender   common.Address
+	FeeToken common.Address
+	Raw      types.Log // Blockchain specific contextual infos
 }
 
-// FilterFailedUpdateRequest is a free log retrieval operation binding the contract event 0x4eb5629fd8501532aeb93b1b6a5b5b2ae398561e56514ed4b4b0c5ac2d381b6e.
+// FilterSetFeeToken is a free log retrieval operation binding the contract event 0xb1ac5f4390b9aad77c4732a494040d019bbf63781246e5c4212dd37858666cfb.
 //
-// Solidity: event FailedUpdateRequest(string _reason)
-func (_Oracle *OracleFilterer) FilterFailedUpdateRequest(opts *bind.FilterOpts) (*OracleFailedUpdateRequestIterator, error) {
+// Solidity: event SetFeeToken(address _sender, address _feeToken)
+func (_Oracle *OracleFilterer) FilterSetFeeToken(opts *bind.FilterOpts) (*OracleSetFeeTokenIterator, error) {
 
-	logs, sub, err := _Oracle.contract.FilterLogs(opts, "FailedUpdateRequest")
+	logs, sub, err := _Oracle.contract.FilterLogs(opts, "SetFeeToken")
 	if err != nil {
 		return nil, err
 	}
-	return &OracleFailedUpdateRequestIterator{contract: _Oracle.contract, event: "FailedUpdateRequest", logs: logs, sub: sub}, nil
+	return &OracleSetFeeTokenIterator{contract: _Oracle.contract, event: "SetFeeToken", logs: logs, sub: sub}, nil
 }
 
-// WatchFailedUpdateRequest is a free log subscription operation binding the contract event 0x4eb5629fd8501532aeb93b1b6a5b5b2ae398561e56514ed4b4b0c5ac2d381b6e.
+// WatchSetFeeToken is a free log subscription operation binding the contract event 0xb1ac5f4390b9aad77c4732a494040d019bbf63781246e5c4212dd37858666cfb.
 //
-// Solidity: event FailedUpdateRequest(string _reason)
-func (_Oracle *OracleFilterer) WatchFailedUpdateRequest(opts *bind.WatchOpts, sink chan<- *OracleFailedUpdateRequest) (event.Subscription, error) {
+// Solidity: event SetFeeToken(address _sender, address _feeToken)
+func (_Oracle *OracleFilterer) WatchSetFeeToken(opts *bind.WatchOpts, sink chan<- *OracleSetFeeToken) (event.Subscription, error) {
 
-	logs, sub, err := _Oracle.contract.WatchLogs(opts, "FailedUpdateRequest")
+	logs, sub, err := _Oracle.contract.WatchLogs(opts, "SetFeeToken")
 	if err != nil {
 		return nil, err
 	}
@@ -654,8 +1908,8 @@ func (_Oracle *OracleFilterer) WatchFailedUpdateRequest(opts *bind.WatchOpts, si
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(OracleFailedUpdateRequest)
-				if err := _Oracle.contract.UnpackLog(event, "FailedUpdateRequest", log); err != nil {
+				event := new(OracleSetFeeToken)
+				if err := _Oracle.contract.UnpackLog(event, "SetFeeToken", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -676,20 +1930,20 @@ func (_Oracle *OracleFilterer) WatchFailedUpdateRequest(opts *bind.WatchOpts, si
 	}), nil
 }
 
-// ParseFailedUpdateRequest is a log parse operation binding the contract event 0x4eb5629fd8501532aeb93b1b6a5b5b2ae398561e56514ed4b4b0c5ac2d381b6e.
+// ParseSetFeeToken is a log parse operation binding the contract event 0xb1ac5f4390b9aad77c4732a494040d019bbf63781246e5c4212dd37858666cfb.
 //
-// Solidity: event FailedUpdateRequest(string _reason)
-func (_Oracle *OracleFilterer) ParseFailedUpdateRequest(log types.Log) (*OracleFailedUpdateRequest, error) {
-	event := new(OracleFailedUpdateRequest)
-	if err := _Oracle.contract.UnpackLog(event, "FailedUpdateRequest", log); err != nil {
+// Solidity: event SetFeeToken(address _sender, address _feeToken)
+func (_Oracle *OracleFilterer) ParseSetFeeToken(log types.Log) (*OracleSetFeeToken, error) {
+	event := new(OracleSetFeeToken)
+	if err := _Oracle.contract.UnpackLog(event, "SetFeeToken", log); err != nil {
 		return nil, err
 	}
 	return event, nil
 }
 
-// OracleRequestedUpdateIterator is returned from FilterRequestedUpdate and is used to iterate over the raw logs and unpacked data for RequestedUpdate events raised by the Oracle contract.
-type OracleRequestedUpdateIterator struct {
-	Event *OracleRequestedUpdate // Event containing the contract specifics and raw log
+// OracleSetGasPriceIterator is returned from FilterSetGasPrice and is used to iterate over the raw logs and unpacked data for SetGasPrice events raised by the Oracle contract.
+type OracleSetGasPriceIterator struct {
+	Event *OracleSetGasPrice // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -703,7 +1957,7 @@ type OracleRequestedUpdateIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *OracleRequestedUpdateIterator) Next() bool {
+func (it *OracleSetGasPriceIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -712,7 +1966,7 @@ func (it *OracleRequestedUpdateIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(OracleRequestedUpdate)
+			it.Event = new(OracleSetGasPrice)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -727,7 +1981,7 @@ func (it *OracleRequestedUpdateIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(OracleRequestedUpdate)
+		it.Event = new(OracleSetGasPrice)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -743,42 +1997,42 @@ func (it *OracleRequestedUpdateIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *OracleRequestedUpdateIterator) Error() error {
+func (it *OracleSetGasPriceIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *OracleRequestedUpdateIterator) Close() error {
+func (it *OracleSetGasPriceIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// OracleRequestedUpdate represents a RequestedUpdate event raised by the Oracle contract.
-type OracleRequestedUpdate struct {
-	Symbol  string
-	QueryID [32]byte
-	Raw     types.Log // Blockchain specific contextual infos
+// OracleSetGasPrice represents a SetGasPrice event raised by the Oracle contract.
+type OracleSetGasPrice struct {
+	Sender   common.Address
+	GasPrice *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
 }
 
-// FilterRequestedUpdate is a free log retrieval operation binding the contract event 0x47737841f636da1ca9f2de10d9bfb96c4251e0b31de72a902d4fd4ac8797bbbe.
+// FilterSetGasPrice is a free log retrieval operation binding the contract event 0xfbd406825addb09beef160afc17bb80ba28df4a3533dcd23592b82658a1c5ab4.
 //
-// Solidity: event RequestedUpdate(string _symbol, bytes32 _queryID)
-func (_Oracle *OracleFilterer) FilterRequestedUpdate(opts *bind.FilterOpts) (*OracleRequestedUpdateIterator, error) {
+// Solidity: event SetGasPrice(address _sender, uint256 _gasPrice)
+func (_Oracle *OracleFilterer) FilterSetGasPrice(opts *bind.FilterOpts) (*OracleSetGasPriceIterator, error) {
 
-	logs, sub, err := _Oracle.contract.FilterLogs(opts, "RequestedUpdate")
+	logs, sub, err := _Oracle.contract.FilterLogs(opts, "SetGasPrice")
 	if err != nil {
 		return nil, err
 	}
-	return &OracleRequestedUpdateIterator{contract: _Oracle.contract, event: "RequestedUpdate", logs: logs, sub: sub}, nil
+	return &OracleSetGasPriceIterator{contract: _Oracle.contract, event: "SetGasPrice", logs: logs, sub: sub}, nil
 }
 
-// WatchRequestedUpdate is a free log subscription operation binding the contract event 0x47737841f636da1ca9f2de10d9bfb96c4251e0b31de72a902d4fd4ac8797bbbe.
+// WatchSetGasPrice is a free log subscription operation binding the contract event 0xfbd406825addb09beef160afc17bb80ba28df4a3533dcd23592b82658a1c5ab4.
 //
-// Solidity: event RequestedUpdate(string _symbol, bytes32 _queryID)
-func (_Oracle *OracleFilterer) WatchRequestedUpdate(opts *bind.WatchOpts, sink chan<- *OracleRequestedUpdate) (event.Subscription, error) {
+// Solidity: event SetGasPrice(address _sender, uint256 _gasPrice)
+func (_Oracle *OracleFilterer) WatchSetGasPrice(opts *bind.WatchOpts, sink chan<- *OracleSetGasPrice) (event.Subscription, error) {
 
-	logs, sub, err := _Oracle.contract.WatchLogs(opts, "RequestedUpdate")
+	logs, sub, err := _Oracle.contract.WatchLogs(opts, "SetGasPrice")
 	if err != nil {
 		return nil, err
 	}
@@ -788,8 +2042,8 @@ func (_Oracle *OracleFilterer) WatchRequestedUpdate(opts *bind.WatchOpts, sink c
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(OracleRequestedUpdate)
-				if err := _Oracle.contract.UnpackLog(event, "RequestedUpdate", log); err != nil {
+				event := new(OracleSetGasPrice)
+				if err := _Oracle.contract.UnpackLog(event, "SetGasPrice", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -810,20 +2064,20 @@ func (_Oracle *OracleFilterer) WatchRequestedUpdate(opts *bind.WatchOpts, sink c
 	}), nil
 }
 
-// ParseRequestedUpdate is a log parse operation binding the contract event 0x47737841f636da1ca9f2de10d9bfb96c4251e0b31de72a902d4fd4ac8797bbbe.
+// ParseSetGasPrice is a log parse operation binding the contract event 0xfbd406825addb09beef160afc17bb80ba28df4a3533dcd23592b82658a1c5ab4.
 //
-// Solidity: event RequestedUpdate(string _symbol, bytes32 _queryID)
-func (_Oracle *OracleFilterer) ParseRequestedUpdate(log types.Log) (*OracleRequestedUpdate, error) {
-	event := new(OracleRequestedUpdate)
-	if err := _Oracle.contract.UnpackLog(event, "RequestedUpdate", log); err != nil {
+// Solidity: event SetGasPrice(address _sender, uint256 _gasPrice)
+func (_Oracle *OracleFilterer) ParseSetGasPrice(log types.Log) (*OracleSetGasPrice, error) {
+	event := new(OracleSetGasPrice)
+	if err := _Oracle.contract.UnpackLog(event, "SetGasPrice", log); err != nil {
 		return nil, err
 	}
 	return event, nil
 }
 
-// OracleSetCryptoComparePublicKeyIterator is returned from FilterSetCryptoComparePublicKey and is used to iterate over the raw logs and unpacked data for SetCryptoComparePublicKey events raised by the Oracle contract.
-type OracleSetCryptoComparePublicKeyIterator struct {
-	Event *OracleSetCryptoComparePublicKey // Event containing the contract specifics and raw log
+// OracleSetMaintenanceIterator is returned from FilterSetMaintenance and is used to iterate over the raw logs and unpacked data for SetMaintenance events raised by the Oracle contract.
+type OracleSetMaintenanceIterator struct {
+	Event *OracleSetMaintenance // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -837,7 +2091,7 @@ type OracleSetCryptoComparePublicKeyIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *OracleSetCryptoComparePublicKeyIterator) Next() bool {
+func (it *OracleSetMaintenanceIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -846,7 +2100,7 @@ func (it *OracleSetCryptoComparePublicKeyIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(OracleSetCryptoComparePublicKey)
+			it.Event = new(OracleSetMaintenance)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -861,7 +2115,7 @@ func (it *OracleSetCryptoComparePublicKeyIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(OracleSetCryptoComparePublicKey)
+		it.Event = new(OracleSetMaintenance)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -877,42 +2131,42 @@ func (it *OracleSetCryptoComparePublicKeyIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *OracleSetCryptoComparePublicKeyIterator) Error() error {
+func (it *OracleSetMaintenanceIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *OracleSetCryptoComparePublicKeyIterator) Close() error {
+func (it *OracleSetMaintenanceIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// OracleSetCryptoComparePublicKey represents a SetCryptoComparePublicKey event raised by the Oracle contract.
-type OracleSetCryptoComparePublicKey struct {
-	Sender    common.Address
-	PublicKey []byte
-	Raw       types.Log // Blockchain specific contextual infos
+// OracleSetMaintenance represents a SetMaintenance event raised by the Oracle contract.
+type OracleSetMaintenance struct {
+	Sender      common.Address
+	Maintenance bool
+	Raw         types.Log // Blockchain specific contextual infos
 }
 
-// FilterSetCryptoComparePublicKey is a free log retrieval operation binding the contract event 0xc6b0860ba9f580e9c5b6ba4e0954fe82827096a99d92e8c2d73009539ea8d9fa.
+// FilterSetMaintenance is a free log retrieval operation binding the contract event 0x81d3792f99931878dc649df742ab18b0b22c5678d141123a7419ec105ff95c14.
 //
-// Solidity: event SetCryptoComparePublicKey(address _sender, bytes _publicKey)
-func (_Oracle *OracleFilterer) FilterSetCryptoComparePublicKey(opts *bind.FilterOpts) (*OracleSetCryptoComparePublicKeyIterator, error) {
+// Solidity: event SetMaintenance(address _sender, bool _maintenance)
+func (_Oracle *OracleFilterer) FilterSetMaintenance(opts *bind.FilterOpts) (*OracleSetMaintenanceIterator, error) {
 
-	logs, sub, err := _Oracle.contract.FilterLogs(opts, "SetCryptoComparePublicKey")
+	logs, sub, err := _Oracle.contract.FilterLogs(opts, "SetMaintenance")
 	if err != nil {
 		return nil, err
 	}
-	return &OracleSetCryptoComparePublicKeyIterator{contract: _Oracle.contract, event: "SetCryptoComparePublicKey", logs: logs, sub: sub}, nil
+	return &OracleSetMaintenanceIterator{contract: _Oracle.contract, event: "SetMaintenance", logs: logs, sub: sub}, nil
 }
 
-// WatchSetCryptoComparePublicKey is a free log subscription operation binding the contract event 0xc6b0860ba9f580e9c5b6ba4e0954fe82827096a99d92e8c2d73009539ea8d9fa.
+// WatchSetMaintenance is a free log subscription operation binding the contract event 0x81d3792f99931878dc649df742ab18b0b22c5678d141123a7419ec105ff95c14.
 //
-// Solidity: event SetCryptoComparePublicKey(address _sender, bytes _publicKey)
-func (_Oracle *OracleFilterer) WatchSetCryptoComparePublicKey(opts *bind.WatchOpts, sink chan<- *OracleSetCryptoComparePublicKey) (event.Subscription, error) {
+// Solidity: event SetMaintenance(address _sender, bool _maintenance)
+func (_Oracle *OracleFilterer) WatchSetMaintenance(opts *bind.WatchOpts, sink chan<- *OracleSetMaintenance) (event.Subscription, error) {
 
-	logs, sub, err := _Oracle.contract.WatchLogs(opts, "SetCryptoComparePublicKey")
+	logs, sub, err := _Oracle.contract.WatchLogs(opts, "SetMaintenance")
 	if err != nil {
 		return nil, err
 	}
@@ -922,8 +2176,8 @@ func (_Oracle *OracleFilterer) WatchSetCryptoComparePublicKey(opts *bind.WatchOp
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(OracleSetCryptoComparePublicKey)
-				if err := _Oracle.contract.UnpackLog(event, "SetCryptoComparePublicKey", log); err != nil {
+				event := new(OracleSetMaintenance)
+				if err := _Oracle.contract.UnpackLog(event, "SetMaintenance", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -944,20 +2198,20 @@ func (_Oracle *OracleFilterer) WatchSetCryptoComparePublicKey(opts *bind.WatchOp
 	}), nil
 }
 
-// ParseSetCryptoComparePublicKey is a log parse operation binding the contract event 0xc6b0860ba9f580e9c5b6ba4e0954fe82827096a99d92e8c2d73009539ea8d9fa.
+// ParseSetMaintenance is a log parse operation binding the contract event 0x81d3792f99931878dc649df742ab18b0b22c5678d141123a7419ec105ff95c14.
 //
-// Solidity: event SetCryptoComparePublicKey(address _sender, bytes _publicKey)
-func (_Oracle *OracleFilterer) ParseSetCryptoComparePublicKey(log types.Log) (*OracleSetCryptoComparePublicKey, error) {
-	event := new(OracleSetCryptoComparePublicKey)
-	if err := _Oracle.contract.UnpackLog(event, "SetCryptoComparePublicKey", log); err != nil {
+// Solidity: event SetMaintenance(address _sender, bool _maintenance)
+func (_Oracle *OracleFilterer) ParseSetMaintenance(log types.Log) (*OracleSetMaintenance, error) {
+	event := new(OracleSetMaintenance)
+	if err := _Oracle.contract.UnpackLog(event, "SetMaintenance", log); err != nil {
 		return nil, err
 	}
 	return event, nil
 }
 
-// OracleSetGasPriceIterator is returned from FilterSetGasPrice and is used to iterate over the raw logs and unpacked data for SetGasPrice events raised by the Oracle contract.
-type OracleSetGasPriceIterator struct {
-	Event *OracleSetGasPrice // Event containing the contract specifics and raw log
+// OracleSetMaxRateAgeIterator is returned from FilterSetMaxRateAge and is used to iterate over the raw logs and unpacked data for SetMaxRateAge events raised by the Oracle contract.
+type OracleSetMaxRateAgeIterator struct {
+	Event *OracleSetMaxRateAge // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -971,7 +2225,7 @@ type OracleSetGasPriceIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *OracleSetGasPriceIterator) Next() bool {
+func (it *OracleSetMaxRateAgeIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -980,7 +2234,7 @@ func (it *OracleSetGasPriceIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(OracleSetGasPrice)
+			it.Event = new(OracleSetMaxRateAge)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -995,7 +2249,7 @@ func (it *OracleSetGasPriceIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(OracleSetGasPrice)
+		it.Event = new(OracleSetMaxRateAge)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1011,42 +2265,42 @@ func (it *OracleSetGasPriceIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *OracleSetGasPriceIterator) Error() error {
+func (it *OracleSetMaxRateAgeIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *OracleSetGasPriceIterator) Close() error {
+func (it *OracleSetMaxRateAgeIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// OracleSetGasPrice represents a SetGasPrice event raised by the Oracle contract.
-type OracleSetGasPrice struct {
-	Sender   common.Address
-	GasPrice *big.Int
-	Raw      types.Log // Blockchain specific contextual infos
+// OracleSetMaxRateAge represents a SetMaxRateAge event raised by the Oracle contract.
+type OracleSetMaxRateAge struct {
+	Sender     common.Address
+	MaxRateAge *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
 }
 
-// FilterSetGasPrice is a free log retrieval operation binding the contract event 0xfbd406825addb09beef160afc17bb80ba28df4a3533dcd23592b82658a1c5ab4.
+// FilterSetMaxRateAge is a free log retrieval operation binding the contract event 0x756454c52752c361bad4728dee28df8738ab2fb0813b7a116e1b441668d47892.
 //
-// Solidity: event SetGasPrice(address _sender, uint256 _gasPrice)
-func (_Oracle *OracleFilterer) FilterSetGasPrice(opts *bind.FilterOpts) (*OracleSetGasPriceIterator, error) {
+// Solidity: event SetMaxRateAge(address _sender, uint256 _maxRateAge)
+func (_Oracle *OracleFilterer) FilterSetMaxRateAge(opts *bind.FilterOpts) (*OracleSetMaxRateAgeIterator, error) {
 
-	logs, sub, err := _Oracle.contract.FilterLogs(opts, "SetGasPrice")
+	logs, sub, err := _Oracle.contract.FilterLogs(opts, "SetMaxRateAge")
 	if err != nil {
 		return nil, err
 	}
-	return &OracleSetGasPriceIterator{contract: _Oracle.contract, event: "SetGasPrice", logs: logs, sub: sub}, nil
+	return &OracleSetMaxRateAgeIterator{contract: _Oracle.contract, event: "SetMaxRateAge", logs: logs, sub: sub}, nil
 }
 
-// WatchSetGasPrice is a free log subscription operation binding the contract event 0xfbd406825addb09beef160afc17bb80ba28df4a3533dcd23592b82658a1c5ab4.
+// WatchSetMaxRateAge is a free log subscription operation binding the contract event 0x756454c52752c361bad4728dee28df8738ab2fb0813b7a116e1b441668d47892.
 //
-// Solidity: event SetGasPrice(address _sender, uint256 _gasPrice)
-func (_Oracle *OracleFilterer) WatchSetGasPrice(opts *bind.WatchOpts, sink chan<- *OracleSetGasPrice) (event.Subscription, error) {
+// Solidity: event SetMaxRateAge(address _sender, uint256 _maxRateAge)
+func (_Oracle *OracleFilterer) WatchSetMaxRateAge(opts *bind.WatchOpts, sink chan<- *OracleSetMaxRateAge) (event.Subscription, error) {
 
-	logs, sub, err := _Oracle.contract.WatchLogs(opts, "SetGasPrice")
+	logs, sub, err := _Oracle.contract.WatchLogs(opts, "SetMaxRateAge")
 	if err != nil {
 		return nil, err
 	}
@@ -1056,8 +2310,8 @@ func (_Oracle *OracleFilterer) WatchSetGasPrice(opts *bind.WatchOpts, sink chan<
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(OracleSetGasPrice)
-				if err := _Oracle.contract.UnpackLog(event, "SetGasPrice", log); err != nil {
+				event := new(OracleSetMaxRateAge)
+				if err := _Oracle.contract.UnpackLog(event, "SetMaxRateAge", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1078,12 +2332,12 @@ func (_Oracle *OracleFilterer) WatchSetGasPrice(opts *bind.WatchOpts, sink chan<
 	}), nil
 }
 
-// ParseSetGasPrice is a log parse operation binding the contract event 0xfbd406825addb09beef160afc17bb80ba28df4a3533dcd23592b82658a1c5ab4.
+// ParseSetMaxRateAge is a log parse operation binding the contract event 0x756454c52752c361bad4728dee28df8738ab2fb0813b7a116e1b441668d47892.
 //
-// Solidity: event SetGasPrice(address _sender, uint256 _gasPrice)
-func (_Oracle *OracleFilterer) ParseSetGasPrice(log types.Log) (*OracleSetGasPrice, error) {
-	event := new(OracleSetGasPrice)
-	if err := _Oracle.contract.UnpackLog(event, "SetGasPrice", log); err != nil {
+// Solidity: event SetMaxRateAge(address _sender, uint256 _maxRateAge)
+func (_Oracle *OracleFilterer) ParseSetMaxRateAge(log types.Log) (*OracleSetMaxRateAge, error) {
+	event := new(OracleSetMaxRateAge)
+	if err := _Oracle.contract.UnpackLog(event, "SetMaxRateAge", log); err != nil {
 		return nil, err
 	}
 	return event, nil
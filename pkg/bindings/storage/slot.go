@@ -0,0 +1,33 @@
+// Package storage computes the storage slots of contract state, following the Solidity storage layout.
+package storage
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// MappingSlot returns the slot of the value stored under key in the mapping declared at baseSlot,
+// keccak256(key . baseSlot). Value type keys have to be padded to 32 bytes, see AddressMappingSlot and
+// UintMappingSlot, while string and bytes keys are used as they are.
+func MappingSlot(baseSlot uint64, key []byte) common.Hash {
+	return NestedMappingSlot(common.BigToHash(new(big.Int).SetUint64(baseSlot)), key)
+}
+
+// AddressMappingSlot returns the slot of the value stored under key in the address keyed mapping declared at baseSlot.
+func AddressMappingSlot(baseSlot uint64, key common.Address) common.Hash {
+	return MappingSlot(baseSlot, common.LeftPadBytes(key.Bytes(), 32))
+}
+
+// UintMappingSlot returns the slot of the value stored under key in the uint256 keyed mapping declared at baseSlot.
+func UintMappingSlot(baseSlot uint64, key *big.Int) common.Hash {
+	return MappingSlot(baseSlot, math.PaddedBigBytes(key, 32))
+}
+
+// NestedMappingSlot returns the slot of the value stored under key in the mapping stored at slot,
+// e.g. the inner mapping of a mapping of mappings, whose slot is given by the outer mapping.
+func NestedMappingSlot(slot common.Hash, key []byte) common.Hash {
+	return crypto.Keccak256Hash(key, slot.Bytes())
+}
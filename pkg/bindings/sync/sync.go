@@ -0,0 +1,216 @@
+// Package sync replays the events of contracts from genesis and keeps following them, resuming from a checkpoint.
+package sync
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings/checkpoint"
+	"github.com/tokencard/contracts/v3/pkg/export"
+)
+
+// DefaultPageSize is the number of blocks queried at once, providers limit the range of a single log query.
+const DefaultPageSize = 5000
+
+// DefaultConfirmations is how deep a block has to be before its events are handled, by default.
+const DefaultConfirmations = 12
+
+// ErrReorgBeyondCheckpoint is returned when the checkpointed block was reorganised away, i.e. when events
+// that were already handled may no longer be part of the chain.
+var ErrReorgBeyondCheckpoint = errors.New("checkpointed block is no longer canonical")
+
+// Backend is the chain access required by Full.
+type Backend interface {
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error)
+}
+
+type config struct {
+	pageSize      uint64
+	confirmations uint64
+}
+
+// Option configures Full.
+type Option func(*config)
+
+// WithPageSize sets how many blocks are queried at once, DefaultPageSize by default.
+func WithPageSize(n uint64) Option {
+	return func(c *config) {
+		c.pageSize = n
+	}
+}
+
+// WithConfirmations sets how deep a block has to be before its events are handled, DefaultConfirmations by default.
+func WithConfirmations(n uint64) Option {
+	return func(c *config) {
+		c.confirmations = n
+	}
+}
+
+// Full calls handle with every event emitted by the contracts in abis, which maps their addresses to their ABI,
+// in the order they were emitted. It starts from genesis, or from the block after the checkpoint in store, and
+// once it caught up with the chain it follows new blocks until ctx is done or an error occurs.
+//
+// Reorgs are avoided rather than undone: a block's events are only handled once it has enough confirmations,
+// and Full fails with ErrReorgBeyondCheckpoint if the checkpointed block was reorganised away anyway.
+// The checkpoint is saved after each page of blocks, so after a restart the events of the page that was being
+// handled are handled again; handle must be idempotent. Events missing from the ABI of their contract are skipped.
+func Full(ctx context.Context, backend Backend, abis map[common.Address]string, store checkpoint.Store, handle func(export.DecodedEvent), opts ...Option) error {
+	cfg := config{pageSize: DefaultPageSize, confirmations: DefaultConfirmations}
+	for _, o := range opts {
+		o(&cfg)
+	}
+	if cfg.pageSize == 0 {
+		return errors.New("invalid page size 0")
+	}
+
+	parsed := make(map[common.Address]abi.ABI, len(abis))
+	addresses := make([]common.Address, 0, len(abis))
+	for a, j := range abis {
+		p, err := abi.JSON(strings.NewReader(j))
+		if err != nil {
+			return errors.Wrapf(err, "parsing ABI of %s", a.Hex())
+		}
+		parsed[a] = p
+		addresses = append(addresses, a)
+	}
+
+	s := syncer{
+		backend:   backend,
+		abis:      abis,
+		parsed:    parsed,
+		addresses: addresses,
+		store:     store,
+		handle:    handle,
+		cfg:       cfg,
+	}
+	cp, ok, err := store.Load(ctx)
+	if err != nil {
+		return errors.Wrap(err, "loading checkpoint")
+	}
+	if ok {
+		s.checkpoint = &cp
+	}
+
+	// Subscribe before catching up, so that no new block is missed in between.
+	heads := make(chan *types.Header)
+	sub, err := backend.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return errors.Wrap(err, "subscribing to new blocks")
+	}
+	defer sub.Unsubscribe()
+
+	if err := s.catchUp(ctx); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "syncing")
+		case err := <-sub.Err():
+			return errors.Wrap(err, "new block subscription failed")
+		case <-heads:
+			if err := s.catchUp(ctx); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type syncer struct {
+	backend    Backend
+	abis       map[common.Address]string
+	parsed     map[common.Address]abi.ABI
+	addresses  []common.Address
+	store      checkpoint.Store
+	handle     func(export.DecodedEvent)
+	cfg        config
+	checkpoint *checkpoint.Checkpoint
+}
+
+// catchUp handles the events of the confirmed blocks after the checkpoint, one page at a time.
+func (s *syncer) catchUp(ctx context.Context) error {
+	head, err := s.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "getting chain head")
+	}
+	if head.Number.Uint64() < s.cfg.confirmations {
+		return nil
+	}
+	target := head.Number.Uint64() - s.cfg.confirmations
+
+	var next uint64
+	if s.checkpoint != nil {
+		if err := s.checkCanonical(ctx); err != nil {
+			return err
+		}
+		next = s.checkpoint.BlockNumber + 1
+	}
+	for next <= target {
+		end := next + s.cfg.pageSize - 1
+		if end > target || end < next {
+			end = target
+		}
+		if err := s.page(ctx, next, end); err != nil {
+			return err
+		}
+		next = end + 1
+	}
+	return nil
+}
+
+// checkCanonical fails if the checkpointed block was reorganised away.
+func (s *syncer) checkCanonical(ctx context.Context) error {
+	h, err := s.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(s.checkpoint.BlockNumber))
+	if err != nil {
+		return errors.Wrapf(err, "getting header of block %d", s.checkpoint.BlockNumber)
+	}
+	if h.Hash() != s.checkpoint.BlockHash {
+		return ErrReorgBeyondCheckpoint
+	}
+	return nil
+}
+
+// page handles the events of the blocks from start to end (both inclusive), then checkpoints end.
+func (s *syncer) page(ctx context.Context, start, end uint64) error {
+	logs, err := s.backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(start),
+		ToBlock:   new(big.Int).SetUint64(end),
+		Addresses: s.addresses,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "filtering logs of blocks %d-%d", start, end)
+	}
+	for _, l := range logs {
+		if l.Removed || len(l.Topics) == 0 {
+			continue
+		}
+		parsed := s.parsed[l.Address]
+		if event, err := parsed.EventByID(l.Topics[0]); err != nil || event.Anonymous {
+			continue
+		}
+		e, err := export.Decode(s.abis[l.Address], l)
+		if err != nil {
+			return errors.Wrapf(err, "decoding log %d of transaction %s", l.Index, l.TxHash.Hex())
+		}
+		s.handle(e)
+	}
+
+	h, err := s.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(end))
+	if err != nil {
+		return errors.Wrapf(err, "getting header of block %d", end)
+	}
+	cp := checkpoint.Checkpoint{BlockNumber: end, BlockHash: h.Hash()}
+	if err := s.store.Save(ctx, cp); err != nil {
+		return errors.Wrap(err, "saving checkpoint")
+	}
+	s.checkpoint = &cp
+	return nil
+}
@@ -0,0 +1,65 @@
+// Package calldata names the contract method a transaction calls.
+package calldata
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+)
+
+// ABI is a contract ABI together with the name of the contract, which a bare abi.ABI doesn't carry.
+type ABI struct {
+	Name string
+	abi.ABI
+}
+
+// defaultABIs are the ABIs of the contracts in this repository, in the order they are matched.
+var defaultABIs = []struct {
+	name string
+	json string
+}{
+	{"Wallet", bindings.WalletABI},
+	{"WalletDeployer", bindings.WalletDeployerABI},
+	{"WalletCache", bindings.WalletCacheABI},
+	{"Controller", bindings.ControllerABI},
+	{"Licence", bindings.LicenceABI},
+	{"Holder", bindings.HolderABI},
+	{"Oracle", bindings.OracleABI},
+	{"TokenWhitelist", bindings.TokenWhitelistABI},
+	{"ParseIntScientificExporter", mocks.ParseIntScientificExporterABI},
+}
+
+// DefaultABIs returns the ABIs of the contracts in this repository.
+func DefaultABIs() ([]ABI, error) {
+	abis := make([]ABI, len(defaultABIs))
+	for i, d := range defaultABIs {
+		parsed, err := abi.JSON(strings.NewReader(d.json))
+		if err != nil {
+			return nil, err
+		}
+		abis[i] = ABI{Name: d.name, ABI: parsed}
+	}
+	return abis, nil
+}
+
+// Classify finds the method whose selector prefixes data and decodes its arguments. When several ABIs share
+// a selector (e.g. owner()) the first one wins. ok is false if no method matches or the arguments can't be decoded.
+func Classify(data []byte, abis []ABI) (contract, method string, args []interface{}, ok bool) {
+	if len(data) < 4 {
+		return "", "", nil, false
+	}
+	for _, a := range abis {
+		m, err := a.MethodById(data[:4])
+		if err != nil {
+			continue
+		}
+		args, err := m.Inputs.UnpackValues(data[4:])
+		if err != nil {
+			return "", "", nil, false
+		}
+		return a.Name, m.Name, args, true
+	}
+	return "", "", nil, false
+}
@@ -0,0 +1,55 @@
+// Package deploy checks contracts before they are deployed.
+package deploy
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/pkg/errors"
+)
+
+// MaxCodeSize is the largest runtime code a contract can be deployed with, see EIP-170.
+const MaxCodeSize = params.MaxCodeSize
+
+// preflightGasLimit is far above the block gas limit, so that Preflight reports the gas of any constructor.
+const preflightGasLimit = 1000000000
+
+// preflightChainConfig enables the Istanbul rules, except for EIP-158 whose code size limit would abort the
+// creation of oversized contracts before their size could be reported.
+var preflightChainConfig = &params.ChainConfig{
+	ChainID:             big.NewInt(1),
+	HomesteadBlock:      new(big.Int),
+	EIP150Block:         new(big.Int),
+	EIP155Block:         new(big.Int),
+	ByzantiumBlock:      new(big.Int),
+	ConstantinopleBlock: new(big.Int),
+	PetersburgBlock:     new(big.Int),
+	IstanbulBlock:       new(big.Int),
+}
+
+// Preflight runs the hex encoded creation code bin, i.e. the constructor followed by any ABI encoded arguments,
+// in an empty in-memory EVM. It returns the size of the resulting runtime code, whether it exceeds MaxCodeSize
+// and the gas the deployment transaction would use. Constructors calling other contracts see an empty chain,
+// so they may fail here even though they would succeed once deployed.
+func Preflight(bin string) (codeSize int, overLimit bool, estimatedGas uint64, err error) {
+	input := common.FromHex(bin)
+	if len(input) == 0 {
+		return 0, false, 0, errors.New("empty creation code")
+	}
+	intrinsic, err := core.IntrinsicGas(input, true, true, true)
+	if err != nil {
+		return 0, false, 0, errors.Wrap(err, "computing intrinsic gas")
+	}
+	cfg := &runtime.Config{
+		ChainConfig: preflightChainConfig,
+		GasLimit:    preflightGasLimit,
+	}
+	code, _, leftOverGas, err := runtime.Create(input, cfg)
+	if err != nil {
+		return 0, false, 0, errors.Wrap(err, "running constructor")
+	}
+	return len(code), len(code) > MaxCodeSize, intrinsic + preflightGasLimit - leftOverGas, nil
+}
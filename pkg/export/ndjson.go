@@ -0,0 +1,126 @@
+// Package export dumps contract events for offline processing.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"reflect"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/logdec"
+)
+
+// PageSize is the number of blocks queried at once, providers limit the range of a single log query.
+const PageSize = 5000
+
+// Event is the JSON object written for each log. Event and Args are only set if the ABI defines the event,
+// the raw Topics and Data are always included.
+type Event struct {
+	Address          string                 `json:"address"`
+	BlockNumber      uint64                 `json:"blockNumber"`
+	BlockHash        common.Hash            `json:"blockHash"`
+	TransactionHash  common.Hash            `json:"transactionHash"`
+	TransactionIndex uint                   `json:"transactionIndex"`
+	LogIndex         uint                   `json:"logIndex"`
+	Event            string                 `json:"event,omitempty"`
+	Args             map[string]interface{} `json:"args,omitempty"`
+	Topics           []common.Hash          `json:"topics"`
+	Data             hexutil.Bytes          `json:"data"`
+}
+
+// EventsNDJSON writes the events emitted by the given contracts between fromBlock and toBlock (both inclusive)
+// to w as newline-delimited JSON, in the order they were emitted. Events are decoded with the given ABI,
+// numbers are written as decimal strings, addresses checksummed and byte arrays as hex strings so no precision is lost.
+func EventsNDJSON(ctx context.Context, client ethereum.LogFilterer, abiJSON string, addrs []common.Address, fromBlock, toBlock uint64, w io.Writer) error {
+	if toBlock < fromBlock {
+		return errors.Errorf("invalid block range %d-%d", fromBlock, toBlock)
+	}
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return errors.Wrap(err, "parsing ABI")
+	}
+	enc := json.NewEncoder(w)
+	for start := fromBlock; ; start += PageSize {
+		end := start + PageSize - 1
+		if end > toBlock || end < start {
+			end = toBlock
+		}
+		logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(start),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: addrs,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "filtering logs of blocks %d-%d", start, end)
+		}
+		for _, l := range logs {
+			e := Event{
+				Address:          l.Address.Hex(),
+				BlockNumber:      l.BlockNumber,
+				BlockHash:        l.BlockHash,
+				TransactionHash:  l.TxHash,
+				TransactionIndex: l.TxIndex,
+				LogIndex:         l.Index,
+				Topics:           l.Topics,
+				Data:             l.Data,
+			}
+			if len(l.Topics) > 0 {
+				if event, err := parsed.EventByID(l.Topics[0]); err == nil && !event.Anonymous {
+					name, fields, err := logdec.ToMap(abiJSON, l)
+					if err != nil {
+						return errors.Wrapf(err, "decoding log %d of transaction %s", l.Index, l.TxHash.Hex())
+					}
+					e.Event = name
+					e.Args = make(map[string]interface{}, len(fields))
+					for k, v := range fields {
+						e.Args[k] = jsonValue(v)
+					}
+				}
+			}
+			if err := enc.Encode(e); err != nil {
+				return errors.Wrap(err, "writing event")
+			}
+		}
+		if end == toBlock {
+			return nil
+		}
+	}
+}
+
+// jsonValue converts decoded ABI values into values that are encoded losslessly.
+func jsonValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case *big.Int:
+		return t.String()
+	case []byte:
+		return hexutil.Bytes(t)
+	case common.Address:
+		return t.Hex()
+	case common.Hash, string, bool:
+		return t
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return hexutil.Bytes(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		out := make([]interface{}, rv.Len())
+		for i := range out {
+			out[i] = jsonValue(rv.Index(i).Interface())
+		}
+		return out
+	}
+	return v
+}
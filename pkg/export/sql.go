@@ -0,0 +1,103 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/tokencard/contracts/v3/pkg/logdec"
+)
+
+// DecodedEvent is a log decoded with the ABI of the contract that emitted it.
+type DecodedEvent struct {
+	Address     common.Address
+	BlockNumber uint64
+	TxHash      common.Hash
+	LogIndex    uint
+	Name        string
+	Args        map[string]interface{}
+}
+
+// Decode decodes a log emitted by a contract with the given ABI.
+func Decode(abiJSON string, log types.Log) (DecodedEvent, error) {
+	name, args, err := logdec.ToMap(abiJSON, log)
+	if err != nil {
+		return DecodedEvent{}, err
+	}
+	return DecodedEvent{
+		Address:     log.Address,
+		BlockNumber: log.BlockNumber,
+		TxHash:      log.TxHash,
+		LogIndex:    log.Index,
+		Name:        name,
+		Args:        args,
+	}, nil
+}
+
+// SQLRows lays decoded events out as table rows for a bulk insert, e.g. with Postgres' COPY.
+// The columns are block_number, transaction_hash, log_index and event followed by one column per argument,
+// named after the argument prefixed with arg_ and sorted by name.
+//
+// The argument columns are taken from the first event, so events should be sent one event type at a time,
+// an argument missing from a later event is NULL. Block numbers and log indices are int64 values, numbers
+// are numeric strings, addresses and bytes hex strings and arrays JSON arrays.
+// The rows are read from the channel as rows is called, until it's closed or yield returns false.
+func SQLRows(events <-chan DecodedEvent) (columns []string, rows func(yield func([]interface{}) bool)) {
+	columns = []string{"block_number", "transaction_hash", "log_index", "event"}
+	first, ok := <-events
+	if !ok {
+		return columns, func(func([]interface{}) bool) {}
+	}
+	var args []string
+	for name := range first.Args {
+		args = append(args, name)
+	}
+	sort.Strings(args)
+	for _, name := range args {
+		columns = append(columns, "arg_"+strings.TrimPrefix(name, "_"))
+	}
+	row := func(e DecodedEvent) []interface{} {
+		r := []interface{}{int64(e.BlockNumber), e.TxHash.Hex(), int64(e.LogIndex), e.Name}
+		for _, name := range args {
+			v, ok := e.Args[name]
+			if !ok {
+				r = append(r, nil)
+				continue
+			}
+			r = append(r, sqlValue(v))
+		}
+		return r
+	}
+	return columns, func(yield func([]interface{}) bool) {
+		if !yield(row(first)) {
+			return
+		}
+		for e := range events {
+			if !yield(row(e)) {
+				return
+			}
+		}
+	}
+}
+
+// sqlValue converts a decoded ABI value into a value every SQL driver accepts.
+func sqlValue(v interface{}) interface{} {
+	switch t := jsonValue(v).(type) {
+	case string, bool:
+		return t
+	case hexutil.Bytes:
+		return t.String()
+	case common.Hash:
+		return t.Hex()
+	case []interface{}:
+		// jsonValue only returns JSON friendly elements, encoding them can't fail.
+		b, _ := json.Marshal(t)
+		return string(b)
+	}
+	// The remaining values are the integers of less than 64 bits.
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,175 @@
+// Package feedmonitor checks the integrity of the token rate feed, e.g. to alert on replayed or reordered updates.
+package feedmonitor
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+)
+
+// AnomalyKind tells what is wrong with a rate update.
+type AnomalyKind int
+
+const (
+	// Backwards is an update dated before the previous update of the token.
+	Backwards AnomalyKind = iota
+	// Duplicate is an update with the same date as an earlier update of the token, e.g. a replay.
+	Duplicate
+	// Undated is an update made by a transaction the date can't be recovered from, e.g. one relayed by a multisig.
+	Undated
+)
+
+func (k AnomalyKind) String() string {
+	switch k {
+	case Backwards:
+		return "backwards"
+	case Duplicate:
+		return "duplicate"
+	case Undated:
+		return "undated"
+	}
+	return "unknown"
+}
+
+// Anomaly is a suspicious rate update. Dates are in the whitelist's format, e.g. 20180913153211.
+type Anomaly struct {
+	Kind        AnomalyKind
+	Token       common.Address
+	BlockNumber uint64
+	TxHash      common.Hash
+	Date        uint64
+	// PreviousDate is the date of the token's previous update, 0 for its first update.
+	PreviousDate uint64
+}
+
+// Backend is the chain access required to scan the rate updates and the transactions that made them.
+type Backend interface {
+	bind.ContractFilterer
+	TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error)
+}
+
+// The offset and layout of the date in the headers of an oracle proof, e.g. "03 Oct 2018 17:00:22" in
+// "date: Wed, 03 Oct 2018 17:00:22 GMT", and the offset of the headers in the proof.
+const (
+	proofHeadersOffset = 69
+	proofDateOffset    = 11
+	proofDateLayout    = "02 Jan 2006 15:04:05"
+	dateLayout         = "20060102150405"
+)
+
+var (
+	whitelistABI = mustParseABI(bindings.TokenWhitelistABI)
+	oracleABI    = mustParseABI(bindings.OracleABI)
+)
+
+func mustParseABI(s string) abi.ABI {
+	a, err := abi.JSON(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// CheckMonotonic scans the rate updates of a token on the whitelist from fromBlock and flags the updates dated
+// before the previous one or with a date that was already written. The UpdatedTokenRate event doesn't carry the
+// date, so it is recovered from the transaction that made the update: the _updateDate of a direct updateTokenRate
+// call, or the signed date header of the proof passed to the oracle's __callback.
+func CheckMonotonic(ctx context.Context, backend Backend, whitelist, token common.Address, fromBlock uint64) ([]Anomaly, error) {
+	filterer, err := bindings.NewTokenWhitelistFilterer(whitelist, backend)
+	if err != nil {
+		return nil, errors.Wrap(err, "binding the token whitelist")
+	}
+	it, err := filterer.FilterUpdatedTokenRate(&bind.FilterOpts{Start: fromBlock, Context: ctx})
+	if err != nil {
+		return nil, errors.Wrap(err, "filtering rate updates")
+	}
+	defer it.Close()
+
+	var anomalies []Anomaly
+	var previous uint64
+	seen := map[uint64]bool{}
+	for it.Next() {
+		if it.Event.Token != token {
+			continue
+		}
+		log := it.Event.Raw
+		tx, _, err := backend.TransactionByHash(ctx, log.TxHash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting transaction %s", log.TxHash.Hex())
+		}
+		anomaly := Anomaly{Token: token, BlockNumber: log.BlockNumber, TxHash: log.TxHash, PreviousDate: previous}
+		date, ok, err := updateDate(tx.Data(), token)
+		if err != nil {
+			return nil, errors.Wrapf(err, "decoding transaction %s", log.TxHash.Hex())
+		}
+		if !ok {
+			anomaly.Kind = Undated
+			anomalies = append(anomalies, anomaly)
+			continue
+		}
+		anomaly.Date = date
+		switch {
+		case seen[date]:
+			anomaly.Kind = Duplicate
+			anomalies = append(anomalies, anomaly)
+		case date < previous:
+			anomaly.Kind = Backwards
+			anomalies = append(anomalies, anomaly)
+		}
+		seen[date] = true
+		previous = date
+	}
+	if err := it.Error(); err != nil {
+		return nil, errors.Wrap(err, "iterating rate updates")
+	}
+	return anomalies, nil
+}
+
+// updateDate recovers the date of the token's rate update from the input of the transaction that made it.
+// It returns false if the transaction isn't a direct updateTokenRate or __callback call.
+func updateDate(input []byte, token common.Address) (uint64, bool, error) {
+	if len(input) < 4 {
+		return 0, false, nil
+	}
+	if method := whitelistABI.Methods["updateTokenRate"]; bytes.Equal(input[:4], method.ID()) {
+		args, err := method.Inputs.UnpackValues(input[4:])
+		if err != nil {
+			return 0, false, errors.Wrap(err, "unpacking updateTokenRate")
+		}
+		if args[0].(common.Address) != token {
+			return 0, false, nil
+		}
+		return args[2].(*big.Int).Uint64(), true, nil
+	}
+	if method := oracleABI.Methods["__callback"]; bytes.Equal(input[:4], method.ID()) {
+		args, err := method.Inputs.UnpackValues(input[4:])
+		if err != nil {
+			return 0, false, errors.Wrap(err, "unpacking __callback")
+		}
+		proof := args[2].([]byte)
+		start := proofHeadersOffset + proofDateOffset
+		if len(proof) < start+len(proofDateLayout) {
+			return 0, false, errors.New("proof too short")
+		}
+		t, err := time.Parse(proofDateLayout, string(proof[start:start+len(proofDateLayout)]))
+		if err != nil {
+			return 0, false, errors.Wrap(err, "parsing proof date")
+		}
+		date, err := strconv.ParseUint(t.Format(dateLayout), 10, 64)
+		if err != nil {
+			return 0, false, errors.Wrap(err, "encoding proof date")
+		}
+		return date, true, nil
+	}
+	return 0, false, nil
+}
@@ -0,0 +1,188 @@
+// Package inspect collects the full state of a deployed wallet into a single report.
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+)
+
+// maxWhitelistLength bounds how many whitelist entries are read.
+const maxWhitelistLength = 1000
+
+// Limit is the state of one of the wallet's daily limits.
+type Limit struct {
+	Value                          *big.Int
+	Available                      *big.Int
+	Pending                        *big.Int
+	ControllerConfirmationRequired bool
+}
+
+// Report is the state of a wallet. Fields that the inspected wallet doesn't support are left
+// empty and their view names are listed in Unsupported.
+type Report struct {
+	Version      string
+	Owner        common.Address
+	Transferable bool
+	RelayNonce   *big.Int
+
+	SpendLimit    Limit
+	GasTopUpLimit Limit
+	LoadLimit     Limit
+
+	WhitelistInitialized     bool
+	Whitelist                []common.Address
+	PendingWhitelistAddition []common.Address
+	PendingWhitelistRemoval  []common.Address
+
+	ConfirmThreshold   *big.Int
+	RelayerRefundCap   *big.Int
+	RelayerRefundToken common.Address
+
+	Unsupported []string
+}
+
+// Wallet reads every view of the wallet bound to caller. Only the owner is required,
+// any other view that fails is recorded as unsupported, e.g. on wallets predating it.
+func Wallet(ctx context.Context, caller *bindings.WalletCaller) (Report, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	r := Report{}
+
+	var err error
+	r.Owner, err = caller.Owner(opts)
+	if err != nil {
+		return Report{}, errors.Wrap(err, "getting wallet owner")
+	}
+
+	unsupported := func(view string, err error) {
+		if err != nil {
+			r.Unsupported = append(r.Unsupported, view)
+		}
+	}
+
+	r.Version, err = caller.WALLETVERSION(opts)
+	unsupported("WALLET_VERSION", err)
+	r.Transferable, err = caller.IsTransferable(opts)
+	unsupported("isTransferable", err)
+	r.RelayNonce, err = caller.RelayNonce(opts)
+	unsupported("relayNonce", err)
+
+	r.SpendLimit.Value, err = caller.SpendLimitValue(opts)
+	unsupported("spendLimitValue", err)
+	r.SpendLimit.Available, err = caller.SpendLimitAvailable(opts)
+	unsupported("spendLimitAvailable", err)
+	r.SpendLimit.Pending, err = caller.SpendLimitPending(opts)
+	unsupported("spendLimitPending", err)
+	r.SpendLimit.ControllerConfirmationRequired, err = caller.SpendLimitControllerConfirmationRequired(opts)
+	unsupported("spendLimitControllerConfirmationRequired", err)
+
+	r.GasTopUpLimit.Value, err = caller.GasTopUpLimitValue(opts)
+	unsupported("gasTopUpLimitValue", err)
+	r.GasTopUpLimit.Available, err = caller.GasTopUpLimitAvailable(opts)
+	unsupported("gasTopUpLimitAvailable", err)
+	r.GasTopUpLimit.Pending, err = caller.GasTopUpLimitPending(opts)
+	unsupported("gasTopUpLimitPending", err)
+	r.GasTopUpLimit.ControllerConfirmationRequired, err = caller.GasTopUpLimitControllerConfirmationRequired(opts)
+	unsupported("gasTopUpLimitControllerConfirmationRequired", err)
+
+	r.LoadLimit.Value, err = caller.LoadLimitValue(opts)
+	unsupported("loadLimitValue", err)
+	r.LoadLimit.Available, err = caller.LoadLimitAvailable(opts)
+	unsupported("loadLimitAvailable", err)
+	r.LoadLimit.Pending, err = caller.LoadLimitPending(opts)
+	unsupported("loadLimitPending", err)
+	r.LoadLimit.ControllerConfirmationRequired, err = caller.LoadLimitControllerConfirmationRequired(opts)
+	unsupported("loadLimitControllerConfirmationRequired", err)
+
+	r.WhitelistInitialized, err = caller.IsSetWhitelist(opts)
+	unsupported("isSetWhitelist", err)
+	// There is no length getter, reading past the end of the array reverts.
+	for i := int64(0); i < maxWhitelistLength; i++ {
+		a, err := caller.WhitelistArray(opts, big.NewInt(i))
+		if isRevert(err) {
+			break
+		}
+		if err != nil {
+			return Report{}, errors.Wrapf(err, "getting whitelist entry %d", i)
+		}
+		r.Whitelist = append(r.Whitelist, a)
+	}
+	r.PendingWhitelistAddition, err = caller.PendingWhitelistAddition(opts)
+	unsupported("pendingWhitelistAddition", err)
+	r.PendingWhitelistRemoval, err = caller.PendingWhitelistRemoval(opts)
+	unsupported("pendingWhitelistRemoval", err)
+
+	r.ConfirmThreshold, err = caller.ConfirmThreshold(opts)
+	unsupported("confirmThreshold", err)
+	r.RelayerRefundCap, err = caller.RelayerRefundCap(opts)
+	unsupported("relayerRefundCap", err)
+	r.RelayerRefundToken, err = caller.RelayerRefundToken(opts)
+	unsupported("relayerRefundToken", err)
+
+	if ctx.Err() != nil {
+		return Report{}, ctx.Err()
+	}
+	return r, nil
+}
+
+// isRevert is true if the call failed because the contract reverted rather than because of the node. Depending
+// on the node, a revert is either an empty result, which can't be unpacked, or an error reported by the node.
+func isRevert(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "attempting to unmarshall an empty string") ||
+		strings.Contains(msg, "execution reverted") ||
+		strings.Contains(msg, "invalid opcode")
+}
+
+// String returns the report in a human readable form.
+func (r Report) String() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "version:             %s\n", r.Version)
+	fmt.Fprintf(b, "owner:               %s (transferable: %t)\n", r.Owner.Hex(), r.Transferable)
+	fmt.Fprintf(b, "relay nonce:         %s\n", str(r.RelayNonce))
+	writeLimit(b, "spend limit", r.SpendLimit)
+	writeLimit(b, "gas top up limit", r.GasTopUpLimit)
+	writeLimit(b, "load limit", r.LoadLimit)
+	fmt.Fprintf(b, "whitelist:           %s (initialized: %t)\n", addresses(r.Whitelist), r.WhitelistInitialized)
+	fmt.Fprintf(b, "pending addition:    %s\n", addresses(r.PendingWhitelistAddition))
+	fmt.Fprintf(b, "pending removal:     %s\n", addresses(r.PendingWhitelistRemoval))
+	fmt.Fprintf(b, "confirm threshold:   %s\n", str(r.ConfirmThreshold))
+	fmt.Fprintf(b, "relayer refund cap:  %s (token: %s)\n", str(r.RelayerRefundCap), r.RelayerRefundToken.Hex())
+	if len(r.Unsupported) > 0 {
+		fmt.Fprintf(b, "unsupported:         %s\n", strings.Join(r.Unsupported, ", "))
+	}
+	return b.String()
+}
+
+func writeLimit(b *strings.Builder, name string, l Limit) {
+	fmt.Fprintf(b, "%-21s%s (available: %s, pending: %s, controller confirmation required: %t)\n",
+		name+":", str(l.Value), str(l.Available), str(l.Pending), l.ControllerConfirmationRequired)
+}
+
+func addresses(as []common.Address) string {
+	if len(as) == 0 {
+		return "-"
+	}
+	hex := make([]string, len(as))
+	for i, a := range as {
+		hex[i] = a.Hex()
+	}
+	return strings.Join(hex, ", ")
+}
+
+// str formats a possibly unset number.
+func str(n *big.Int) string {
+	if n == nil {
+		return "-"
+	}
+	return n.String()
+}
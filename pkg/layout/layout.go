@@ -0,0 +1,70 @@
+// Package layout fingerprints contract storage layouts to catch incompatible changes before an upgrade.
+package layout
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// storageLayout is the storage layout JSON emitted by solc --storage-layout.
+type storageLayout struct {
+	Storage []variable             `json:"storage"`
+	Types   map[string]storageType `json:"types"`
+}
+
+type variable struct {
+	Label  string `json:"label"`
+	Offset uint   `json:"offset"`
+	Slot   string `json:"slot"`
+	Type   string `json:"type"`
+}
+
+type storageType struct {
+	Encoding      string     `json:"encoding"`
+	Label         string     `json:"label"`
+	NumberOfBytes string     `json:"numberOfBytes"`
+	Members       []variable `json:"members"`
+}
+
+// Hash returns a hex encoded hash of the declared storage layout, as emitted by solc --storage-layout.
+// The hash covers the slot, offset, name and type of every variable and struct member so any reordering,
+// retyping or renaming changes it, while the order of the entries in the JSON and compiler assigned ids don't.
+func Hash(layoutJSON []byte) (string, error) {
+	var l storageLayout
+	err := json.Unmarshal(layoutJSON, &l)
+	if err != nil {
+		return "", errors.Wrap(err, "parsing storage layout")
+	}
+	if l.Storage == nil {
+		return "", errors.New("not a storage layout: missing storage entries")
+	}
+
+	entries, err := l.describe(l.Storage)
+	if err != nil {
+		return "", err
+	}
+	return crypto.Keccak256Hash([]byte(strings.Join(entries, "\n"))).Hex(), nil
+}
+
+// describe returns one sorted entry per variable, struct members are described within their variable's entry.
+func (l storageLayout) describe(vars []variable) ([]string, error) {
+	entries := make([]string, len(vars))
+	for i, v := range vars {
+		t, ok := l.Types[v.Type]
+		if !ok {
+			return nil, errors.Errorf("unknown type %s of %s", v.Type, v.Label)
+		}
+		members, err := l.describe(t.Members)
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = fmt.Sprintf("%064s:%02d:%s:%s:%s:%s{%s}", v.Slot, v.Offset, v.Label, t.Label, t.Encoding, t.NumberOfBytes, strings.Join(members, ";"))
+	}
+	sort.Strings(entries)
+	return entries, nil
+}
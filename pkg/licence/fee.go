@@ -0,0 +1,20 @@
+// Package licence mirrors the fee the Licence contract takes when a card is loaded,
+// so it can be previewed without calling the contract.
+package licence
+
+import (
+	"math/big"
+)
+
+// MaxAmountScale is the scaled licence amount of a 100% fee, the scale is 10 for 1%.
+const MaxAmountScale = 1000
+
+// Fee returns the part of a load of amount base units (fee included) the Licence contract sends to the token holder,
+// given its licenceAmountScaled, e.g. 10 for 1%. The rest is loaded, as computed by the contract's load:
+// amount * 1000 / (licenceAmountScaled + 1000), rounded down. Loads of TKN pay no fee.
+func Fee(amount, licenceAmountScaled *big.Int) *big.Int {
+	scale := big.NewInt(MaxAmountScale)
+	load := new(big.Int).Mul(amount, scale)
+	load.Quo(load, scale.Add(scale, licenceAmountScaled))
+	return load.Sub(amount, load)
+}
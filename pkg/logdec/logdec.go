@@ -0,0 +1,38 @@
+// Package logdec decodes contract event logs into generic maps for indexing.
+package logdec
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// ToMap decodes both the indexed and non-indexed parameters of a log emitted by a contract with the given ABI.
+// It returns the event name and the values keyed by parameter name.
+// Anonymous events can't be identified by their first topic and are therefore not supported.
+func ToMap(abiJSON string, log types.Log) (string, map[string]interface{}, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return "", nil, errors.Wrap(err, "parsing ABI")
+	}
+	if len(log.Topics) == 0 {
+		return "", nil, errors.New("log has no topics, anonymous events can't be decoded")
+	}
+	event, err := parsed.EventByID(log.Topics[0])
+	if err != nil {
+		return "", nil, errors.Errorf("unknown event topic %s", log.Topics[0].Hex())
+	}
+	if event.Anonymous {
+		return "", nil, errors.Errorf("event %s is anonymous and can't be decoded", event.Name)
+	}
+	fields := map[string]interface{}{}
+	contract := bind.NewBoundContract(log.Address, parsed, nil, nil, nil)
+	err = contract.UnpackLogIntoMap(fields, event.Name, log)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "decoding %s", event.Name)
+	}
+	return event.Name, fields, nil
+}
@@ -0,0 +1,47 @@
+// Package metatx computes the EIP-712 values the wallet owner signs for signature based features.
+package metatx
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+)
+
+// WalletName is the name of the wallet's signing domain, its version is the wallet's WALLET_VERSION.
+const WalletName = "Monolith"
+
+var domainTypeHash = crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+
+// DomainSeparator returns the EIP-712 domain separator of the wallet at the given address, as built by its
+// DOMAIN_SEPARATOR view. It doesn't need access to a node.
+func DomainSeparator(name, version string, chainID *big.Int, wallet common.Address) common.Hash {
+	return crypto.Keccak256Hash(
+		domainTypeHash,
+		crypto.Keccak256([]byte(name)),
+		crypto.Keccak256([]byte(version)),
+		math.PaddedBigBytes(chainID, 32),
+		common.LeftPadBytes(wallet.Bytes(), 32),
+	)
+}
+
+// VerifyDomainSeparator checks that the separator computed for the wallet matches the one of the contract,
+// e.g. to make sure that the name, version and chain ID signed with are right.
+func VerifyDomainSeparator(opts *bind.CallOpts, caller bind.ContractCaller, name, version string, chainID *big.Int, wallet common.Address) error {
+	w, err := bindings.NewWalletCaller(wallet, caller)
+	if err != nil {
+		return errors.Wrap(err, "binding the wallet")
+	}
+	onChain, err := w.DOMAINSEPARATOR(opts)
+	if err != nil {
+		return errors.Wrap(err, "getting the domain separator")
+	}
+	if computed := DomainSeparator(name, version, chainID, wallet); computed != onChain {
+		return errors.Errorf("domain separator mismatch: computed %s, wallet has %s", computed.Hex(), common.Hash(onChain).Hex())
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Package nonce hands out transaction nonces locally and repairs the gaps left by dropped transactions.
+package nonce
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// fillGasLimit is the gas used by a plain ETH transfer.
+const fillGasLimit = 21000
+
+// Backend is the chain access required to manage nonces.
+type Backend interface {
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	SendTransaction(ctx context.Context, tx *types.Transaction) error
+}
+
+// Manager keeps track of the nonces issued for each account so that several transactions can be sent
+// without waiting for the node to see the previous ones. It's safe for concurrent use.
+type Manager struct {
+	mu   sync.Mutex
+	next map[common.Address]uint64
+}
+
+// NewManager returns a Manager that hasn't issued any nonce yet.
+func NewManager() *Manager {
+	return &Manager{next: make(map[common.Address]uint64)}
+}
+
+// Next issues the nonce of the next transaction of addr.
+func (m *Manager) Next(ctx context.Context, backend Backend, addr common.Address) (uint64, error) {
+	pending, err := backend.PendingNonceAt(ctx, addr)
+	if err != nil {
+		return 0, errors.Wrapf(err, "getting pending nonce of %s", addr.Hex())
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := m.next[addr]
+	if pending > n {
+		n = pending
+	}
+	m.next[addr] = n + 1
+	return n, nil
+}
+
+// DetectGap reports whether a nonce issued for addr is stuck, i.e. its transaction was dropped from the mempool
+// and every later transaction is queued behind it. The node's pending nonce only counts the transactions
+// following the confirmed nonce without interruption, so the first nonce it's missing from those issued is the gap.
+func (m *Manager) DetectGap(ctx context.Context, backend Backend, addr common.Address) (hasGap bool, gapNonce uint64, err error) {
+	confirmed, err := backend.NonceAt(ctx, addr, nil)
+	if err != nil {
+		return false, 0, errors.Wrapf(err, "getting confirmed nonce of %s", addr.Hex())
+	}
+	pending, err := backend.PendingNonceAt(ctx, addr)
+	if err != nil {
+		return false, 0, errors.Wrapf(err, "getting pending nonce of %s", addr.Hex())
+	}
+	if pending < confirmed {
+		pending = confirmed
+	}
+	m.mu.Lock()
+	issued := m.next[addr]
+	m.mu.Unlock()
+	if issued <= pending {
+		return false, 0, nil
+	}
+	return true, pending, nil
+}
+
+// FillGap unblocks the transactions queued behind gapNonce by sending a transfer of 0 ETH from opts.From to itself
+// at that nonce. The opts.GasPrice is used if set, otherwise the backend's suggested gas price.
+func (m *Manager) FillGap(ctx context.Context, backend Backend, opts *bind.TransactOpts, gapNonce uint64) (*types.Transaction, error) {
+	gasPrice := opts.GasPrice
+	if gasPrice == nil {
+		var err error
+		gasPrice, err = backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "suggesting gas price")
+		}
+	}
+	if opts.Signer == nil {
+		return nil, errors.New("no signer to authorize the transaction with")
+	}
+	tx, err := opts.Signer(types.HomesteadSigner{}, opts.From, types.NewTransaction(gapNonce, opts.From, new(big.Int), fillGasLimit, gasPrice, nil))
+	if err != nil {
+		return nil, errors.Wrap(err, "signing transaction")
+	}
+	if err := backend.SendTransaction(ctx, tx); err != nil {
+		return nil, errors.Wrapf(err, "sending transaction with nonce %d", gapNonce)
+	}
+	return tx, nil
+}
@@ -0,0 +1,85 @@
+// Package notify watches the chain for the wallet changes users are notified of.
+package notify
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/subscribe"
+)
+
+// LimitEvent is the name of the event emitted when a new daily spend limit is in effect, i.e. when the owner
+// sets the initial limit or the controller confirms an update.
+const LimitEvent = "SetSpendLimit"
+
+// ResubscribeDelay is how long WatchLimitChanges waits before subscribing again after the subscription failed.
+var ResubscribeDelay = time.Second
+
+// Client is the chain access required by WatchLimitChanges.
+type Client interface {
+	bind.ContractCaller
+	ethereum.LogFilterer
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// LimitChange is a change of the daily spend limit of one of the watched wallets, in wei.
+type LimitChange struct {
+	Wallet      common.Address
+	Old         *big.Int
+	New         *big.Int
+	BlockNumber uint64
+	TxHash      common.Hash
+}
+
+// WatchLimitChanges calls handler with every LimitEvent of walletABI emitted by one of wallets from the next
+// block on, in order. The old limits are read from the wallets when the watch starts and then follow the events.
+// Whenever the subscription fails it subscribes again after ResubscribeDelay, and the changes missed in the
+// meantime are handled first. It blocks until ctx is done, or until the chain can't be read.
+func WatchLimitChanges(ctx context.Context, client Client, walletABI abi.ABI, wallets []common.Address, handler func(LimitChange)) error {
+	event, ok := walletABI.Events[LimitEvent]
+	if !ok {
+		return errors.Errorf("ABI has no %s event", LimitEvent)
+	}
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "getting chain head")
+	}
+	limits := make(map[common.Address]*big.Int, len(wallets))
+	for _, w := range wallets {
+		var limit *big.Int
+		if err := bind.NewBoundContract(w, walletABI, client, nil, nil).Call(&bind.CallOpts{Context: ctx}, &limit, "spendLimitValue"); err != nil {
+			return errors.Wrapf(err, "getting spend limit of %s", w.Hex())
+		}
+		limits[w] = limit
+	}
+	query := ethereum.FilterQuery{
+		Addresses: wallets,
+		Topics:    [][]common.Hash{{event.ID()}},
+	}
+
+	return subscribe.Follow(ctx, client, query, head.Number.Uint64(), ResubscribeDelay, "limit changes", func(l types.Log) error {
+		var e struct {
+			Sender common.Address
+			Amount *big.Int
+		}
+		if err := walletABI.Unpack(&e, LimitEvent, l.Data); err != nil {
+			return errors.Wrap(err, "unpacking limit change")
+		}
+		handler(LimitChange{
+			Wallet:      l.Address,
+			Old:         limits[l.Address],
+			New:         e.Amount,
+			BlockNumber: l.BlockNumber,
+			TxHash:      l.TxHash,
+		})
+		limits[l.Address] = e.Amount
+		return nil
+	})
+}
@@ -0,0 +1,53 @@
+package oracle
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// ErrNoRate is returned when converting from or to a token whose rate hasn't been set.
+var ErrNoRate = errors.New("rate is not set")
+
+// Convert converts amount base units of the from token into base units of the to token, 0x0 standing for ETH.
+// Like the wallet's convertToStablecoin, the amount is first converted to wei and then to the target token,
+// truncating at each step, so the result matches what the contracts compute.
+func Convert(ctx context.Context, caller RateCaller, from, to common.Address, amount *big.Int) (*big.Int, error) {
+	wei := new(big.Int).Set(amount)
+	if from != (common.Address{}) {
+		magnitude, rate, err := rateOf(ctx, caller, from)
+		if err != nil {
+			return nil, err
+		}
+		wei.Mul(wei, rate).Quo(wei, magnitude)
+	}
+	if to == (common.Address{}) {
+		return wei, nil
+	}
+	magnitude, rate, err := rateOf(ctx, caller, to)
+	if err != nil {
+		return nil, err
+	}
+	return wei.Mul(wei, magnitude).Quo(wei, rate), nil
+}
+
+// rateOf returns the magnitude (10^decimals) and the rate in wei of one whole token.
+func rateOf(ctx context.Context, caller RateCaller, token common.Address) (*big.Int, *big.Int, error) {
+	_, magnitude, rate, available, _, _, _, err := caller.GetTokenInfo(&bind.CallOpts{Context: ctx}, token)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "getting token info")
+	}
+	if !available {
+		return nil, nil, errors.Errorf("token %s is not available", token.Hex())
+	}
+	if magnitude.Sign() == 0 {
+		return nil, nil, errors.Errorf("token %s has no magnitude", token.Hex())
+	}
+	if rate.Sign() == 0 {
+		return nil, nil, errors.Wrapf(ErrNoRate, "token %s", token.Hex())
+	}
+	return magnitude, rate, nil
+}
@@ -0,0 +1,101 @@
+package oracle
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/amount"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+)
+
+// RateRow is the validation result of a row of a rate CSV.
+type RateRow struct {
+	// Line is the row's line number in the file, starting at 1.
+	Line  int
+	Token common.Address
+	// Raw is the rate as written in the file.
+	Raw string
+	// Canonical is the rate the oracle would store, in ETH per whole token without an exponent or trailing zeros,
+	// e.g. 0.0015 for 1.5e-3. It's empty if the row is invalid.
+	Canonical string
+	Err       error
+}
+
+// Report lists the validated rows of a rate CSV in the order of the file.
+type Report struct {
+	Rows []RateRow
+}
+
+// Valid returns true if none of the rows has an error.
+func (r Report) Valid() bool {
+	for _, row := range r.Rows {
+		if row.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateRateCSV validates a CSV of token rates before they are uploaded with UpdateRates.
+// Each row holds a token address and its rate in ETH per whole token (e.g. 0x...,0.00125). Blank lines and
+// a leading token,rate header are skipped. Invalid rows are reported rather than returned as an error,
+// which is only returned if r can't be read.
+func ValidateRateCSV(r io.Reader) (Report, error) {
+	var report Report
+	seen := map[common.Address]int{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		fields, err := csv.NewReader(strings.NewReader(text)).Read()
+		if err != nil {
+			report.Rows = append(report.Rows, RateRow{Line: line, Raw: text, Err: errors.Wrap(err, "parsing row")})
+			continue
+		}
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if len(report.Rows) == 0 && len(fields) == 2 && strings.EqualFold(fields[0], "token") && strings.EqualFold(fields[1], "rate") {
+			continue
+		}
+		report.Rows = append(report.Rows, validateRateRow(line, fields, seen))
+	}
+	if err := scanner.Err(); err != nil {
+		return Report{}, errors.Wrap(err, "reading rates")
+	}
+	return report, nil
+}
+
+// validateRateRow validates the fields of a row, seen maps the tokens of the previous rows to their line.
+func validateRateRow(line int, fields []string, seen map[common.Address]int) RateRow {
+	row := RateRow{Line: line}
+	if len(fields) != 2 {
+		row.Raw = strings.Join(fields, ",")
+		row.Err = errors.Errorf("expected a token and a rate, got %d fields", len(fields))
+		return row
+	}
+	row.Raw = fields[1]
+	if !common.IsHexAddress(fields[0]) {
+		row.Err = errors.Errorf("invalid token address %q", fields[0])
+		return row
+	}
+	row.Token = common.HexToAddress(fields[0])
+	if first, ok := seen[row.Token]; ok {
+		row.Err = errors.Errorf("duplicate token, its rate is already set on line %d", first)
+		return row
+	}
+	seen[row.Token] = line
+	if row.Err = ValidateRates([]string{row.Raw})[0]; row.Err != nil {
+		return row
+	}
+	// The rate is valid, so Parse can't fail.
+	rate, _ := parseint.Parse(row.Raw, rateDecimals)
+	row.Canonical = amount.Scaled{Value: rate, Decimals: rateDecimals}.Human()
+	return row
+}
@@ -0,0 +1,50 @@
+// Package oracle provides helpers for consumers of the token rates maintained by the oracle.
+package oracle
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/atblock"
+)
+
+// ErrNoUpdateTime is returned for rates without a last update time, e.g. tokens whose rate was never updated.
+var ErrNoUpdateTime = errors.New("rate has no update time")
+
+// dateLayout is the layout of the dates the oracle records rate updates with e.g. 20180913153211.
+const dateLayout = "20060102150405"
+
+// RateCaller reads the token rates updated by the oracle, it's implemented by bindings.TokenWhitelistCaller.
+type RateCaller interface {
+	GetTokenInfo(opts *bind.CallOpts, _a common.Address) (string, *big.Int, *big.Int, bool, bool, bool, *big.Int, error)
+}
+
+// RateFresh checks whether the token's rate was updated at most maxAge before the latest block.
+// It returns the time of the last update alongside.
+func RateFresh(ctx context.Context, caller RateCaller, chain atblock.HeaderReader, token common.Address, maxAge time.Duration) (bool, time.Time, error) {
+	_, _, _, available, _, _, lastUpdate, err := caller.GetTokenInfo(&bind.CallOpts{Context: ctx}, token)
+	if err != nil {
+		return false, time.Time{}, errors.Wrap(err, "getting token info")
+	}
+	if !available {
+		return false, time.Time{}, errors.Errorf("token %s is not available", token.Hex())
+	}
+	if lastUpdate.Sign() == 0 {
+		return false, time.Time{}, ErrNoUpdateTime
+	}
+	updated, err := time.Parse(dateLayout, lastUpdate.String())
+	if err != nil {
+		return false, time.Time{}, errors.Wrapf(err, "parsing update time %s", lastUpdate)
+	}
+
+	header, err := chain.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, time.Time{}, errors.Wrap(err, "getting latest header")
+	}
+	now := time.Unix(int64(header.Time), 0)
+	return now.Sub(updated) <= maxAge, updated, nil
+}
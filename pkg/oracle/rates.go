@@ -0,0 +1,71 @@
+package oracle
+
+import (
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+)
+
+// rateDecimals are the decimals rates are parsed with, the oracle stores them in wei per whole token.
+const rateDecimals = 18
+
+// ErrZeroRate is returned for rates of 0, which the wallet refuses to convert with.
+var ErrZeroRate = errors.New("the rate can't be zero")
+
+// RateUpdater updates the token rates, it's implemented by bindings.TokenWhitelistTransactor.
+type RateUpdater interface {
+	UpdateTokenRate(opts *bind.TransactOpts, _token common.Address, _rate *big.Int, _updateDate *big.Int) (*types.Transaction, error)
+}
+
+// ValidateRates checks rates in ETH per whole token (e.g. 0.00125 or 1.25e-3) the way the oracle parses them.
+// It returns an error for each rate, nil for the valid ones, with messages that can be shown to a user.
+func ValidateRates(rates []string) []error {
+	validate := parseint.FieldValidator(rateDecimals)
+	errs := make([]error, len(rates))
+	for i, r := range rates {
+		if errs[i] = validate(r); errs[i] != nil {
+			continue
+		}
+		// The rate parses, so Parse can't fail.
+		if rate, _ := parseint.Parse(r, rateDecimals); rate.Sign() == 0 {
+			errs[i] = ErrZeroRate
+		}
+	}
+	return errs
+}
+
+// UpdateRates sets the rates of the tokens, given in ETH per whole token, as updated at updatedAt.
+// Nothing is sent unless all of the rates are valid, otherwise a transaction is sent per token.
+func UpdateRates(opts *bind.TransactOpts, updater RateUpdater, tokens []common.Address, rates []string, updatedAt time.Time) ([]*types.Transaction, error) {
+	if len(tokens) != len(rates) {
+		return nil, errors.Errorf("got %d rates for %d tokens", len(rates), len(tokens))
+	}
+	for i, err := range ValidateRates(rates) {
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid rate %q of token %s", rates[i], tokens[i].Hex())
+		}
+	}
+	date, err := strconv.ParseInt(updatedAt.UTC().Format(dateLayout), 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "encoding update time %s", updatedAt)
+	}
+	var txs []*types.Transaction
+	for i, token := range tokens {
+		rate, err := parseint.Parse(rates[i], rateDecimals)
+		if err != nil {
+			return txs, errors.Wrapf(err, "parsing rate %q of token %s", rates[i], token.Hex())
+		}
+		tx, err := updater.UpdateTokenRate(opts, token, rate, big.NewInt(date))
+		if err != nil {
+			return txs, errors.Wrapf(err, "updating rate of token %s", token.Hex())
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
@@ -0,0 +1,25 @@
+package parseint
+
+// trickyCorpus holds inputs that exercise the edge cases of the parser, grouped by what they test.
+var trickyCorpus = []string{
+	// Scientific notation.
+	"1e3", "1E3", "1e0", "1.5e3", "1.5E-3", "123e-2", "123.456e+12", "0.1E+1",
+	// Leading and trailing zeros.
+	"0", "0001", "007.50", "0.000", "00e5", "000123e-1",
+	// The largest values and exponents.
+	"115792089237316195423570985008687907853269984665640564039457584007913129639935",
+	"115792089237316195423570985008687907853269984665640564039457584007913129639936",
+	"1e77", "11e76", "2e77", "1e78", "1e-77", "1e-78",
+	// Signs in the wrong places.
+	"-1", "+1", "1-e3", "1+e3", "1e3-", "1e3+", "1e--3", "1e++3", "1e+-3", "1e-+3",
+	// Empty mantissas and exponents.
+	"", ".5", "e5", ".e5", "E-3", "1.", "1.e3", "1e", "1e-", "1e+",
+	// Malformed numbers.
+	"1.2.3", "1e3.5", "1e3e4", "12a", "1 ", "0x10",
+}
+
+// TrickyCorpus returns a curated list of numeric strings covering the edge cases of the ParseIntScientific
+// contract, e.g. for differential and fuzz tests. A new copy is returned on each call.
+func TrickyCorpus() []string {
+	return append([]string(nil), trickyCorpus...)
+}
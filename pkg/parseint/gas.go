@@ -0,0 +1,48 @@
+package parseint
+
+// The gas model of parseIntScientificDecimals, fitted to the gas estimated for transactions calling the
+// ParseIntScientificExporter. The costs include the calldata of each character.
+const (
+	gasBase          = 22893 // the transaction, the call and the final scaling
+	gasScaled        = 106   // scaling by a non-zero number of decimals
+	gasIntegralDigit = 657
+	gasDecimalPoint  = 489
+	gasDecimalDigit  = 673
+	gasExponent      = 1161 // the 'e' or 'E' symbol
+	gasExponentDigit = 812
+	gasMinus         = 274 // a negative exponent returns early, skipping part of the scaling
+	gasPlus          = 742
+)
+
+// EstimateGas approximates the gas used by a transaction parsing the input with the given decimals through
+// the ParseIntScientificExporter, without calling a node. It models the cost of each character of the parsing
+// loop and is within 2% of the estimate of a node for valid inputs of up to 32 characters; longer inputs
+// and inputs the contract rejects aren't modelled.
+func EstimateGas(input string, decimals uint) uint64 {
+	gas := uint64(gasBase)
+	if decimals != 0 {
+		gas += gasScaled
+	}
+	var dec, exp bool
+	for i := 0; i < len(input); i++ {
+		switch c := input[i]; {
+		case c >= '0' && c <= '9' && exp:
+			gas += gasExponentDigit
+		case c >= '0' && c <= '9' && dec:
+			gas += gasDecimalDigit
+		case c >= '0' && c <= '9':
+			gas += gasIntegralDigit
+		case c == '.':
+			dec = true
+			gas += gasDecimalPoint
+		case c == 'e' || c == 'E':
+			exp = true
+			gas += gasExponent
+		case c == '-':
+			gas += gasMinus
+		case c == '+':
+			gas += gasPlus
+		}
+	}
+	return gas
+}
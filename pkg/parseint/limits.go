@@ -0,0 +1,5 @@
+package parseint
+
+// MaxScientificExponent is the largest exponent and number of decimal digits the contract parses,
+// as returned by the maxExponent() function of the ParseIntScientificExporter.
+const MaxScientificExponent = 77
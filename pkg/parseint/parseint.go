@@ -0,0 +1,186 @@
+// Package parseint mirrors the ParseIntScientific contract so amounts can be parsed off-chain
+// exactly as the oracle parses them on-chain.
+package parseint
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// The reasons parsing fails for, matching the revert reasons of the contract.
+// ErrMissingExponent and ErrOverflow stand for the contract's bare require and SafeMath reverts.
+var (
+	ErrMissingIntegral       = errors.New("missing integral part")
+	ErrDuplicateDecimalPoint = errors.New("duplicate decimal point")
+	ErrDecimalAfterExponent  = errors.New("decimal after exponent")
+	ErrDuplicateMinus        = errors.New("duplicate -")
+	ErrExtraSign             = errors.New("extra sign")
+	ErrMisplacedMinus        = errors.New("- sign not immediately after e")
+	ErrDuplicatePlus         = errors.New("duplicate +")
+	ErrMisplacedPlus         = errors.New("+ sign not immediately after e")
+	ErrDuplicateExponent     = errors.New("duplicate exponent symbol")
+	ErrInvalidDigit          = errors.New("invalid digit")
+	ErrMissingExponent       = errors.New("missing exponent")
+	ErrExponentTooLarge      = errors.New("exponent > 77")
+	ErrTooManyDecimals       = errors.New("more than 77 decimal digits parsed")
+	ErrOverflow              = errors.New("overflow")
+)
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// Parse parses a JSON standard floating point number (e.g. 1.5e-3) and multiplies it by 10^decimals.
+// Any precision beyond the requested decimals is truncated. It fails with the same reasons as the contract.
+func Parse(in string, decimals uint) (*big.Int, error) {
+	var (
+		mint      = new(big.Int) // the integral part
+		mintDec   = new(big.Int) // the digits following the decimal point
+		mintExp   = new(big.Int) // the exponent
+		decMinted uint64         // how many decimals were parsed
+		expIndex  int            // the position of 'e' (if found)
+		integral  bool
+		dec       bool
+		exp       bool
+		minus     bool
+		plus      bool
+	)
+	ten := big.NewInt(10)
+	for i := 0; i < len(in); i++ {
+		c := in[i]
+		switch {
+		case c >= '0' && c <= '9' && !exp:
+			if dec {
+				mintDec.Mul(mintDec, ten).Add(mintDec, big.NewInt(int64(c-'0')))
+				decMinted++
+			} else {
+				integral = true
+				mint.Mul(mint, ten).Add(mint, big.NewInt(int64(c-'0')))
+			}
+		case c >= '0' && c <= '9' && exp:
+			mintExp.Mul(mintExp, ten).Add(mintExp, big.NewInt(int64(c-'0')))
+		case c == '.':
+			if !integral {
+				return nil, ErrMissingIntegral
+			}
+			if dec {
+				return nil, ErrDuplicateDecimalPoint
+			}
+			if exp {
+				return nil, ErrDecimalAfterExponent
+			}
+			dec = true
+		case c == '-':
+			if minus {
+				return nil, ErrDuplicateMinus
+			}
+			if plus {
+				return nil, ErrExtraSign
+			}
+			if expIndex+1 != i {
+				return nil, ErrMisplacedMinus
+			}
+			minus = true
+		case c == '+':
+			if plus {
+				return nil, ErrDuplicatePlus
+			}
+			if minus {
+				return nil, ErrExtraSign
+			}
+			if expIndex+1 != i {
+				return nil, ErrMisplacedPlus
+			}
+			plus = true
+		case c == 'e' || c == 'E':
+			if !integral {
+				return nil, ErrMissingIntegral
+			}
+			if exp {
+				return nil, ErrDuplicateExponent
+			}
+			exp = true
+			expIndex = i
+		default:
+			return nil, ErrInvalidDigit
+		}
+		if mint.Cmp(maxUint256) > 0 || mintDec.Cmp(maxUint256) > 0 || mintExp.Cmp(maxUint256) > 0 {
+			return nil, ErrOverflow
+		}
+	}
+
+	if ((minus || plus) && len(in) <= expIndex+2) || (exp && len(in) <= expIndex+1) {
+		return nil, ErrMissingExponent
+	}
+
+	magnitude := new(big.Int).SetUint64(uint64(decimals))
+	decMintedBig := new(big.Int).SetUint64(decMinted)
+	if minus {
+		if mintExp.Cmp(magnitude) >= 0 {
+			shift := new(big.Int).Sub(mintExp, magnitude)
+			if shift.Cmp(big.NewInt(MaxScientificExponent)) > 0 {
+				return nil, ErrExponentTooLarge
+			}
+			return mint.Quo(mint, new(big.Int).Exp(ten, shift, nil)), nil
+		}
+		magnitude.Sub(magnitude, mintExp)
+	} else {
+		if magnitude.Add(magnitude, mintExp).Cmp(maxUint256) > 0 {
+			return nil, ErrOverflow
+		}
+	}
+
+	if magnitude.Cmp(decMintedBig) >= 0 {
+		if decMinted > MaxScientificExponent {
+			return nil, ErrTooManyDecimals
+		}
+		// The contract checks for overflows at each step, before checking the shift.
+		if mint.Mul(mint, new(big.Int).Exp(ten, decMintedBig, nil)).Cmp(maxUint256) > 0 {
+			return nil, ErrOverflow
+		}
+		if mint.Add(mint, mintDec).Cmp(maxUint256) > 0 {
+			return nil, ErrOverflow
+		}
+		shift := new(big.Int).Sub(magnitude, decMintedBig)
+		if shift.Cmp(big.NewInt(MaxScientificExponent)) > 0 {
+			return nil, ErrExponentTooLarge
+		}
+		mint.Mul(mint, new(big.Int).Exp(ten, shift, nil))
+	} else {
+		discard := new(big.Int).Sub(decMintedBig, magnitude)
+		if discard.Cmp(big.NewInt(MaxScientificExponent)) > 0 || magnitude.Cmp(big.NewInt(MaxScientificExponent)) > 0 {
+			return nil, ErrTooManyDecimals
+		}
+		mintDec.Quo(mintDec, new(big.Int).Exp(ten, discard, nil))
+		mint.Mul(mint, new(big.Int).Exp(ten, magnitude, nil)).Add(mint, mintDec)
+	}
+	if mint.Cmp(maxUint256) > 0 {
+		return nil, ErrOverflow
+	}
+	return mint, nil
+}
+
+// SortStrings parses the inputs with the given decimals and returns them sorted by their numeric value.
+// Inputs with equal values keep their original order.
+func SortStrings(inputs []string, decimals uint) ([]string, error) {
+	values := make([]*big.Int, len(inputs))
+	for i, in := range inputs {
+		v, err := Parse(in, decimals)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing %q", in)
+		}
+		values[i] = v
+	}
+	order := make([]int, len(inputs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return values[order[a]].Cmp(values[order[b]]) < 0
+	})
+	sorted := make([]string, len(inputs))
+	for i, idx := range order {
+		sorted[i] = inputs[idx]
+	}
+	return sorted, nil
+}
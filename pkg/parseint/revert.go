@@ -0,0 +1,44 @@
+package parseint
+
+import (
+	"github.com/tokencard/contracts/v3/pkg/reverts"
+)
+
+// revertErrors maps the revert reasons of the contract to the errors Parse fails with.
+var revertErrors = map[string]error{
+	"SafeMath: addition overflow":       ErrOverflow,
+	"SafeMath: multiplication overflow": ErrOverflow,
+}
+
+func init() {
+	for _, err := range []error{
+		ErrMissingIntegral,
+		ErrDuplicateDecimalPoint,
+		ErrDecimalAfterExponent,
+		ErrDuplicateMinus,
+		ErrExtraSign,
+		ErrMisplacedMinus,
+		ErrDuplicatePlus,
+		ErrMisplacedPlus,
+		ErrDuplicateExponent,
+		ErrInvalidDigit,
+		ErrExponentTooLarge,
+		ErrTooManyDecimals,
+	} {
+		revertErrors[err.Error()] = err
+	}
+}
+
+// DecodeRevert returns the error Parse fails with for the data a call parsing a number reverted with,
+// e.g. as returned by CallContract, or nil if the contract doesn't revert with such data when parsing.
+// Parsing only reverts without data when the exponent is missing, successful calls always return data.
+func DecodeRevert(data []byte) error {
+	if len(data) == 0 {
+		return ErrMissingExponent
+	}
+	r := reverts.Decode(data)
+	if r.Kind != reverts.Reason {
+		return nil
+	}
+	return revertErrors[r.Reason]
+}
@@ -0,0 +1,44 @@
+package parseint
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ErrEmpty is returned by field validators for empty input, which the contract parses as 0.
+var ErrEmpty = errors.New("please enter an amount")
+
+// friendly maps the parse errors to messages that can be shown to a user.
+var friendly = map[error]string{
+	ErrMissingIntegral:       "the amount must start with a digit",
+	ErrDuplicateDecimalPoint: "only one decimal point is allowed",
+	ErrDecimalAfterExponent:  "the exponent must be a whole number",
+	ErrDuplicateMinus:        "the exponent can only have one sign",
+	ErrDuplicatePlus:         "the exponent can only have one sign",
+	ErrExtraSign:             "the exponent can only have one sign",
+	ErrMisplacedMinus:        "a sign is only allowed right after the exponent symbol",
+	ErrMisplacedPlus:         "a sign is only allowed right after the exponent symbol",
+	ErrDuplicateExponent:     "only one exponent is allowed",
+	ErrInvalidDigit:          "only digits, a decimal point and an exponent (e.g. 1.5e3) are allowed",
+	ErrMissingExponent:       "the exponent is missing after e",
+	ErrExponentTooLarge:      "the exponent is too large",
+	ErrTooManyDecimals:       "too many decimal places",
+	ErrOverflow:              "the amount is too large",
+}
+
+// FieldValidator returns a validator for form inputs that are parsed with the given decimals.
+// The returned errors are readable messages rather than the contract's revert reasons.
+func FieldValidator(decimals uint) func(string) error {
+	return func(in string) error {
+		if in == "" {
+			return ErrEmpty
+		}
+		_, err := Parse(in, decimals)
+		if err == nil {
+			return nil
+		}
+		if msg, ok := friendly[err]; ok {
+			return errors.New(msg)
+		}
+		return err
+	}
+}
@@ -0,0 +1,37 @@
+// Package proxyscan finds upgradeable proxies, whose behaviour can change without their address changing.
+package proxyscan
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// ImplementationSlot is the EIP-1967 storage slot holding the implementation of a proxy,
+// i.e. keccak256("eip1967.proxy.implementation") - 1. It is used by the wallet's UpgradeabilityProxy.
+var ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+// StorageReader reads contract storage, it is implemented by ethclient.Client.
+type StorageReader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// Detect reads the EIP-1967 implementation slot of the given addresses and returns the implementation of those
+// that are proxies. Addresses with an empty slot, including accounts without code, are omitted.
+func Detect(ctx context.Context, client StorageReader, addrs []common.Address) (map[common.Address]common.Address, error) {
+	proxies := make(map[common.Address]common.Address)
+	for _, a := range addrs {
+		value, err := client.StorageAt(ctx, a, ImplementationSlot, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading implementation slot of %s", a.Hex())
+		}
+		implementation := common.BytesToAddress(value)
+		if implementation == (common.Address{}) {
+			continue
+		}
+		proxies[a] = implementation
+	}
+	return proxies, nil
+}
@@ -0,0 +1,55 @@
+// Package quote values wallet amounts in display currencies.
+package quote
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+)
+
+// USDDecimals are the decimals of the USD amounts and rates, e.g. 150000000 is $1.50.
+const USDDecimals = 8
+
+var (
+	usdMagnitude   = new(big.Int).Exp(big.NewInt(10), big.NewInt(USDDecimals), nil)
+	etherMagnitude = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+)
+
+// SpendLimitCaller reads the spend limit of a wallet, it's implemented by bindings.WalletCaller.
+type SpendLimitCaller interface {
+	SpendLimitAvailable(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// StablecoinCaller reads the stablecoin rate maintained by the oracle, it's implemented by bindings.TokenWhitelistCaller.
+type StablecoinCaller interface {
+	GetStablecoinInfo(opts *bind.CallOpts) (string, *big.Int, *big.Int, bool, bool, bool, *big.Int, error)
+}
+
+// AvailableInUSD returns how much the wallet can still spend today in USD, with USDDecimals decimals.
+// The spend limit is denominated in ETH and converted with usdPerEthE8, the USD price of one ETH with USDDecimals
+// decimals. If it's nil, the oracle's stablecoin rate is used instead, taking one stablecoin for one USD.
+// The result is truncated, so it never exceeds what can be spent.
+func AvailableInUSD(ctx context.Context, wallet SpendLimitCaller, oracle StablecoinCaller, usdPerEthE8 *big.Int) (*big.Int, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	spend, err := wallet.SpendLimitAvailable(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting available spend limit")
+	}
+	if usdPerEthE8 != nil {
+		return spend.Mul(spend, usdPerEthE8).Quo(spend, etherMagnitude), nil
+	}
+	_, _, rate, available, _, _, _, err := oracle.GetStablecoinInfo(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting stablecoin rate")
+	}
+	if !available {
+		return nil, errors.New("stablecoin is not available")
+	}
+	if rate.Sign() == 0 {
+		return nil, errors.New("stablecoin rate is not set")
+	}
+	// The rate is the value in wei of one whole stablecoin.
+	return spend.Mul(spend, usdMagnitude).Quo(spend, rate), nil
+}
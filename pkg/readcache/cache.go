@@ -0,0 +1,107 @@
+// Package readcache reduces the RPCs made for contract reads under load.
+package readcache
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/sync/singleflight"
+)
+
+// Cache is a read-through cache for contract reads, usable as the caller of any binding.
+// Identical reads made concurrently are collapsed into a single call to the backend whose result
+// they all share, and successful results are then served from memory for the cache's TTL.
+// Errors are never cached. The returned bytes are shared and mustn't be modified.
+type Cache struct {
+	caller bind.ContractCaller
+	ttl    time.Duration
+	group  singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]entry
+	pruned  time.Time
+}
+
+type entry struct {
+	value   []byte
+	expires time.Time
+}
+
+var _ bind.ContractCaller = (*Cache)(nil)
+
+// New returns a Cache reading through caller, keeping results for ttl.
+// With a ttl of 0 nothing is cached and only concurrent reads are collapsed.
+func New(caller bind.ContractCaller, ttl time.Duration) *Cache {
+	return &Cache{
+		caller:  caller,
+		ttl:     ttl,
+		entries: make(map[string]entry),
+	}
+}
+
+// CodeAt returns the code of the given account.
+func (c *Cache) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	key := fmt.Sprintf("code:%x:%s", contract, blockNumber)
+	return c.read(key, func() ([]byte, error) {
+		return c.caller.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+// CallContract executes an Ethereum contract call with the specified data as the input.
+func (c *Cache) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var to common.Address
+	if call.To != nil {
+		to = *call.To
+	}
+	key := fmt.Sprintf("call:%x:%x:%d:%s:%s:%x:%s", call.From, to, call.Gas, call.GasPrice, call.Value, call.Data, blockNumber)
+	return c.read(key, func() ([]byte, error) {
+		return c.caller.CallContract(ctx, call, blockNumber)
+	})
+}
+
+// read serves the read identified by key from memory or, collapsing concurrent identical reads, from fetch.
+// The concurrent reads share the context of the one that made the call.
+func (c *Cache) read(key string, fetch func() ([]byte, error)) ([]byte, error) {
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && time.Now().Before(e.expires) {
+		return e.value, nil
+	}
+	v, err, _ := c.group.Do(key, func() (interface{}, error) {
+		value, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		if c.ttl > 0 {
+			c.store(key, value)
+		}
+		return value, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]byte), nil
+}
+
+// store caches the value of the read identified by key, dropping the expired entries once per TTL.
+func (c *Cache) store(key string, value []byte) {
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now.Sub(c.pruned) >= c.ttl {
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.pruned = now
+	}
+	c.entries[key] = entry{value: value, expires: now.Add(c.ttl)}
+}
@@ -0,0 +1,170 @@
+// Package reconcile compares the spend recorded on-chain by a wallet with external records of it.
+package reconcile
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/oracle"
+)
+
+// Filterer is the wallet and chain access required by Spend: the Transferred events of the wallet, e.g. from
+// a wallet.Client, and the headers needed to find the blocks within the window.
+type Filterer interface {
+	FilterTransferred(opts *bind.FilterOpts) (*bindings.WalletTransferredIterator, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// LedgerEntry is a transfer of the wallet as recorded by the ledger.
+type LedgerEntry struct {
+	TxHash common.Hash
+	// Asset is the address of the ERC20 token or 0x0 for ETH.
+	Asset common.Address
+	// Amount is in base units of the asset.
+	Amount *big.Int
+}
+
+// Discrepancy is a transaction whose transfers of an asset differ between the ledger and the chain.
+type Discrepancy struct {
+	TxHash common.Hash
+	Asset  common.Address
+	// Recorded is the amount of the ledger entries, 0 for transfers missing from the ledger.
+	Recorded *big.Int
+	// Transferred is the amount transferred within the window, 0 for entries without a transfer.
+	Transferred *big.Int
+}
+
+// Report is the outcome of reconciling the spend of a wallet.
+type Report struct {
+	// FromBlock and ToBlock are the blocks of the window (both inclusive).
+	FromBlock uint64
+	ToBlock   uint64
+	// OnChain and Ledger are the values in wei of the transfers and of the ledger entries, at the current rates.
+	OnChain *big.Int
+	Ledger  *big.Int
+	// Discrepancies lists the mismatching ledger entries in the ledger's order, followed by
+	// the transfers missing from the ledger in the order they were executed.
+	Discrepancies []Discrepancy
+}
+
+// Reconciled is true if the ledger matches the transfers made on-chain.
+func (r Report) Reconciled() bool {
+	return len(r.Discrepancies) == 0
+}
+
+// key identifies the transfers of an asset made by a transaction, e.g. by bulkTransfer.
+type key struct {
+	txHash common.Hash
+	asset  common.Address
+}
+
+// Spend compares the ledger with the transfers the wallet made within window before the latest block.
+// The amounts of each asset are summed per transaction, so an entry can stand for several transfers of a
+// transaction and the other way round. The values in wei are converted at the rates of oracleCaller,
+// it fails if an asset has no rate.
+func Spend(ctx context.Context, filterer Filterer, oracleCaller oracle.RateCaller, ledger []LedgerEntry, window time.Duration) (Report, error) {
+	head, err := filterer.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return Report{}, errors.Wrap(err, "getting chain head")
+	}
+	from, err := firstBlockSince(ctx, filterer, head, window)
+	if err != nil {
+		return Report{}, err
+	}
+	report := Report{
+		FromBlock: from,
+		ToBlock:   head.Number.Uint64(),
+		OnChain:   new(big.Int),
+		Ledger:    new(big.Int),
+	}
+
+	recorded := make(map[key]*big.Int)
+	var order []key
+	for i, e := range ledger {
+		if e.Amount == nil {
+			return Report{}, errors.Errorf("ledger entry %d has no amount", i)
+		}
+		k := key{txHash: e.TxHash, asset: e.Asset}
+		if recorded[k] == nil {
+			recorded[k] = new(big.Int)
+			order = append(order, k)
+		}
+		recorded[k].Add(recorded[k], e.Amount)
+		if err := addValue(ctx, oracleCaller, report.Ledger, e.Asset, e.Amount); err != nil {
+			return Report{}, err
+		}
+	}
+
+	transferred := make(map[key]*big.Int)
+	var unrecorded []key
+	it, err := filterer.FilterTransferred(&bind.FilterOpts{Start: report.FromBlock, End: &report.ToBlock, Context: ctx})
+	if err != nil {
+		return Report{}, errors.Wrap(err, "filtering Transferred events")
+	}
+	for it.Next() {
+		e := it.Event
+		k := key{txHash: e.Raw.TxHash, asset: e.Asset}
+		if transferred[k] == nil {
+			transferred[k] = new(big.Int)
+			if recorded[k] == nil {
+				unrecorded = append(unrecorded, k)
+			}
+		}
+		transferred[k].Add(transferred[k], e.Amount)
+		if err := addValue(ctx, oracleCaller, report.OnChain, e.Asset, e.Amount); err != nil {
+			return Report{}, err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return Report{}, errors.Wrap(err, "decoding Transferred events")
+	}
+
+	for _, k := range append(order, unrecorded...) {
+		r, t := recorded[k], transferred[k]
+		if r == nil {
+			r = new(big.Int)
+		}
+		if t == nil {
+			t = new(big.Int)
+		}
+		if r.Cmp(t) != 0 {
+			report.Discrepancies = append(report.Discrepancies, Discrepancy{TxHash: k.txHash, Asset: k.asset, Recorded: r, Transferred: t})
+		}
+	}
+	return report, nil
+}
+
+// addValue adds the value in wei of amount base units of the asset to total.
+func addValue(ctx context.Context, caller oracle.RateCaller, total *big.Int, asset common.Address, amount *big.Int) error {
+	value, err := oracle.Convert(ctx, caller, asset, common.Address{}, amount)
+	if err != nil {
+		return errors.Wrapf(err, "converting %s of %s", amount, asset.Hex())
+	}
+	total.Add(total, value)
+	return nil
+}
+
+// firstBlockSince returns the first block mined at most window before head, searching the chain by timestamp.
+func firstBlockSince(ctx context.Context, client Filterer, head *types.Header, window time.Duration) (uint64, error) {
+	since := int64(head.Time) - int64(window/time.Second)
+	var err error
+	n := sort.Search(int(head.Number.Uint64()), func(i int) bool {
+		if err != nil {
+			return true
+		}
+		var h *types.Header
+		h, err = client.HeaderByNumber(ctx, big.NewInt(int64(i)))
+		return err == nil && int64(h.Time) >= since
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "searching the first block of the window")
+	}
+	return uint64(n), nil
+}
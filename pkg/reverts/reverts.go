@@ -0,0 +1,222 @@
+// Package reverts decodes the data returned by reverted calls.
+package reverts
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// Kind is the kind of a revert.
+type Kind int
+
+// The kinds of reverts, in the order Decode tries them.
+const (
+	// Unknown is a revert without data, or with data too short to carry a selector.
+	Unknown Kind = iota
+	// Reason is an Error(string) revert, as made by require and revert with a message.
+	Reason
+	// Panic is a Panic(uint256) revert, as made by failed asserts and checked arithmetic.
+	Panic
+	// Custom is a revert with any other selector.
+	Custom
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Reason:
+		return "reason"
+	case Panic:
+		return "panic"
+	case Custom:
+		return "custom"
+	default:
+		return "unknown"
+	}
+}
+
+// Sentinels matching the Revert of the same kind with errors.Is.
+var (
+	ErrUnknown = errors.New("reverted without reason")
+	ErrReason  = errors.New("reverted with reason")
+	ErrPanic   = errors.New("reverted with panic")
+	ErrCustom  = errors.New("reverted with custom error")
+)
+
+var sentinels = map[Kind]error{
+	Unknown: ErrUnknown,
+	Reason:  ErrReason,
+	Panic:   ErrPanic,
+	Custom:  ErrCustom,
+}
+
+// panicCodes describes the codes of Panic(uint256).
+var panicCodes = map[uint64]string{
+	0x00: "generic panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow",
+	0x12: "division by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array",
+	0x31: "pop on empty array",
+	0x32: "index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero function",
+}
+
+// CustomError describes an error the contract may revert with, e.g. InsufficientBalance(uint256,uint256).
+type CustomError struct {
+	Name   string
+	Inputs abi.Arguments
+}
+
+// Selector returns the first 4 bytes of the hash of the error's signature.
+func (e CustomError) Selector() []byte {
+	types := make([]string, len(e.Inputs))
+	for i, in := range e.Inputs {
+		types[i] = in.Type.String()
+	}
+	return crypto.Keccak256([]byte(e.Name + "(" + strings.Join(types, ",") + ")"))[:4]
+}
+
+// CustomRevert is the custom error a call reverted with.
+type CustomRevert struct {
+	// Name is empty if the selector doesn't match any of the custom errors given to the Decoder.
+	Name     string
+	Selector [4]byte
+	// Args are the decoded arguments, or nil if the error is unknown.
+	Args []interface{}
+	// Data is the data following the selector.
+	Data []byte
+}
+
+// Revert is a decoded revert. Only the field matching its Kind is set.
+type Revert struct {
+	Kind      Kind
+	Reason    string
+	PanicCode *big.Int
+	Custom    *CustomRevert
+}
+
+// Error implements error, so that a Revert can be returned as is.
+func (r Revert) Error() string {
+	switch r.Kind {
+	case Reason:
+		return "execution reverted: " + r.Reason
+	case Panic:
+		desc, ok := panicCodes[r.PanicCode.Uint64()]
+		if !r.PanicCode.IsUint64() || !ok {
+			desc = "unknown panic"
+		}
+		return fmt.Sprintf("execution reverted: panic 0x%x (%s)", r.PanicCode, desc)
+	case Custom:
+		if r.Custom.Name == "" {
+			return fmt.Sprintf("execution reverted: unknown custom error 0x%x", r.Custom.Selector)
+		}
+		args := make([]string, len(r.Custom.Args))
+		for i, a := range r.Custom.Args {
+			args[i] = fmt.Sprint(a)
+		}
+		return fmt.Sprintf("execution reverted: %s(%s)", r.Custom.Name, strings.Join(args, ", "))
+	default:
+		return "execution reverted"
+	}
+}
+
+// Is reports whether target is the sentinel of the revert's Kind.
+func (r Revert) Is(target error) bool {
+	return sentinels[r.Kind] == target
+}
+
+// matcher decodes data if it recognises its selector.
+type matcher func(selector, data []byte) (Revert, bool)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	stringType, _  = abi.NewType("string", "", nil)
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+)
+
+func matchReason(selector, data []byte) (Revert, bool) {
+	if !bytes.Equal(selector, errorSelector) {
+		return Revert{}, false
+	}
+	var reason string
+	if err := (abi.Arguments{{Type: stringType}}).Unpack(&reason, data); err != nil {
+		return Revert{}, false
+	}
+	return Revert{Kind: Reason, Reason: reason}, true
+}
+
+func matchPanic(selector, data []byte) (Revert, bool) {
+	if !bytes.Equal(selector, panicSelector) {
+		return Revert{}, false
+	}
+	var code *big.Int
+	if err := (abi.Arguments{{Type: uint256Type}}).Unpack(&code, data); err != nil {
+		return Revert{}, false
+	}
+	return Revert{Kind: Panic, PanicCode: code}, true
+}
+
+func matchCustom(e CustomError) matcher {
+	sel := e.Selector()
+	return func(selector, data []byte) (Revert, bool) {
+		if !bytes.Equal(selector, sel) {
+			return Revert{}, false
+		}
+		args, err := e.Inputs.UnpackValues(data)
+		if err != nil {
+			return Revert{}, false
+		}
+		c := &CustomRevert{Name: e.Name, Args: args, Data: data}
+		copy(c.Selector[:], selector)
+		return Revert{Kind: Custom, Custom: c}, true
+	}
+}
+
+// Decoder decodes reverts, recognising a set of custom errors.
+type Decoder struct {
+	matchers []matcher
+}
+
+// NewDecoder returns a Decoder recognising the given custom errors on top of Error(string) and Panic(uint256).
+func NewDecoder(customs ...CustomError) *Decoder {
+	d := &Decoder{matchers: []matcher{matchReason, matchPanic}}
+	for _, c := range customs {
+		d.matchers = append(d.matchers, matchCustom(c))
+	}
+	return d
+}
+
+// Decode decodes the data a call reverted with. The matchers are tried in order: Error(string),
+// Panic(uint256), then the custom errors. Data with a selector none of them can decode is an unknown
+// custom error, and data without a selector is an Unknown revert.
+func (d *Decoder) Decode(data []byte) Revert {
+	if len(data) < 4 {
+		return Revert{Kind: Unknown}
+	}
+	selector, args := data[:4], data[4:]
+	for _, m := range d.matchers {
+		if r, ok := m(selector, args); ok {
+			return r
+		}
+	}
+	c := &CustomRevert{Data: args}
+	copy(c.Selector[:], selector)
+	return Revert{Kind: Custom, Custom: c}
+}
+
+var defaultDecoder = NewDecoder()
+
+// Decode decodes data with a Decoder that doesn't know any custom error.
+func Decode(data []byte) Revert {
+	return defaultDecoder.Decode(data)
+}
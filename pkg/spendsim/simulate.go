@@ -0,0 +1,157 @@
+// Package spendsim predicts the outcome of a wallet transfer off-chain by reimplementing the checks
+// the Wallet contract makes when transferring, so complex limit configurations can be evaluated without a node.
+package spendsim
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// The reasons a transfer is rejected for, matching the revert reasons of the wallet.
+const (
+	ReasonZeroValue            = "value=0"
+	ReasonConfirmationRequired = "confirmation required"
+	ReasonZeroDestination      = "destination=0"
+	ReasonLimitExceeded        = "available<amount"
+	ReasonBalanceOnHold        = "balance on hold"
+)
+
+// limitPeriod is how long (in seconds) the daily spend limit lasts before it resets.
+const limitPeriod = 24 * 60 * 60
+
+// WalletConfig is the spend configuration of a wallet.
+type WalletConfig struct {
+	// SpendLimit is the stored daily spend limit in wei.
+	SpendLimit *big.Int
+	// ConfirmThreshold is the value in wei above which transfers need a confirmation, nil or 0 disables it.
+	ConfirmThreshold *big.Int
+	// Whitelist are the destinations exempt from the spend limit, including contracts
+	// implementing a whitelisted interface.
+	Whitelist map[common.Address]bool
+	// ScheduledLimit is the spend limit scheduled by the controller.
+	ScheduledLimit *big.Int
+	// ScheduledLimitAt is when the scheduled limit takes effect, 0 if no change is scheduled.
+	ScheduledLimitAt uint64
+}
+
+// WalletState is the state of a wallet the transfer depends on.
+type WalletState struct {
+	// Now is the timestamp of the block the transfer is made in.
+	Now uint64
+	// Available is the stored available spend limit in wei.
+	Available *big.Int
+	// LimitTimestamp is when the current daily spend limit period started.
+	LimitTimestamp uint64
+	// Balance is the wallet's balance of the transferred asset.
+	Balance *big.Int
+	// Held is the part of the balance on hold, nil if nothing is held.
+	Held *big.Int
+}
+
+// TransferLeg is a single transfer made by the wallet.
+type TransferLeg struct {
+	To     common.Address
+	Asset  common.Address
+	Amount *big.Int
+	// EtherValue is the value of the amount in wei as converted by the oracle rate,
+	// it is required for tokens and ignored for ETH.
+	EtherValue *big.Int
+}
+
+// Result is the predicted outcome of a transfer.
+type Result struct {
+	// Allowed is true if the transfer succeeds.
+	Allowed bool
+	// Reason is the revert reason of a rejected transfer.
+	Reason string
+	// Available is the available spend limit in wei after an allowed transfer, nil if it is rejected.
+	Available *big.Int
+}
+
+// Simulate predicts the outcome of transferring the leg from a wallet with the given configuration and state,
+// as transfer() would, i.e. rejecting transfers above the confirmation threshold. It fails if the inputs are incomplete.
+func Simulate(config WalletConfig, state WalletState, leg TransferLeg) (Result, error) {
+	if config.SpendLimit == nil || state.Available == nil || state.Balance == nil || leg.Amount == nil {
+		return Result{}, errors.New("missing spend limit, available limit, balance or amount")
+	}
+	etherValue := leg.Amount
+	if leg.Asset != (common.Address{}) {
+		if leg.EtherValue == nil {
+			return Result{}, errors.Errorf("missing ether value of the %s token amount", leg.Asset.Hex())
+		}
+		etherValue = leg.EtherValue
+	}
+
+	if leg.Amount.Sign() == 0 {
+		return Result{Reason: ReasonZeroValue}, nil
+	}
+	if config.ConfirmThreshold != nil && config.ConfirmThreshold.Sign() != 0 && etherValue.Cmp(config.ConfirmThreshold) > 0 {
+		return Result{Reason: ReasonConfirmationRequired}, nil
+	}
+	if leg.To == (common.Address{}) {
+		return Result{Reason: ReasonZeroDestination}, nil
+	}
+
+	limit := newDailyLimit(config, state)
+	if config.ScheduledLimitAt != 0 && state.Now >= config.ScheduledLimitAt && config.ScheduledLimit != nil {
+		limit.modify(state.Now, config.ScheduledLimit)
+	}
+	if !config.Whitelist[leg.To] {
+		limit.update(state.Now)
+		if limit.available.Cmp(etherValue) < 0 {
+			return Result{Reason: ReasonLimitExceeded}, nil
+		}
+		limit.available.Sub(limit.available, etherValue)
+	}
+
+	unheld := new(big.Int).Set(state.Balance)
+	if state.Held != nil {
+		unheld.Sub(unheld, state.Held)
+	}
+	if leg.Amount.Cmp(unheld) > 0 {
+		return Result{Reason: ReasonBalanceOnHold}, nil
+	}
+	return Result{Allowed: true, Available: limit.availableAt(state.Now)}, nil
+}
+
+// dailyLimit mirrors the DailyLimit of the wallet.
+type dailyLimit struct {
+	value     *big.Int
+	available *big.Int
+	timestamp uint64
+}
+
+func newDailyLimit(config WalletConfig, state WalletState) *dailyLimit {
+	return &dailyLimit{
+		value:     new(big.Int).Set(config.SpendLimit),
+		available: new(big.Int).Set(state.Available),
+		timestamp: state.LimitTimestamp,
+	}
+}
+
+// update resets the available limit once the daily period has passed, like _updateAvailableLimit.
+func (l *dailyLimit) update(now uint64) {
+	if now > l.timestamp+limitPeriod {
+		l.timestamp = now
+		l.available.Set(l.value)
+	}
+}
+
+// modify sets a new limit, lowering the available limit if it is higher, like _modifyLimit.
+func (l *dailyLimit) modify(now uint64, value *big.Int) {
+	l.update(now)
+	l.value.Set(value)
+	if l.available.Cmp(l.value) > 0 {
+		l.available.Set(l.value)
+	}
+}
+
+// availableAt returns the available limit accounting for the daily reset, like _getAvailableLimit.
+func (l *dailyLimit) availableAt(now uint64) *big.Int {
+	if now > l.timestamp+limitPeriod {
+		return new(big.Int).Set(l.value)
+	}
+	return new(big.Int).Set(l.available)
+}
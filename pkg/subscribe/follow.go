@@ -0,0 +1,100 @@
+package subscribe
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/pkg/errors"
+)
+
+// position is the block number and log index of the last event handled.
+type position struct {
+	block uint64
+	index uint
+}
+
+func (p position) before(e Event) bool {
+	return e.BlockNumber > p.block || e.BlockNumber == p.block && e.Index > p.index
+}
+
+// Follow calls handle with every event matching query from the block after head on, in order, removed events
+// are skipped. Whenever the subscription fails it subscribes again after resubscribeDelay, and the events missed
+// in the meantime are handled first. It blocks until ctx is done, until the chain can't be read or until handle
+// fails. The events are named by what, e.g. "freezes", in the logs and errors.
+func Follow(ctx context.Context, client ethereum.LogFilterer, query ethereum.FilterQuery, head uint64, resubscribeDelay time.Duration, what string, handle func(Event) error) error {
+	// Everything up to the head is in the past, the last log index of a block is unknown but can't be larger.
+	last := position{block: head, index: ^uint(0)}
+	handleNew := func(e Event) error {
+		if e.Removed || !last.before(e) {
+			return nil
+		}
+		if err := handle(e); err != nil {
+			return err
+		}
+		last = position{block: e.BlockNumber, index: e.Index}
+		return nil
+	}
+
+	events := make(chan Event)
+	resumed := false
+	for {
+		sub, err := client.SubscribeFilterLogs(ctx, query, events)
+		if err != nil {
+			log.Warn("Subscribing failed", "events", what, "err", err)
+			select {
+			case <-ctx.Done():
+				return errors.Wrapf(ctx.Err(), "watching %s", what)
+			case <-time.After(resubscribeDelay):
+			}
+			continue
+		}
+
+		if resumed {
+			q := query
+			q.FromBlock = new(big.Int).SetUint64(last.block)
+			missed, err := client.FilterLogs(ctx, q)
+			if err != nil {
+				sub.Unsubscribe()
+				return errors.Wrapf(err, "getting missed %s", what)
+			}
+			for _, e := range missed {
+				if err := handleNew(e); err != nil {
+					sub.Unsubscribe()
+					return err
+				}
+			}
+		}
+		resumed = true
+
+		err = watch(ctx, sub, events, what, handleNew)
+		sub.Unsubscribe()
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "watching %s", what)
+		case <-time.After(resubscribeDelay):
+		}
+	}
+}
+
+// watch handles the events of sub until it fails, in which case it returns nil so that the caller subscribes again.
+func watch(ctx context.Context, sub ethereum.Subscription, events <-chan Event, what string, handle func(Event) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "watching %s", what)
+		case err := <-sub.Err():
+			log.Warn("Subscription failed", "events", what, "err", err)
+			return nil
+		case e := <-events:
+			if err := handle(e); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// Package subscribe provides helpers for consuming streams of contract events.
+package subscribe
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Event is a contract log delivered by a subscription.
+type Event = types.Log
+
+// DefaultThrottleBuffer is the number of events Throttle holds back by default.
+const DefaultThrottleBuffer = 100
+
+// Policy decides what Throttle does with events arriving while its buffer is full.
+type Policy int
+
+const (
+	// Drop discards the event and logs a warning, the input is always drained promptly.
+	Drop Policy = iota
+	// Block stops reading the input until there is room in the buffer, pushing the back-pressure upstream.
+	Block
+)
+
+type throttleConfig struct {
+	buffer int
+	policy Policy
+}
+
+// ThrottleOption configures Throttle.
+type ThrottleOption func(*throttleConfig)
+
+// WithBuffer sets how many events are held back before the policy applies.
+func WithBuffer(n int) ThrottleOption {
+	return func(c *throttleConfig) {
+		c.buffer = n
+	}
+}
+
+// WithPolicy sets what happens to events arriving while the buffer is full, Drop by default.
+func WithPolicy(p Policy) ThrottleOption {
+	return func(c *throttleConfig) {
+		c.policy = p
+	}
+}
+
+// Throttle delivers the events of in at most maxPerSec times per second, in order. Bursts are held in a buffer
+// of DefaultThrottleBuffer events (see WithBuffer), once it is full the Policy applies. The returned channel is
+// closed after in is closed and the buffered events are delivered; it must be drained to release the goroutines.
+// Throttle panics if maxPerSec isn't positive.
+func Throttle(in <-chan Event, maxPerSec int, opts ...ThrottleOption) <-chan Event {
+	if maxPerSec <= 0 {
+		panic("subscribe: non-positive rate for Throttle")
+	}
+	cfg := throttleConfig{buffer: DefaultThrottleBuffer, policy: Drop}
+	for _, o := range opts {
+		o(&cfg)
+	}
+
+	buf := make(chan Event, cfg.buffer)
+	go func() {
+		defer close(buf)
+		for e := range in {
+			if cfg.policy == Block {
+				buf <- e
+				continue
+			}
+			select {
+			case buf <- e:
+			default:
+				log.Warn("Throttle buffer full, dropping event", "tx", e.TxHash, "index", e.Index)
+			}
+		}
+	}()
+
+	out := make(chan Event)
+	interval := time.Second / time.Duration(maxPerSec)
+	go func() {
+		defer close(out)
+		var next time.Time
+		for e := range buf {
+			if wait := time.Until(next); wait > 0 {
+				time.Sleep(wait)
+			}
+			out <- e
+			next = time.Now().Add(interval)
+		}
+	}()
+	return out
+}
@@ -0,0 +1,64 @@
+// Package txutil extracts information from executed transactions beyond their receipts.
+package txutil
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// RPCClient is the raw RPC access required for tracing, it's implemented by *rpc.Client.
+type RPCClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// Transfer is an ETH transfer made by a contract while executing a transaction.
+type Transfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+}
+
+// callFrame is a call as reported by the built-in callTracer.
+type callFrame struct {
+	Type  string         `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+	Error string         `json:"error"`
+	Calls []callFrame    `json:"calls"`
+}
+
+// InternalTransfers returns the ETH sent by the wallet with internal calls of a transaction, in execution order.
+// The transaction's own value isn't included, neither are calls that were reverted nor the transfers made
+// by other contracts, e.g. by a contract the wallet called.
+//
+// The transaction is re-executed by the node through debug_traceTransaction with the built-in callTracer:
+// the node has to expose the debug API (e.g. geth --rpcapi debug) and, for older transactions,
+// still hold the state of the preceding block, which requires an archive node.
+func InternalTransfers(ctx context.Context, backend RPCClient, wallet common.Address, txHash common.Hash) ([]Transfer, error) {
+	var root callFrame
+	err := backend.CallContext(ctx, &root, "debug_traceTransaction", txHash, map[string]string{"tracer": "callTracer"})
+	if err != nil {
+		return nil, errors.Wrapf(err, "tracing transaction %s", txHash.Hex())
+	}
+	var transfers []Transfer
+	collectTransfers(wallet, root.Calls, &transfers)
+	return transfers, nil
+}
+
+func collectTransfers(wallet common.Address, calls []callFrame, transfers *[]Transfer) {
+	for _, c := range calls {
+		if c.Error != "" {
+			continue
+		}
+		// Delegated calls run in the caller's context, they don't move any ETH.
+		if c.From == wallet && c.Type != "DELEGATECALL" && c.Type != "CALLCODE" && c.Type != "STATICCALL" && c.Value != nil && c.Value.ToInt().Sign() > 0 {
+			*transfers = append(*transfers, Transfer{From: c.From, To: c.To, Value: new(big.Int).Set(c.Value.ToInt())})
+		}
+		collectTransfers(wallet, c.Calls, transfers)
+	}
+}
@@ -0,0 +1,47 @@
+package txutil
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// HeaderReader is the chain access required to notice new blocks.
+type HeaderReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// WaitForState waits until the on-chain state satisfies predicate, e.g. until a transaction's effect is visible
+// to reads rather than just its receipt. The predicate is checked right away and then, every poll interval,
+// whenever the chain head has changed since the last check. It fails with the predicate's error or once ctx is done.
+func WaitForState(ctx context.Context, backend HeaderReader, predicate func(ctx context.Context) (bool, error), poll time.Duration) error {
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+
+	var checked *types.Header
+	for {
+		head, err := backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return errors.Wrap(err, "getting chain head")
+		}
+		if checked == nil || head.Hash() != checked.Hash() {
+			ok, err := predicate(ctx)
+			if err != nil {
+				return errors.Wrap(err, "checking state")
+			}
+			if ok {
+				return nil
+			}
+			checked = head
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "waiting for state")
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Package verify checks that deployed contracts run the code published in this repository.
+package verify
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+)
+
+// CodeReader reads the code of deployed contracts, it's implemented by bind.ContractCaller.
+type CodeReader interface {
+	CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error)
+}
+
+// ParserBytecodeMatches checks whether the contract at addr runs the code of the ParseIntScientificExporter.
+func ParserBytecodeMatches(ctx context.Context, backend CodeReader, addr common.Address) (bool, error) {
+	return BytecodeMatches(ctx, backend, addr, mocks.ParseIntScientificExporterBin)
+}
+
+// BytecodeMatches checks whether the contract at addr runs the code deployed by the hex encoded creation code.
+// The creation code ends with the runtime code, so the deployed code has to be its suffix. The metadata
+// hashes solc appends are left out of the comparison, they change with the source's comments and paths
+// without changing the code.
+func BytecodeMatches(ctx context.Context, backend CodeReader, addr common.Address, creationBin string) (bool, error) {
+	deployed, err := backend.CodeAt(ctx, addr, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "getting code of %s", addr.Hex())
+	}
+	runtime := stripMetadata(deployed)
+	if len(runtime) == 0 {
+		return false, nil
+	}
+	return bytes.HasSuffix(stripMetadata(common.FromHex(creationBin)), runtime), nil
+}
+
+// stripMetadata removes the CBOR encoded metadata solc appends to the code, followed by its 2 byte length.
+func stripMetadata(code []byte) []byte {
+	if len(code) < 2 {
+		return code
+	}
+	n := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	start := len(code) - 2 - n
+	// The metadata is a CBOR map, whose major type is 5.
+	if n == 0 || start < 0 || code[start]>>5 != 5 {
+		return code
+	}
+	return code[:start]
+}
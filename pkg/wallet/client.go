@@ -0,0 +1,186 @@
+// Package wallet provides a client for interacting with a deployed Consumer Contract Wallet.
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/wallet/sigverify"
+)
+
+// DefaultTransferGas prices the gas of a transfer the wallet doesn't hold enough for,
+// as its estimation would revert.
+const DefaultTransferGas = 100000
+
+// Backend is the chain access required by the Client.
+type Backend interface {
+	bind.ContractBackend
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// Client wraps the Wallet binding with higher level helpers.
+type Client struct {
+	*bindings.Wallet
+	address common.Address
+	owner   *bind.TransactOpts
+	backend Backend
+	abi     abi.ABI
+}
+
+// NewClient creates a Client for the wallet deployed at address, transacting as owner.
+func NewClient(address common.Address, owner *bind.TransactOpts, backend Backend) (*Client, error) {
+	w, err := bindings.NewWallet(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := abi.JSON(strings.NewReader(bindings.WalletABI))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		Wallet:  w,
+		address: address,
+		owner:   owner,
+		backend: backend,
+		abi:     parsed,
+	}, nil
+}
+
+// Address returns the address of the wallet contract.
+func (c *Client) Address() common.Address {
+	return c.address
+}
+
+// Shortfall is the amount missing for an operation to be affordable.
+type Shortfall struct {
+	// Token is the amount of the transferred asset missing from the wallet.
+	Token *big.Int
+	// Gas is the amount of ETH missing from the owner account to pay for the transaction.
+	Gas *big.Int
+}
+
+// CanAfford checks whether the wallet holds amount of token (0x0 for ETH) and whether the owner
+// account holds enough ETH to pay for the gas of the transfer.
+func (c *Client) CanAfford(ctx context.Context, token common.Address, amount *big.Int) (bool, Shortfall, error) {
+	balance, err := c.GetBalance(&bind.CallOpts{Context: ctx}, token)
+	if err != nil {
+		return false, Shortfall{}, errors.Wrap(err, "getting wallet balance")
+	}
+
+	tokenShortfall := missing(amount, balance)
+	gas := uint64(DefaultTransferGas)
+	if tokenShortfall.Sign() == 0 {
+		gas, err = c.estimateTransferGas(ctx, c.owner.From, token, amount)
+		if err != nil {
+			return false, Shortfall{}, err
+		}
+	}
+	gasPrice, err := c.backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return false, Shortfall{}, errors.Wrap(err, "suggesting gas price")
+	}
+	gasCost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gas))
+
+	native, err := c.backend.BalanceAt(ctx, c.owner.From, nil)
+	if err != nil {
+		return false, Shortfall{}, errors.Wrap(err, "getting owner balance")
+	}
+
+	s := Shortfall{
+		Token: tokenShortfall,
+		Gas:   missing(gasCost, native),
+	}
+	return s.Token.Sign() == 0 && s.Gas.Sign() == 0, s, nil
+}
+
+// IsOwnerSignature checks whether sig is an EIP-191 personal signature of message made by the current
+// owner of the wallet, e.g. to prove ownership when logging in.
+func (c *Client) IsOwnerSignature(ctx context.Context, message, sig []byte) (bool, error) {
+	signer, err := sigverify.RecoverPersonal(message, sig)
+	if err != nil {
+		return false, err
+	}
+	owner, err := c.Owner(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, errors.Wrap(err, "getting wallet owner")
+	}
+	return signer == owner, nil
+}
+
+// Owners returns the accounts that own the wallet and how many of them have to sign a transaction.
+// The wallet has a single owner, so the owners are that account with a threshold of 1.
+func (c *Client) Owners(ctx context.Context) ([]common.Address, uint64, error) {
+	owner, err := c.Owner(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "getting wallet owner")
+	}
+	return []common.Address{owner}, 1, nil
+}
+
+// maxTransferableRounds bounds how many times MaxTransferable re-estimates the gas of the transfer.
+const maxTransferableRounds = 5
+
+// MaxTransferable returns how much ETH the owner account can send to the given address (e.g. to top up
+// the wallet) once the gas of that same transfer is paid for. The gas is re-estimated for the resulting
+// amount until it settles, and zero is returned if the gas alone exceeds the owner's balance.
+func (c *Client) MaxTransferable(ctx context.Context, to common.Address) (*big.Int, error) {
+	balance, err := c.backend.BalanceAt(ctx, c.owner.From, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting owner balance")
+	}
+	gasPrice, err := c.backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "suggesting gas price")
+	}
+
+	value := new(big.Int)
+	var gas uint64
+	for i := 0; i < maxTransferableRounds; i++ {
+		estimate, err := c.backend.EstimateGas(ctx, ethereum.CallMsg{From: c.owner.From, To: &to, Value: value})
+		if err != nil {
+			return nil, errors.Wrap(err, "estimating transfer gas")
+		}
+		if estimate <= gas {
+			break
+		}
+		gas = estimate
+		cost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gas))
+		if cost.Cmp(balance) >= 0 {
+			return new(big.Int), nil
+		}
+		value = new(big.Int).Sub(balance, cost)
+	}
+	return value, nil
+}
+
+// estimateTransferGas estimates the gas used by the transfer, it fails if the transfer would revert,
+// e.g. because it exceeds the spend limit.
+func (c *Client) estimateTransferGas(ctx context.Context, to, token common.Address, amount *big.Int) (uint64, error) {
+	data, err := c.abi.Pack("transfer", to, token, amount)
+	if err != nil {
+		return 0, err
+	}
+	// A zero gas price estimates the gas regardless of the ETH the owner holds, CanAfford checks that separately.
+	gas, err := c.backend.EstimateGas(ctx, ethereum.CallMsg{From: c.owner.From, To: &c.address, GasPrice: new(big.Int), Data: data})
+	if err != nil {
+		return 0, errors.Wrap(err, "estimating transfer gas")
+	}
+	return gas, nil
+}
+
+// missing returns how much want exceeds have, or zero.
+func missing(want, have *big.Int) *big.Int {
+	if want.Cmp(have) <= 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Sub(want, have)
+}
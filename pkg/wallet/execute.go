@@ -0,0 +1,101 @@
+package wallet
+
+import (
+	"context"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/reverts"
+)
+
+// ErrNotConfirmed is returned by Execute when the confirmation callback declines the transaction.
+var ErrNotConfirmed = errors.New("transaction not confirmed")
+
+type executeConfig struct {
+	dryRun     bool
+	confirm    func(gas uint64) bool
+	zeroTarget bool
+}
+
+// ExecuteOption configures Execute.
+type ExecuteOption func(*executeConfig)
+
+// WithDryRun only simulates the call against the latest block, Execute then returns a nil receipt
+// or the reason the wallet would revert with.
+func WithDryRun() ExecuteOption {
+	return func(c *executeConfig) {
+		c.dryRun = true
+	}
+}
+
+// WithConfirm calls confirm with the estimated gas before sending the transaction,
+// Execute fails with ErrNotConfirmed unless it returns true.
+func WithConfirm(confirm func(gas uint64) bool) ExecuteOption {
+	return func(c *executeConfig) {
+		c.confirm = confirm
+	}
+}
+
+// AllowZeroTarget lets Execute call the zero address, which is almost always a mistake, e.g. an unset target.
+func AllowZeroTarget() ExecuteOption {
+	return func(c *executeConfig) {
+		c.zeroTarget = true
+	}
+}
+
+// Execute has the wallet call target with value and data through executeTransaction, and waits for the
+// transaction to be mined. The call is simulated first so that a reverting call fails with its reason
+// without spending any gas. The receipt is returned even if the transaction failed on-chain.
+func (c *Client) Execute(ctx context.Context, target common.Address, value *big.Int, data []byte, opts ...ExecuteOption) (*types.Receipt, error) {
+	var cfg executeConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	if target == (common.Address{}) && !cfg.zeroTarget {
+		return nil, errors.New("zero target address")
+	}
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	input, err := c.abi.Pack("executeTransaction", target, value, data)
+	if err != nil {
+		return nil, err
+	}
+	msg := ethereum.CallMsg{From: c.owner.From, To: &c.address, Data: input}
+	ret, err := c.backend.CallContract(ctx, msg, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "simulating transaction")
+	}
+	// The simulated backend returns the revert data rather than an error.
+	if r := reverts.Decode(ret); r.Kind == reverts.Reason || r.Kind == reverts.Panic {
+		return nil, errors.Wrap(r, "transaction would revert")
+	}
+	gas, err := c.backend.EstimateGas(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "estimating transaction gas")
+	}
+	if cfg.dryRun {
+		return nil, nil
+	}
+	if cfg.confirm != nil && !cfg.confirm(gas) {
+		return nil, ErrNotConfirmed
+	}
+
+	auth := *c.owner
+	auth.Context = ctx
+	auth.GasLimit = gas
+	tx, err := c.ExecuteTransaction(&auth, target, value, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "sending transaction")
+	}
+	r, err := bind.WaitMined(ctx, c.backend, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "waiting for transaction")
+	}
+	return r, nil
+}
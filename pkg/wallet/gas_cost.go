@@ -0,0 +1,78 @@
+package wallet
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/bindings/externals/ens"
+)
+
+// GasCostInToken returns the cost of gasUnits at the suggested gas price, in base units of token (0x0 for ETH).
+// The ETH cost is converted with the token's rate in the wallet's token whitelist, the same rate the wallet
+// uses to convert tokens to ether, and rounded up so that paying the result covers the gas.
+func (c *Client) GasCostInToken(ctx context.Context, token common.Address, gasUnits uint64) (*big.Int, error) {
+	gasPrice, err := c.backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "suggesting gas price")
+	}
+	cost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasUnits))
+	if token == (common.Address{}) {
+		return cost, nil
+	}
+
+	whitelist, err := c.tokenWhitelist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, magnitude, rate, available, _, _, _, err := whitelist.GetTokenInfo(&bind.CallOpts{Context: ctx}, token)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting token info of %s", token.Hex())
+	}
+	if !available {
+		return nil, errors.Errorf("token %s isn't whitelisted", token.Hex())
+	}
+	if rate.Sign() == 0 {
+		return nil, errors.Errorf("token %s has no rate", token.Hex())
+	}
+	// The rate is the value in wei of one whole token, i.e. of magnitude base units.
+	amount := new(big.Int).Mul(cost, magnitude)
+	amount.Add(amount, new(big.Int).Sub(rate, big.NewInt(1)))
+	return amount.Div(amount, rate), nil
+}
+
+// tokenWhitelist resolves the token whitelist used by the wallet through ENS.
+func (c *Client) tokenWhitelist(ctx context.Context) (*bindings.TokenWhitelistCaller, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	registryAddress, err := c.EnsRegistry(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting ENS registry")
+	}
+	node, err := c.TokenWhitelistNode(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting token whitelist node")
+	}
+	registry, err := ens.NewENSRegistryCaller(registryAddress, c.backend)
+	if err != nil {
+		return nil, err
+	}
+	resolverAddress, err := registry.Resolver(opts, node)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting token whitelist resolver")
+	}
+	resolver, err := ens.NewPublicResolverCaller(resolverAddress, c.backend)
+	if err != nil {
+		return nil, err
+	}
+	address, err := resolver.Addr(opts, node)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving token whitelist")
+	}
+	if address == (common.Address{}) {
+		return nil, errors.New("token whitelist doesn't resolve")
+	}
+	return bindings.NewTokenWhitelistCaller(address, c.backend)
+}
@@ -0,0 +1,136 @@
+package wallet
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// historyPageSize is the number of blocks queried at once, nodes limit the range of a single log query.
+const historyPageSize = 5000
+
+// TransferKind is the kind of wallet operation that moved funds out of the wallet.
+type TransferKind string
+
+// The kinds of transfers returned by History.
+const (
+	KindTransfer      TransferKind = "transfer"
+	KindCardLoad      TransferKind = "card load"
+	KindGasTopUp      TransferKind = "gas top up"
+	KindRelayerRefund TransferKind = "relayer refund"
+)
+
+// Transfer is a movement of funds out of the wallet.
+type Transfer struct {
+	Kind TransferKind
+	// To is the counterparty, it is empty for card loads as the funds go to the licence contract.
+	To common.Address
+	// Asset is the address of the ERC20 token or 0x0 for ETH.
+	Asset  common.Address
+	Amount *big.Int
+
+	BlockNumber uint64
+	TxHash      common.Hash
+	Index       uint
+}
+
+// History returns the transfers made by the wallet between fromBlock and toBlock (both inclusive),
+// ordered as they were executed. The logs are fetched in pages of historyPageSize blocks.
+func (c *Client) History(ctx context.Context, fromBlock, toBlock uint64) ([]Transfer, error) {
+	if toBlock < fromBlock {
+		return nil, errors.Errorf("invalid block range %d-%d", fromBlock, toBlock)
+	}
+	var transfers []Transfer
+	for start := fromBlock; ; start += historyPageSize {
+		end := start + historyPageSize - 1
+		if end > toBlock || end < start {
+			end = toBlock
+		}
+		page, err := c.historyPage(&bind.FilterOpts{Start: start, End: &end, Context: ctx})
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading blocks %d-%d", start, end)
+		}
+		transfers = append(transfers, page...)
+		if end == toBlock {
+			break
+		}
+	}
+	sort.SliceStable(transfers, func(i, j int) bool {
+		if transfers[i].BlockNumber != transfers[j].BlockNumber {
+			return transfers[i].BlockNumber < transfers[j].BlockNumber
+		}
+		return transfers[i].Index < transfers[j].Index
+	})
+	return transfers, nil
+}
+
+// historyPage reads every transfer related event within the range of opts.
+func (c *Client) historyPage(opts *bind.FilterOpts) ([]Transfer, error) {
+	var transfers []Transfer
+	add := func(kind TransferKind, to, asset common.Address, amount *big.Int, raw types.Log) {
+		transfers = append(transfers, Transfer{
+			Kind:        kind,
+			To:          to,
+			Asset:       asset,
+			Amount:      amount,
+			BlockNumber: raw.BlockNumber,
+			TxHash:      raw.TxHash,
+			Index:       raw.Index,
+		})
+	}
+
+	transferred, err := c.FilterTransferred(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "filtering Transferred events")
+	}
+	for transferred.Next() {
+		e := transferred.Event
+		add(KindTransfer, e.To, e.Asset, e.Amount, e.Raw)
+	}
+	if err := transferred.Error(); err != nil {
+		return nil, errors.Wrap(err, "decoding Transferred events")
+	}
+
+	loaded, err := c.FilterLoadedTokenCard(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "filtering LoadedTokenCard events")
+	}
+	for loaded.Next() {
+		e := loaded.Event
+		add(KindCardLoad, common.Address{}, e.Asset, e.Amount, e.Raw)
+	}
+	if err := loaded.Error(); err != nil {
+		return nil, errors.Wrap(err, "decoding LoadedTokenCard events")
+	}
+
+	toppedUp, err := c.FilterToppedUpGas(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "filtering ToppedUpGas events")
+	}
+	for toppedUp.Next() {
+		e := toppedUp.Event
+		add(KindGasTopUp, e.Owner, common.Address{}, e.Amount, e.Raw)
+	}
+	if err := toppedUp.Error(); err != nil {
+		return nil, errors.Wrap(err, "decoding ToppedUpGas events")
+	}
+
+	refunded, err := c.FilterRefundedRelayer(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "filtering RefundedRelayer events")
+	}
+	for refunded.Next() {
+		e := refunded.Event
+		add(KindRelayerRefund, e.Relayer, e.Asset, e.Amount, e.Raw)
+	}
+	if err := refunded.Error(); err != nil {
+		return nil, errors.Wrap(err, "decoding RefundedRelayer events")
+	}
+
+	return transfers, nil
+}
@@ -0,0 +1,53 @@
+// Package relay computes the digests the wallet owner signs to have transactions relayed by the controller.
+package relay
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/wallet/sigverify"
+)
+
+// prefix marks relayed transactions, so that they can't be mistaken for any other signed message.
+const prefix = "monolith:"
+
+// Domain binds a relayed transaction to a chain and a wallet, so that it can't be replayed on others.
+type Domain struct {
+	ChainID           *big.Int
+	VerifyingContract common.Address
+}
+
+// Operation is a call the wallet makes to itself, e.g. a transfer, once relayed.
+type Operation struct {
+	// Nonce must match the wallet's relayNonce when the transaction is executed.
+	Nonce *big.Int
+	// Data is the ABI encoded call.
+	Data []byte
+}
+
+// Digest returns the hash checked by executeRelayedTransaction, i.e. the EIP-191 personal hash of
+// keccak256("monolith:" ++ chainID ++ wallet ++ nonce ++ data). It doesn't need access to a node.
+func Digest(domain Domain, op Operation) ([32]byte, error) {
+	if domain.ChainID == nil || domain.ChainID.Sign() < 0 {
+		return [32]byte{}, errors.New("invalid chain ID")
+	}
+	if op.Nonce == nil || op.Nonce.Sign() < 0 {
+		return [32]byte{}, errors.New("invalid nonce")
+	}
+	if domain.ChainID.BitLen() > 256 || op.Nonce.BitLen() > 256 {
+		return [32]byte{}, errors.New("chain ID or nonce overflows uint256")
+	}
+	message := crypto.Keccak256(
+		[]byte(prefix),
+		math.PaddedBigBytes(domain.ChainID, 32),
+		domain.VerifyingContract.Bytes(),
+		math.PaddedBigBytes(op.Nonce, 32),
+		op.Data,
+	)
+	var digest [32]byte
+	copy(digest[:], sigverify.PersonalHash(message))
+	return digest, nil
+}
@@ -0,0 +1,48 @@
+// Package sigverify signs and verifies EIP-191 personal messages, as used by the login flows.
+package sigverify
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// PersonalHash returns the hash of the message prefixed with "\x19Ethereum Signed Message:\n" and its length.
+func PersonalHash(message []byte) []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+}
+
+// PersonalSign signs the personal hash of the message with the given raw private key.
+// The recovery id of the returned signature is 27 or 28, as expected by the contracts.
+func PersonalSign(key, message []byte) ([]byte, error) {
+	prv, err := crypto.ToECDSA(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing private key")
+	}
+	sig, err := crypto.Sign(PersonalHash(message), prv)
+	if err != nil {
+		return nil, err
+	}
+	sig[64] += 27
+	return sig, nil
+}
+
+// RecoverPersonal returns the address that signed the personal hash of the message.
+// Both the 0/1 and 27/28 recovery ids are accepted.
+func RecoverPersonal(message, sig []byte) (common.Address, error) {
+	if len(sig) != 65 {
+		return common.Address{}, errors.Errorf("invalid signature length %d", len(sig))
+	}
+	s := make([]byte, 65)
+	copy(s, sig)
+	if s[64] >= 27 {
+		s[64] -= 27
+	}
+	pub, err := crypto.SigToPub(PersonalHash(message), s)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "recovering signer")
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
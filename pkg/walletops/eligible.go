@@ -0,0 +1,62 @@
+// Package walletops reports on the wallet operations that only take effect after a delay.
+package walletops
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// The delayed operations EligibleAt knows about.
+const (
+	// OpScheduledLimitChange is a spend limit change submitted with scheduleLimitChange and confirmed by the controller.
+	OpScheduledLimitChange uint8 = iota
+)
+
+// ErrNothingPending is returned by EligibleAt when the wallet has no such operation pending.
+var ErrNothingPending = errors.New("no pending operation")
+
+// Caller is the wallet access required by EligibleAt, it's implemented by bindings.WalletCaller.
+type Caller interface {
+	ScheduledLimitChange(opts *bind.CallOpts) (*big.Int, *big.Int, error)
+}
+
+// HeaderReader is the chain access required to know the current chain time.
+type HeaderReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// EligibleAt returns the local time at which the pending op of the wallet takes effect, e.g. to tell the
+// owner that a limit change applies at 3:42 PM. The chain time can drift from the local clock, so the time
+// left is measured against the latest block and added to the current time. The result is in the past if
+// the operation is already eligible.
+func EligibleAt(ctx context.Context, caller Caller, client HeaderReader, op uint8) (time.Time, error) {
+	var at *big.Int
+	switch op {
+	case OpScheduledLimitChange:
+		_, effectiveAt, err := caller.ScheduledLimitChange(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return time.Time{}, errors.Wrap(err, "getting scheduled limit change")
+		}
+		at = effectiveAt
+	default:
+		return time.Time{}, errors.Errorf("unknown operation %d", op)
+	}
+	if at.Sign() == 0 {
+		return time.Time{}, ErrNothingPending
+	}
+
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "getting chain head")
+	}
+	left := new(big.Int).Sub(at, new(big.Int).SetUint64(head.Time))
+	if !left.IsInt64() || left.Int64() > int64(time.Duration(1<<63-1)/time.Second) {
+		return time.Time{}, errors.Errorf("eligibility time %s out of range", at)
+	}
+	return time.Now().Add(time.Duration(left.Int64()) * time.Second), nil
+}
@@ -0,0 +1,64 @@
+package walletops
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+)
+
+// WhitelistAddResult is the outcome of the whitelist addition submitted to a wallet.
+type WhitelistAddResult struct {
+	Wallet common.Address
+	// TxHash is the hash of the transaction adding the address, it's empty if Err is set.
+	TxHash common.Hash
+	// Pending is true if the addition was submitted and awaits the controller's confirmation, false if the
+	// wallet's whitelist wasn't initialized yet and was set to the address right away.
+	Pending bool
+	Err     error
+}
+
+// WhitelistAddMany adds addr to the whitelist of each wallet as opts.From, which must own them, e.g. to onboard
+// a counterparty of every wallet of an enterprise. The whitelist of a new wallet is initialized with addr, the
+// addition is submitted to the others and still has to be confirmed by the controller. A failure doesn't stop
+// the other wallets, the results are in the order of wallets. The transactions are only sent, it's up to the
+// caller to wait for them to be mined. opts.Nonce should be nil, so that each transaction is sent with the next
+// pending nonce of the owner.
+func WhitelistAddMany(ctx context.Context, wallets []common.Address, addr common.Address, backend bind.ContractBackend, opts *bind.TransactOpts) []WhitelistAddResult {
+	results := make([]WhitelistAddResult, len(wallets))
+	for i, wallet := range wallets {
+		results[i].Wallet = wallet
+		if err := ctx.Err(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		w, err := bindings.NewWallet(wallet, backend)
+		if err != nil {
+			results[i].Err = errors.Wrap(err, "binding the wallet")
+			continue
+		}
+		initialized, err := w.IsSetWhitelist(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			results[i].Err = errors.Wrapf(err, "checking whitelist of %s", wallet.Hex())
+			continue
+		}
+		txOpts := *opts
+		txOpts.Context = ctx
+		var tx *types.Transaction
+		if initialized {
+			tx, err = w.SubmitWhitelistAddition(&txOpts, []common.Address{addr})
+		} else {
+			tx, err = w.SetWhitelist(&txOpts, []common.Address{addr})
+		}
+		if err != nil {
+			results[i].Err = errors.Wrapf(err, "adding to whitelist of %s", wallet.Hex())
+			continue
+		}
+		results[i].TxHash = tx.Hash()
+		results[i].Pending = initialized
+	}
+	return results
+}
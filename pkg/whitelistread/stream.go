@@ -0,0 +1,103 @@
+package whitelistread
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// Stream reads the whole whitelist in pages of pageSize tokens, one JSON-RPC batch per page, and delivers
+// the entries as they are read so that large whitelists can be processed incrementally.
+// The whitelist may change while it is streamed: tokens removed before their page is read are skipped,
+// and the token list is read again at the end to stream the tokens added in the meantime. Each token is
+// delivered at most once. Both channels are closed once done, the error channel receives the error that
+// stopped the stream, if any. The entries must be consumed or ctx cancelled to release the goroutine.
+func Stream(ctx context.Context, caller *Caller, pageSize int) (<-chan TokenInfo, <-chan error) {
+	infos := make(chan TokenInfo)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(infos)
+		if err := stream(ctx, caller, pageSize, infos); err != nil {
+			errc <- err
+		}
+	}()
+	return infos, errc
+}
+
+func stream(ctx context.Context, caller *Caller, pageSize int, infos chan<- TokenInfo) error {
+	if pageSize <= 0 {
+		return errors.Errorf("invalid page size %d", pageSize)
+	}
+	seen := map[common.Address]bool{}
+	for {
+		tokens, err := tokenAddresses(ctx, caller)
+		if err != nil {
+			return err
+		}
+		var pending []common.Address
+		for _, t := range tokens {
+			if !seen[t] {
+				pending = append(pending, t)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		for len(pending) > 0 {
+			page := pending
+			if len(page) > pageSize {
+				page = page[:pageSize]
+			}
+			pending = pending[len(page):]
+
+			batch, err := InfoBatch(ctx, caller, page)
+			if err != nil {
+				return err
+			}
+			for _, info := range batch {
+				seen[info.Address] = true
+				if !info.Available {
+					continue
+				}
+				select {
+				case infos <- info:
+				case <-ctx.Done():
+					return errors.Wrap(ctx.Err(), "streaming whitelist")
+				}
+			}
+		}
+	}
+}
+
+// tokenAddresses reads the addresses of the whitelisted tokens.
+func tokenAddresses(ctx context.Context, caller *Caller) ([]common.Address, error) {
+	data, err := caller.abi.Pack("tokenAddressArray")
+	if err != nil {
+		return nil, err
+	}
+	var result hexutil.Bytes
+	batch := []rpc.BatchElem{{
+		Method: "eth_call",
+		Args: []interface{}{
+			map[string]interface{}{"to": caller.address, "data": hexutil.Bytes(data)},
+			"latest",
+		},
+		Result: &result,
+	}}
+	if err := caller.client.BatchCallContext(ctx, batch); err != nil {
+		return nil, errors.Wrap(err, "sending batch")
+	}
+	if batch[0].Error != nil {
+		return nil, errors.Wrap(batch[0].Error, "getting token addresses")
+	}
+	var tokens []common.Address
+	if err := caller.abi.Unpack(&tokens, "tokenAddressArray", result); err != nil {
+		return nil, errors.Wrap(err, "unpacking token addresses")
+	}
+	return tokens, nil
+}
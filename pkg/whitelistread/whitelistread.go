@@ -0,0 +1,101 @@
+// Package whitelistread reads the token whitelist in bulk.
+package whitelistread
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+)
+
+// BatchCaller sends several JSON-RPC requests at once, it is implemented by *rpc.Client.
+type BatchCaller interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// Caller reads the token whitelist deployed at a given address.
+type Caller struct {
+	client  BatchCaller
+	address common.Address
+	abi     abi.ABI
+}
+
+// NewCaller creates a Caller for the token whitelist deployed at address.
+func NewCaller(client BatchCaller, address common.Address) (*Caller, error) {
+	parsed, err := abi.JSON(strings.NewReader(bindings.TokenWhitelistABI))
+	if err != nil {
+		return nil, err
+	}
+	return &Caller{
+		client:  client,
+		address: address,
+		abi:     parsed,
+	}, nil
+}
+
+// TokenInfo is the whitelist entry of a token, as returned by getTokenInfo.
+type TokenInfo struct {
+	Address    common.Address
+	Symbol     string
+	Magnitude  *big.Int
+	Rate       *big.Int
+	Available  bool
+	Loadable   bool
+	Redeemable bool
+	LastUpdate *big.Int
+}
+
+// InfoBatch reads the whitelist entries of all the tokens in a single round-trip, by sending one getTokenInfo
+// eth_call per token in a JSON-RPC batch. Tokens that aren't whitelisted are returned with Available unset.
+func InfoBatch(ctx context.Context, caller *Caller, tokens []common.Address) ([]TokenInfo, error) {
+	results := make([]hexutil.Bytes, len(tokens))
+	batch := make([]rpc.BatchElem, len(tokens))
+	for i, t := range tokens {
+		data, err := caller.abi.Pack("getTokenInfo", t)
+		if err != nil {
+			return nil, err
+		}
+		batch[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{
+				map[string]interface{}{"to": caller.address, "data": hexutil.Bytes(data)},
+				"latest",
+			},
+			Result: &results[i],
+		}
+	}
+	if len(batch) == 0 {
+		return nil, nil
+	}
+	if err := caller.client.BatchCallContext(ctx, batch); err != nil {
+		return nil, errors.Wrap(err, "sending batch")
+	}
+
+	infos := make([]TokenInfo, len(tokens))
+	for i, t := range tokens {
+		if batch[i].Error != nil {
+			return nil, errors.Wrapf(batch[i].Error, "getting token info of %s", t.Hex())
+		}
+		info := TokenInfo{Address: t}
+		out := &[]interface{}{
+			&info.Symbol,
+			&info.Magnitude,
+			&info.Rate,
+			&info.Available,
+			&info.Loadable,
+			&info.Redeemable,
+			&info.LastUpdate,
+		}
+		if err := caller.abi.Unpack(out, "getTokenInfo", results[i]); err != nil {
+			return nil, errors.Wrapf(err, "unpacking token info of %s", t.Hex())
+		}
+		infos[i] = info
+	}
+	return infos, nil
+}
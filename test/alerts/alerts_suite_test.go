@@ -0,0 +1,24 @@
+package alerts_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+func TestAlertsSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Alerts Suite")
+}
+
+var _ = BeforeEach(func() {
+	err := InitializeBackend()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterEach(func() {
+	err := Backend.Close()
+	Expect(err).ToNot(HaveOccurred())
+})
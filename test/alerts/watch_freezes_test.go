@@ -0,0 +1,174 @@
+package alerts_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/alerts"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+// droppedSub is a log subscription the test can fail, as if the connection to the node was lost.
+type droppedSub struct {
+	ethereum.Subscription
+	err chan error
+}
+
+func (s *droppedSub) Err() <-chan error {
+	return s.err
+}
+
+// flakyBackend adds the header access missing from the simulated backend, and lets the test go offline.
+type flakyBackend struct {
+	ethertest.TestBackend
+
+	mu         sync.Mutex
+	offline    bool
+	subs       []*droppedSub
+	subscribed chan struct{}
+}
+
+func (f *flakyBackend) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return f.Blockchain().GetHeaderByHash(hash), nil
+}
+
+func (f *flakyBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return f.Blockchain().CurrentHeader(), nil
+}
+
+func (f *flakyBackend) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.offline {
+		return nil, errors.New("offline")
+	}
+	sub, err := f.TestBackend.SubscribeFilterLogs(ctx, q, ch)
+	if err != nil {
+		return nil, err
+	}
+	d := &droppedSub{Subscription: sub, err: make(chan error, 1)}
+	f.subs = append(f.subs, d)
+	f.subscribed <- struct{}{}
+	return d, nil
+}
+
+// disconnect fails the current subscription and any new one until reconnect is called.
+func (f *flakyBackend) disconnect() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.offline = true
+	f.subs[len(f.subs)-1].err <- errors.New("connection lost")
+}
+
+func (f *flakyBackend) reconnect() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.offline = false
+}
+
+var _ = Describe("WatchFreezes", func() {
+
+	var backend *flakyBackend
+	var freezes chan alerts.Freeze
+	var cancel context.CancelFunc
+	var done chan error
+
+	stop := func() *types.Transaction {
+		tx, err := ControllerContract.Stop(ControllerAdmin.TransactOpts())
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+		return tx
+	}
+
+	BeforeEach(func() {
+		alerts.ResubscribeDelay = 10 * time.Millisecond
+		backend = &flakyBackend{TestBackend: Backend, subscribed: make(chan struct{}, 10)}
+		freezes = make(chan alerts.Freeze, 10)
+		done = make(chan error, 1)
+
+		controllerABI, err := abi.JSON(strings.NewReader(bindings.ControllerABI))
+		Expect(err).ToNot(HaveOccurred())
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		go func() {
+			done <- alerts.WatchFreezes(ctx, backend, controllerABI, []common.Address{ControllerContractAddress}, func(f alerts.Freeze) {
+				freezes <- f
+			})
+		}()
+		Eventually(backend.subscribed).Should(Receive())
+	})
+
+	AfterEach(func() {
+		cancel()
+		var err error
+		Eventually(done).Should(Receive(&err))
+		Expect(errors.Cause(err)).To(Equal(context.Canceled))
+	})
+
+	When("the controller is stopped", func() {
+
+		var tx *types.Transaction
+
+		BeforeEach(func() {
+			tx = stop()
+		})
+
+		It("should call the handler with the controller and the time of the freeze", func() {
+			var f alerts.Freeze
+			Eventually(freezes).Should(Receive(&f))
+			Expect(f.Contract).To(Equal(ControllerContractAddress))
+			Expect(f.TxHash).To(Equal(tx.Hash()))
+			Expect(f.Timestamp).To(Equal(Backend.Blockchain().CurrentBlock().Time()))
+			Consistently(freezes).ShouldNot(Receive())
+		})
+	})
+
+	When("the controller is stopped while the subscription is down", func() {
+
+		var tx *types.Transaction
+
+		BeforeEach(func() {
+			backend.disconnect()
+			tx = stop()
+			backend.reconnect()
+			Eventually(backend.subscribed).Should(Receive())
+		})
+
+		It("should resubscribe and call the handler once", func() {
+			var f alerts.Freeze
+			Eventually(freezes).Should(Receive(&f))
+			Expect(f.TxHash).To(Equal(tx.Hash()))
+			Consistently(freezes).ShouldNot(Receive())
+		})
+
+		When("the controller is restarted and stopped again", func() {
+			BeforeEach(func() {
+				tx, err := ControllerContract.Start(ControllerOwner.TransactOpts())
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(IsSuccessful(tx)).To(BeTrue())
+				stop()
+			})
+
+			It("should report both freezes", func() {
+				Eventually(freezes).Should(Receive())
+				Eventually(freezes).Should(Receive())
+				Consistently(freezes).ShouldNot(Receive())
+			})
+		})
+	})
+})
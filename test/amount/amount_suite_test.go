@@ -0,0 +1,36 @@
+package amount_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var ParseIntScientificExporter *mocks.ParseIntScientificExporter
+var ParseIntScientificExporterAddress common.Address
+
+func TestAmountSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Amount Suite")
+}
+
+var _ = BeforeEach(func() {
+	err := InitializeBackend()
+	Expect(err).ToNot(HaveOccurred())
+
+	var tx *types.Transaction
+	ParseIntScientificExporterAddress, tx, ParseIntScientificExporter, err = mocks.DeployParseIntScientificExporter(RandomAccount.TransactOpts(), Backend)
+	Expect(err).ToNot(HaveOccurred())
+	Backend.Commit()
+	Expect(IsSuccessful(tx)).To(BeTrue())
+})
+
+var _ = AfterEach(func() {
+	err := Backend.Close()
+	Expect(err).ToNot(HaveOccurred())
+})
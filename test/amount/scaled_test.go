@@ -0,0 +1,61 @@
+package amount_test
+
+import (
+	"math/big"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/amount"
+)
+
+var _ = Describe("Scaled", func() {
+
+	expectHuman := func(value string, decimals uint, human string) {
+		v, ok := new(big.Int).SetString(value, 10)
+		Expect(ok).To(BeTrue())
+		s := amount.Scaled{Value: v, Decimals: decimals}
+		Expect(s.Human()).To(Equal(human))
+	}
+
+	It("should render whole units without trailing zeros", func() {
+		expectHuman("1500000000000000000", 18, "1.5")
+		expectHuman("2000000", 6, "2")
+		expectHuman("1", 6, "0.000001")
+		expectHuman("123", 0, "123")
+		expectHuman("-2500", 3, "-2.5")
+		expectHuman("0", 18, "0")
+	})
+
+	It("should tag the rendering with its decimals", func() {
+		s := amount.Scaled{Value: big.NewInt(15e17), Decimals: 18}
+		Expect(s.String()).To(Equal("1.5 (18 dp)"))
+	})
+
+	It("should return a copy of the raw value", func() {
+		s := amount.Scaled{Value: big.NewInt(42), Decimals: 2}
+		raw := s.Raw()
+		Expect(raw.String()).To(Equal("42"))
+		raw.SetInt64(7)
+		Expect(s.Value.String()).To(Equal("42"))
+	})
+
+	It("should treat a missing value as zero", func() {
+		Expect(amount.Scaled{Decimals: 8}.String()).To(Equal("0 (8 dp)"))
+	})
+
+	Describe("ParseDecimals", func() {
+		It("should tag the parsed value with the requested decimals", func() {
+			s, err := amount.ParseDecimals(nil, ParseIntScientificExporter, "1.5", 18)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.Raw().String()).To(Equal("1500000000000000000"))
+			Expect(s.Decimals).To(Equal(uint(18)))
+			Expect(s.String()).To(Equal("1.5 (18 dp)"))
+		})
+
+		It("should fail on an invalid number", func() {
+			_, err := amount.ParseDecimals(nil, ParseIntScientificExporter, "1.5.5", 18)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`parsing "1.5.5" with 18 decimals`))
+		})
+	})
+})
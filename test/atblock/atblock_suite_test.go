@@ -0,0 +1,89 @@
+package atblock_test
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+func TestAtBlockSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AtBlock Suite")
+}
+
+var _ = BeforeEach(func() {
+	err := InitializeBackend()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterEach(func() {
+	err := Backend.Close()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+// archiveBackend serves reads at any block of the test chain,
+// the simulated backend itself only serves the latest block.
+type archiveBackend struct {
+	ethertest.TestBackend
+}
+
+func (a archiveBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if number == nil {
+		return a.Blockchain().CurrentHeader(), nil
+	}
+	return a.Blockchain().GetHeaderByNumber(number.Uint64()), nil
+}
+
+func (a archiveBackend) stateAt(number *big.Int) (*state.StateDB, *types.Header, error) {
+	header, err := a.HeaderByNumber(context.Background(), number)
+	if err != nil {
+		return nil, nil, err
+	}
+	if header == nil {
+		return nil, nil, errors.Errorf("unknown block %s", number)
+	}
+	st, err := a.Blockchain().StateAt(header.Root)
+	return st, header, err
+}
+
+func (a archiveBackend) CodeAt(ctx context.Context, contract common.Address, number *big.Int) ([]byte, error) {
+	st, _, err := a.stateAt(number)
+	if err != nil {
+		return nil, err
+	}
+	return st.GetCode(contract), nil
+}
+
+func (a archiveBackend) CallContract(ctx context.Context, call ethereum.CallMsg, number *big.Int) ([]byte, error) {
+	st, header, err := a.stateAt(number)
+	if err != nil {
+		return nil, err
+	}
+	value := call.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	msg := types.NewMessage(call.From, call.To, 0, value, header.GasLimit, new(big.Int), call.Data, false)
+	evm := vm.NewEVM(core.NewEVMContext(msg, header, a.Blockchain(), nil), st, a.Blockchain().Config(), vm.Config{})
+	ret, _, failed, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(math.MaxUint64))
+	if err != nil {
+		return nil, err
+	}
+	if failed {
+		return nil, errors.New("execution reverted")
+	}
+	return ret, nil
+}
@@ -0,0 +1,59 @@
+package atblock_test
+
+import (
+	"context"
+	"math/big"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/atblock"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("PinLatest", func() {
+
+	var pinned *atblock.Caller
+
+	BeforeEach(func() {
+		tx, err := TKNBurner.Mint(BankAccount.TransactOpts(), RandomAccount.Address(), big.NewInt(100))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		archive := archiveBackend{Backend}
+		block, err := atblock.PinLatest(context.Background(), archive)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(block.Uint64()).To(Equal(Backend.Blockchain().CurrentBlock().NumberU64()))
+		pinned = atblock.NewCaller(archive, block)
+	})
+
+	When("the chain advances", func() {
+		BeforeEach(func() {
+			tx, err := TKNBurner.Mint(BankAccount.TransactOpts(), RandomAccount.Address(), big.NewInt(50))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+			Backend.Commit()
+		})
+
+		It("should keep reading the state of the pinned block", func() {
+			token, err := mocks.NewBurnerTokenCaller(TKNBurnerAddress, pinned)
+			Expect(err).ToNot(HaveOccurred())
+
+			b, err := token.BalanceOf(nil, RandomAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b.String()).To(Equal("100"))
+
+			s, err := token.TotalSupply(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.String()).To(Equal("100"))
+		})
+
+		It("should differ from the latest state", func() {
+			b, err := TKNBurner.BalanceOf(nil, RandomAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b.String()).To(Equal("150"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package bindcheck_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestBindCheckSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "BindCheck Suite")
+}
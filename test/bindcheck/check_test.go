@@ -0,0 +1,106 @@
+package bindcheck_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindcheck"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+)
+
+var _ = Describe("Check", func() {
+
+	var buildDir string
+	var token = bindcheck.Binding{Artifact: "mocks/token/Token", ABI: mocks.TokenABI, Bin: mocks.TokenBin}
+
+	writeArtifacts := func(abiJSON, bin string) {
+		dir := filepath.Join(buildDir, "mocks", "token")
+		Expect(os.MkdirAll(dir, 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "Token.abi"), []byte(abiJSON), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "Token.bin"), []byte(bin), 0644)).To(Succeed())
+	}
+
+	// reversedABI returns the binding's ABI with its entries in reverse order.
+	reversedABI := func() string {
+		var entries []json.RawMessage
+		Expect(json.Unmarshal([]byte(mocks.TokenABI), &entries)).To(Succeed())
+		for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+			entries[i], entries[j] = entries[j], entries[i]
+		}
+		b, err := json.MarshalIndent(entries, "", "  ")
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	// artifactBin returns the binding's bytecode as solc writes it, with another metadata hash.
+	artifactBin := func() string {
+		bin := strings.TrimPrefix(mocks.TokenBin, "0x")
+		hash := regexp.MustCompile(`(a265627a7a72315820)[0-9a-f]{64}`)
+		Expect(hash.MatchString(bin)).To(BeTrue())
+		return hash.ReplaceAllString(bin, "${1}"+strings.Repeat("ab", 32)) + "\n"
+	}
+
+	BeforeEach(func() {
+		var err error
+		buildDir, err = ioutil.TempDir("", "bindcheck")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(buildDir)).To(Succeed())
+	})
+
+	When("the artifacts only differ in the order of the ABI and the metadata hash", func() {
+		It("should pass", func() {
+			writeArtifacts(reversedABI(), artifactBin())
+			Expect(bindcheck.Check(buildDir, token)).To(Succeed())
+		})
+	})
+
+	When("the ABI differs", func() {
+		It("should fail with ErrABIDrift", func() {
+			var entries []json.RawMessage
+			Expect(json.Unmarshal([]byte(mocks.TokenABI), &entries)).To(Succeed())
+			b, err := json.Marshal(entries[1:])
+			Expect(err).ToNot(HaveOccurred())
+			writeArtifacts(string(b), artifactBin())
+
+			err = bindcheck.Check(buildDir, token)
+			Expect(errors.Cause(err)).To(Equal(bindcheck.ErrABIDrift))
+			Expect(err).To(MatchError("mocks/token/Token: ABI differs from the compiled contract"))
+		})
+	})
+
+	When("the code differs", func() {
+		It("should fail with ErrBinDrift", func() {
+			bin := artifactBin()
+			writeArtifacts(reversedABI(), bin[:10]+"ff"+bin[12:])
+
+			err := bindcheck.Check(buildDir, token)
+			Expect(errors.Cause(err)).To(Equal(bindcheck.ErrBinDrift))
+		})
+	})
+
+	When("the artifacts are missing", func() {
+		It("should fail", func() {
+			Expect(bindcheck.Check(buildDir, token)).ToNot(Succeed())
+		})
+	})
+
+	When("the contracts have been compiled", func() {
+		It("should find every binding up to date", func() {
+			// build.sh writes the artifacts to build at the root of the repository.
+			if _, err := os.Stat(filepath.Join("..", "..", "build")); os.IsNotExist(err) {
+				Skip("the contracts haven't been compiled")
+			}
+			Expect(bindcheck.CheckAll(filepath.Join("..", "..", "build"))).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,123 @@
+package breaker_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/breaker"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("Backend", func() {
+
+	var flaky *flakyBackend
+	var b *breaker.Backend
+	var now time.Time
+
+	call := func() error {
+		_, err := b.CallContract(context.Background(), ethereum.CallMsg{To: &TokenWhitelistAddress}, nil)
+		return err
+	}
+
+	fail := func(n int) {
+		for i := 0; i < n; i++ {
+			Expect(call()).To(MatchError("node down"))
+		}
+	}
+
+	BeforeEach(func() {
+		now = time.Unix(1600000000, 0)
+		flaky = &flakyBackend{ContractBackend: Backend}
+		b = breaker.New(flaky, breaker.Config{
+			FailureThreshold: 3,
+			Cooldown:         time.Minute,
+			Clock:            func() time.Time { return now },
+		})
+	})
+
+	It("should be closed by default", func() {
+		Expect(b.State()).To(Equal(breaker.Closed))
+		Expect(call()).To(Succeed())
+	})
+
+	When("the backend keeps failing", func() {
+		BeforeEach(func() {
+			flaky.err = errors.New("node down")
+		})
+
+		It("should stay closed below the threshold", func() {
+			fail(2)
+			Expect(b.State()).To(Equal(breaker.Closed))
+		})
+
+		It("should reset the failure count after a success", func() {
+			fail(2)
+			flaky.err = nil
+			Expect(call()).To(Succeed())
+			flaky.err = errors.New("node down")
+			fail(2)
+			Expect(b.State()).To(Equal(breaker.Closed))
+		})
+
+		When("the threshold is reached", func() {
+			BeforeEach(func() {
+				fail(3)
+			})
+
+			It("should open and fail fast", func() {
+				Expect(b.State()).To(Equal(breaker.Open))
+				Expect(call()).To(Equal(breaker.ErrCircuitOpen))
+				Expect(flaky.calls).To(Equal(3))
+			})
+
+			It("should stay open during the cooldown", func() {
+				now = now.Add(59 * time.Second)
+				Expect(b.State()).To(Equal(breaker.Open))
+				Expect(call()).To(Equal(breaker.ErrCircuitOpen))
+			})
+
+			When("the cooldown is over", func() {
+				BeforeEach(func() {
+					now = now.Add(time.Minute)
+				})
+
+				It("should be half-open", func() {
+					Expect(b.State()).To(Equal(breaker.HalfOpen))
+				})
+
+				It("should close when the probe succeeds", func() {
+					flaky.err = nil
+					Expect(call()).To(Succeed())
+					Expect(b.State()).To(Equal(breaker.Closed))
+					Expect(call()).To(Succeed())
+				})
+
+				It("should reopen when the probe fails", func() {
+					fail(1)
+					Expect(b.State()).To(Equal(breaker.Open))
+					Expect(call()).To(Equal(breaker.ErrCircuitOpen))
+					Expect(flaky.calls).To(Equal(4))
+				})
+			})
+		})
+	})
+
+	When("errors aren't counted as failures", func() {
+		BeforeEach(func() {
+			b = breaker.New(flaky, breaker.Config{
+				FailureThreshold: 1,
+				IsFailure:        func(error) bool { return false },
+			})
+			flaky.err = errors.New("node down")
+		})
+
+		It("should stay closed", func() {
+			fail(3)
+			Expect(b.State()).To(Equal(breaker.Closed))
+		})
+	})
+})
@@ -0,0 +1,43 @@
+package breaker_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+func TestBreakerSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Breaker Suite")
+}
+
+var _ = BeforeEach(func() {
+	err := InitializeBackend()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterEach(func() {
+	err := Backend.Close()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+// flakyBackend fails contract calls with err while it is set, counting the calls it receives.
+type flakyBackend struct {
+	bind.ContractBackend
+	err   error
+	calls int
+}
+
+func (f *flakyBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.ContractBackend.CallContract(ctx, call, blockNumber)
+}
@@ -0,0 +1,13 @@
+package calldata_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCalldataSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Calldata Suite")
+}
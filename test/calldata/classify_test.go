@@ -0,0 +1,83 @@
+package calldata_test
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	"github.com/tokencard/contracts/v3/pkg/calldata"
+)
+
+var _ = Describe("Classify", func() {
+
+	var abis []calldata.ABI
+
+	pack := func(abiJSON, method string, args ...interface{}) []byte {
+		a, err := abi.JSON(strings.NewReader(abiJSON))
+		Expect(err).ToNot(HaveOccurred())
+		data, err := a.Pack(method, args...)
+		Expect(err).ToNot(HaveOccurred())
+		return data
+	}
+
+	BeforeEach(func() {
+		var err error
+		abis, err = calldata.DefaultABIs()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should classify a parseIntScientific call", func() {
+		contract, method, args, ok := calldata.Classify(pack(mocks.ParseIntScientificExporterABI, "parseIntScientific", "1.5e3"), abis)
+		Expect(ok).To(BeTrue())
+		Expect(contract).To(Equal("ParseIntScientificExporter"))
+		Expect(method).To(Equal("parseIntScientific"))
+		Expect(args).To(Equal([]interface{}{"1.5e3"}))
+	})
+
+	It("should classify a parseIntScientificDecimals call", func() {
+		contract, method, args, ok := calldata.Classify(pack(mocks.ParseIntScientificExporterABI, "parseIntScientificDecimals", "0.25", big.NewInt(6)), abis)
+		Expect(ok).To(BeTrue())
+		Expect(contract).To(Equal("ParseIntScientificExporter"))
+		Expect(method).To(Equal("parseIntScientificDecimals"))
+		Expect(args).To(HaveLen(2))
+		Expect(args[0]).To(Equal("0.25"))
+		Expect(args[1].(*big.Int).String()).To(Equal("6"))
+	})
+
+	It("should classify a wallet transfer", func() {
+		to := common.HexToAddress("0x1")
+		contract, method, args, ok := calldata.Classify(pack(bindings.WalletABI, "transfer", to, common.Address{}, big.NewInt(100)), abis)
+		Expect(ok).To(BeTrue())
+		Expect(contract).To(Equal("Wallet"))
+		Expect(method).To(Equal("transfer"))
+		Expect(args[0]).To(Equal(to))
+		Expect(args[2].(*big.Int).String()).To(Equal("100"))
+	})
+
+	When("the selector is unknown", func() {
+		It("should not be classified", func() {
+			_, _, _, ok := calldata.Classify([]byte{0xde, 0xad, 0xbe, 0xef}, abis)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("the calldata is shorter than a selector", func() {
+		It("should not be classified", func() {
+			_, _, _, ok := calldata.Classify([]byte{0x01}, abis)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("the arguments are truncated", func() {
+		It("should not be classified", func() {
+			data := pack(mocks.ParseIntScientificExporterABI, "parseIntScientific", "1.5e3")
+			_, _, _, ok := calldata.Classify(data[:20], abis)
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package ctordec_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCtorDecSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Constructor Decoding Suite")
+}
@@ -0,0 +1,20 @@
+package ctordec_test
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+)
+
+var _ = Describe("ParseIntScientificExporter constructor", func() {
+
+	// The exporter's Bin is used as is as init code, constructor arguments would have to be appended to it.
+	It("should not take any arguments", func() {
+		parsed, err := abi.JSON(strings.NewReader(mocks.ParseIntScientificExporterABI))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed.Constructor.Inputs).To(BeEmpty(), "the exporter constructor takes arguments, the init code must encode them")
+	})
+})
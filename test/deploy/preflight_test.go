@@ -0,0 +1,84 @@
+package deploy_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	"github.com/tokencard/contracts/v3/pkg/deploy"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+// zeroCode returns creation code deploying size zero bytes: PUSH2 size, PUSH1 0, RETURN.
+func zeroCode(size int) string {
+	return fmt.Sprintf("61%04x6000f3", size)
+}
+
+var _ = Describe("Preflight", func() {
+
+	When("the ParseIntScientificExporter is checked", func() {
+		It("should be under the limit and match an actual deployment", func() {
+			size, over, gas, err := deploy.Preflight(mocks.ParseIntScientificExporterBin)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(over).To(BeFalse())
+
+			addr, tx, _, err := mocks.DeployParseIntScientificExporter(BankAccount.TransactOpts(), Backend)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			r, err := Backend.TransactionReceipt(context.Background(), tx.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(gas).To(Equal(r.GasUsed))
+
+			code, err := Backend.CodeAt(context.Background(), addr, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(size).To(Equal(len(code)))
+			Expect(size).To(BeNumerically("<", deploy.MaxCodeSize))
+		})
+	})
+
+	When("the Wallet is checked", func() {
+		It("should fit in the EIP-170 limit", func() {
+			size, over, _, err := deploy.Preflight(bindings.WalletBin)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(over).To(BeFalse(), "the Wallet is %d bytes", size)
+		})
+	})
+
+	When("the runtime code is exactly at the limit", func() {
+		It("should not be over the limit", func() {
+			size, over, _, err := deploy.Preflight(zeroCode(deploy.MaxCodeSize))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(size).To(Equal(deploy.MaxCodeSize))
+			Expect(over).To(BeFalse())
+		})
+	})
+
+	When("the runtime code exceeds the limit", func() {
+		It("should report its size and flag it", func() {
+			size, over, gas, err := deploy.Preflight(zeroCode(deploy.MaxCodeSize + 1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(size).To(Equal(deploy.MaxCodeSize + 1))
+			Expect(over).To(BeTrue())
+			// The code deposit alone costs 200 gas per byte.
+			Expect(gas).To(BeNumerically(">", uint64(200*(deploy.MaxCodeSize+1))))
+		})
+	})
+
+	When("the constructor reverts", func() {
+		It("should fail", func() {
+			// PUSH1 0, DUP1, REVERT
+			_, _, _, err := deploy.Preflight("600080fd")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("the code is empty", func() {
+		It("should fail", func() {
+			_, _, _, err := deploy.Preflight("0x")
+			Expect(err).To(MatchError("empty creation code"))
+		})
+	})
+})
@@ -0,0 +1,144 @@
+package export_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/export"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+type recordingFilterer struct {
+	queries []ethereum.FilterQuery
+}
+
+func (r *recordingFilterer) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	r.queries = append(r.queries, q)
+	return Backend.FilterLogs(ctx, q)
+}
+
+func (r *recordingFilterer) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return Backend.SubscribeFilterLogs(ctx, q, ch)
+}
+
+func readLines(buf *bytes.Buffer) []map[string]interface{} {
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		Expect(json.Unmarshal(scanner.Bytes(), &line)).To(Succeed())
+		lines = append(lines, line)
+	}
+	Expect(scanner.Err()).ToNot(HaveOccurred())
+	return lines
+}
+
+var _ = Describe("EventsNDJSON", func() {
+
+	var start uint64
+	var transferTx *types.Transaction
+
+	BeforeEach(func() {
+		start = Backend.Blockchain().CurrentBlock().NumberU64() + 1
+
+		err := BankAccount.Transfer(Backend, WalletProxyAddress, EthToWei(1))
+		Expect(err).ToNot(HaveOccurred())
+
+		transferTx, err = WalletProxy.Transfer(Owner.TransactOpts(), RandomAccount.Address(), common.Address{}, EthToWei(1))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(transferTx)).To(BeTrue())
+
+		tx, err := WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{RandomAccount.Address(), BankAccount.Address()})
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+	})
+
+	When("exporting the wallet events", func() {
+		var lines []map[string]interface{}
+
+		BeforeEach(func() {
+			buf := new(bytes.Buffer)
+			end := Backend.Blockchain().CurrentBlock().NumberU64()
+			err := export.EventsNDJSON(context.Background(), Backend, bindings.WalletABI, []common.Address{WalletProxyAddress}, start, end, buf)
+			Expect(err).ToNot(HaveOccurred())
+			lines = readLines(buf)
+		})
+
+		It("should write one line per event in emission order", func() {
+			Expect(lines).To(HaveLen(3))
+			Expect(lines[0]).ToNot(HaveKey("event"))
+			Expect(lines[1]["event"]).To(Equal("Transferred"))
+			Expect(lines[2]["event"]).To(Equal("AddedToWhitelist"))
+		})
+
+		It("should keep the raw log of events the ABI does not define", func() {
+			Expect(lines[0]["address"]).To(Equal(WalletProxyAddress.Hex()))
+			Expect(lines[0]["topics"]).To(HaveLen(1))
+			Expect(lines[0]["data"]).ToNot(BeEmpty())
+		})
+
+		It("should include the block and transaction metadata", func() {
+			r, err := Backend.TransactionReceipt(context.Background(), transferTx.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lines[1]["blockNumber"]).To(BeNumerically("==", r.BlockNumber.Uint64()))
+			Expect(lines[1]["blockHash"]).To(Equal(r.BlockHash.Hex()))
+			Expect(lines[1]["transactionHash"]).To(Equal(transferTx.Hash().Hex()))
+			Expect(lines[1]["transactionIndex"]).To(BeNumerically("==", r.TransactionIndex))
+			Expect(lines[1]["logIndex"]).To(BeNumerically("==", r.Logs[0].Index))
+		})
+
+		It("should write the decoded arguments", func() {
+			Expect(lines[1]["args"]).To(Equal(map[string]interface{}{
+				"_to":     RandomAccount.Address().Hex(),
+				"_asset":  common.Address{}.Hex(),
+				"_amount": EthToWei(1).String(),
+			}))
+			Expect(lines[2]["args"]).To(Equal(map[string]interface{}{
+				"_sender":    Owner.Address().Hex(),
+				"_addresses": []interface{}{RandomAccount.Address().Hex(), BankAccount.Address().Hex()},
+			}))
+		})
+	})
+
+	When("exporting a range larger than a page", func() {
+		var filterer *recordingFilterer
+		var lines []map[string]interface{}
+
+		BeforeEach(func() {
+			filterer = &recordingFilterer{}
+			buf := new(bytes.Buffer)
+			err := export.EventsNDJSON(context.Background(), filterer, bindings.WalletABI, []common.Address{WalletProxyAddress}, start, start+2*export.PageSize+10, buf)
+			Expect(err).ToNot(HaveOccurred())
+			lines = readLines(buf)
+		})
+
+		It("should query the logs one page at a time", func() {
+			Expect(filterer.queries).To(HaveLen(3))
+			Expect(filterer.queries[0].FromBlock.Uint64()).To(Equal(start))
+			Expect(filterer.queries[0].ToBlock.Uint64()).To(Equal(start + export.PageSize - 1))
+			Expect(filterer.queries[1].FromBlock.Uint64()).To(Equal(start + export.PageSize))
+			Expect(filterer.queries[2].ToBlock.Uint64()).To(Equal(start + 2*export.PageSize + 10))
+		})
+
+		It("should export all the events", func() {
+			Expect(lines).To(HaveLen(3))
+		})
+	})
+
+	When("the block range is inverted", func() {
+		It("should fail", func() {
+			err := export.EventsNDJSON(context.Background(), Backend, bindings.WalletABI, nil, 10, 9, new(bytes.Buffer))
+			Expect(err).To(MatchError("invalid block range 10-9"))
+		})
+	})
+})
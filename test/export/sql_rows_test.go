@@ -0,0 +1,135 @@
+package export_test
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/export"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("SQLRows", func() {
+
+	decode := func(tx *types.Transaction) []export.DecodedEvent {
+		r, err := Backend.TransactionReceipt(context.Background(), tx.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		var events []export.DecodedEvent
+		for _, l := range r.Logs {
+			e, err := export.Decode(bindings.WalletABI, *l)
+			Expect(err).ToNot(HaveOccurred())
+			events = append(events, e)
+		}
+		return events
+	}
+
+	collect := func(rows func(func([]interface{}) bool)) [][]interface{} {
+		var all [][]interface{}
+		rows(func(row []interface{}) bool {
+			all = append(all, row)
+			return true
+		})
+		return all
+	}
+
+	When("no event is sent", func() {
+		It("should only have the metadata columns and no rows", func() {
+			events := make(chan export.DecodedEvent)
+			close(events)
+			columns, rows := export.SQLRows(events)
+			Expect(columns).To(Equal([]string{"block_number", "transaction_hash", "log_index", "event"}))
+			Expect(collect(rows)).To(BeEmpty())
+		})
+	})
+
+	When("Transferred events are sent", func() {
+		var txs []*types.Transaction
+		var columns []string
+		var rows [][]interface{}
+
+		BeforeEach(func() {
+			err := BankAccount.Transfer(Backend, WalletProxyAddress, EthToWei(3))
+			Expect(err).ToNot(HaveOccurred())
+
+			txs = nil
+			events := make(chan export.DecodedEvent, 2)
+			for _, amount := range []int{1, 2} {
+				tx, err := WalletProxy.Transfer(Owner.TransactOpts(), RandomAccount.Address(), common.Address{}, EthToWei(amount))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(IsSuccessful(tx)).To(BeTrue())
+				txs = append(txs, tx)
+				for _, e := range decode(tx) {
+					events <- e
+				}
+			}
+			close(events)
+
+			var all func(func([]interface{}) bool)
+			columns, all = export.SQLRows(events)
+			rows = collect(all)
+		})
+
+		It("should have a column per argument sorted by name", func() {
+			Expect(columns).To(Equal([]string{"block_number", "transaction_hash", "log_index", "event", "arg_amount", "arg_asset", "arg_to"}))
+		})
+
+		It("should have a row per event", func() {
+			Expect(rows).To(HaveLen(2))
+			for i, tx := range txs {
+				r, err := Backend.TransactionReceipt(context.Background(), tx.Hash())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rows[i]).To(Equal([]interface{}{
+					r.BlockNumber.Int64(),
+					tx.Hash().Hex(),
+					int64(r.Logs[0].Index),
+					"Transferred",
+					EthToWei(i + 1).String(),
+					common.Address{}.Hex(),
+					RandomAccount.Address().Hex(),
+				}))
+			}
+		})
+	})
+
+	When("an event with an array argument is sent", func() {
+		It("should render the array as a JSON array", func() {
+			tx, err := WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{RandomAccount.Address()})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+
+			events := make(chan export.DecodedEvent, 1)
+			events <- decode(tx)[0]
+			close(events)
+
+			columns, all := export.SQLRows(events)
+			Expect(columns[4:]).To(Equal([]string{"arg_addresses", "arg_sender"}))
+			rows := collect(all)
+			Expect(rows).To(HaveLen(1))
+			Expect(rows[0][4]).To(Equal(`["` + RandomAccount.Address().Hex() + `"]`))
+			Expect(rows[0][5]).To(Equal(Owner.Address().Hex()))
+		})
+	})
+
+	When("the consumer stops early", func() {
+		It("should stop reading the events", func() {
+			events := make(chan export.DecodedEvent, 2)
+			events <- export.DecodedEvent{Name: "UpdatedAvailableLimit"}
+			events <- export.DecodedEvent{Name: "UpdatedAvailableLimit"}
+			close(events)
+
+			_, all := export.SQLRows(events)
+			count := 0
+			all(func([]interface{}) bool {
+				count++
+				return false
+			})
+			Expect(count).To(Equal(1))
+			Expect(events).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,146 @@
+package feedmonitor_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/feedmonitor"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+// signProof builds a CryptoCompare style proof of the result, dated at the given HTTP date and signed with key.
+func signProof(result, date string, key *ecdsa.PrivateKey) []byte {
+	digest := sha256.Sum256([]byte(result))
+	headers := fmt.Sprintf("date: %s\ndigest: SHA-256=%s", date, base64.StdEncoding.EncodeToString(digest[:]))
+	Expect(headers).To(HaveLen(96))
+	hash := sha256.Sum256([]byte(headers))
+	sig, err := crypto.Sign(hash[:], key)
+	Expect(err).ToNot(HaveOccurred())
+
+	proof := append([]byte{0x00, byte(len(sig))}, sig...)
+	proof = append(proof, 0x00, byte(len(headers)))
+	return append(proof, headers...)
+}
+
+var _ = Describe("CheckMonotonic", func() {
+
+	tkn := common.HexToAddress("0xfe209bdE5CA32fa20E6728A005F26D651FFF5982")
+	other := common.HexToAddress("0x2")
+
+	var key *ecdsa.PrivateKey
+
+	// oracleUpdate updates the rate through the oracle, which rejects proofs that aren't newer than the last update.
+	oracleUpdate := func(result, date string) {
+		tx, err := Oracle.UpdateTokenRates(Controller.TransactOpts(ethertest.WithValue(big.NewInt(100000000))), big.NewInt(2000000))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		id := crypto.Keccak256Hash([]byte("https://min-api.cryptocompare.com/data/price?fsym=TKN&tsyms=ETH&sign=true"))
+		tx, err = Oracle.Callback(OraclizeConnectorOwner.TransactOpts(ethertest.WithGasLimit(500000)), id, result, signProof(result, date, key))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+	}
+
+	// forceUpdate sets the rate directly as the admin, which accepts any date.
+	forceUpdate := func(token common.Address, date int64) common.Hash {
+		tx, err := TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), token, FinneyToWei(1), big.NewInt(date))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+		return tx.Hash()
+	}
+
+	check := func() []feedmonitor.Anomaly {
+		anomalies, err := feedmonitor.CheckMonotonic(context.Background(), txBackend{Backend}, TokenWhitelistAddress, tkn, 0)
+		Expect(err).ToNot(HaveOccurred())
+		return anomalies
+	}
+
+	BeforeEach(func() {
+		var err error
+		key, err = crypto.GenerateKey()
+		Expect(err).ToNot(HaveOccurred())
+		tx, err := Oracle.UpdateCryptoCompareAPIPublicKey(ControllerAdmin.TransactOpts(), crypto.FromECDSAPub(&key.PublicKey)[1:])
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		tx, err = TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{tkn, other},
+			StringsToByte32("TKN", "OTH"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(18)), DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{true, true},
+			[]bool{true, true},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		oracleUpdate("{\"ETH\":0.002}", "Wed, 03 Oct 2018 17:00:22 GMT")
+		oracleUpdate("{\"ETH\":0.003}", "Wed, 03 Oct 2018 17:10:22 GMT")
+		forceUpdate(tkn, 20181003172022)
+	})
+
+	It("should not flag updates moving forward", func() {
+		Expect(check()).To(BeEmpty())
+	})
+
+	When("an update is forced with an earlier date", func() {
+		var txHash common.Hash
+
+		BeforeEach(func() {
+			txHash = forceUpdate(tkn, 20181003170500)
+			// Updates of other tokens don't count.
+			forceUpdate(other, 20181003160000)
+		})
+
+		It("should flag it as backwards", func() {
+			anomalies := check()
+			Expect(anomalies).To(HaveLen(1))
+			Expect(anomalies[0].Kind).To(Equal(feedmonitor.Backwards))
+			Expect(anomalies[0].Token).To(Equal(tkn))
+			Expect(anomalies[0].TxHash).To(Equal(txHash))
+			Expect(anomalies[0].Date).To(Equal(uint64(20181003170500)))
+			Expect(anomalies[0].PreviousDate).To(Equal(uint64(20181003172022)))
+		})
+	})
+
+	When("the date of an oracle update is written again", func() {
+		var txHash common.Hash
+
+		BeforeEach(func() {
+			txHash = forceUpdate(tkn, 20181003171022)
+		})
+
+		It("should flag it as a duplicate", func() {
+			anomalies := check()
+			Expect(anomalies).To(HaveLen(1))
+			Expect(anomalies[0].Kind).To(Equal(feedmonitor.Duplicate))
+			Expect(anomalies[0].TxHash).To(Equal(txHash))
+			Expect(anomalies[0].Date).To(Equal(uint64(20181003171022)))
+		})
+	})
+
+	When("scanning from a later block", func() {
+		It("should only consider the later updates", func() {
+			from := Backend.Blockchain().CurrentHeader().Number.Uint64() + 1
+			forceUpdate(tkn, 20181003170000)
+			anomalies, err := feedmonitor.CheckMonotonic(context.Background(), txBackend{Backend}, TokenWhitelistAddress, tkn, from)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(anomalies).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,41 @@
+package feedmonitor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+func TestFeedMonitorSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Feed Monitor Suite")
+}
+
+var _ = BeforeEach(func() {
+	err := InitializeBackend()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterEach(func() {
+	err := Backend.Close()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+// txBackend looks up mined transactions, the test backend has no TransactionByHash.
+type txBackend struct {
+	ethertest.TestBackend
+}
+
+func (b txBackend) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	r, err := b.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, false, err
+	}
+	return b.Blockchain().GetBlockByHash(r.BlockHash).Transaction(txHash), false, nil
+}
@@ -0,0 +1,129 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/inspect"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("Wallet", func() {
+
+	When("the wallet has been configured", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{RandomAccount.Address()})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+
+			tx, err = WalletProxy.SubmitWhitelistRemoval(Owner.TransactOpts(), []common.Address{RandomAccount.Address()})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should report its state", func() {
+			caller, err := bindings.NewWalletCaller(WalletProxyAddress, Backend)
+			Expect(err).ToNot(HaveOccurred())
+
+			r, err := inspect.Wallet(context.Background(), caller)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(r.Unsupported).ToNot(ContainElement("WALLET_VERSION"))
+			Expect(r.Version).To(Equal("3.3.1"))
+			Expect(r.Owner).To(Equal(Owner.Address()))
+			Expect(r.Transferable).To(BeTrue())
+			Expect(r.RelayNonce.String()).To(Equal("0"))
+			Expect(r.SpendLimit.Value.String()).To(Equal(EthToWei(100).String()))
+			Expect(r.SpendLimit.Available.String()).To(Equal(EthToWei(100).String()))
+			Expect(r.SpendLimit.ControllerConfirmationRequired).To(BeFalse())
+			Expect(r.WhitelistInitialized).To(BeTrue())
+			Expect(r.Whitelist).To(Equal([]common.Address{RandomAccount.Address()}))
+			Expect(r.PendingWhitelistAddition).To(BeEmpty())
+			Expect(r.PendingWhitelistRemoval).To(Equal([]common.Address{RandomAccount.Address()}))
+
+			s := r.String()
+			Expect(s).To(ContainSubstring("owner:               " + Owner.Address().Hex() + " (transferable: true)"))
+			Expect(s).To(ContainSubstring("whitelist:           " + RandomAccount.Address().Hex() + " (initialized: true)"))
+		})
+	})
+
+	When("the contract only supports some of the views", func() {
+		It("should list the unsupported ones", func() {
+			caller, err := bindings.NewWalletCaller(ControllerContractAddress, Backend)
+			Expect(err).ToNot(HaveOccurred())
+
+			r, err := inspect.Wallet(context.Background(), caller)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(r.Owner).ToNot(Equal(common.Address{}))
+			Expect(r.Whitelist).To(BeEmpty())
+			Expect(r.Unsupported).To(ContainElement("WALLET_VERSION"))
+			Expect(r.Unsupported).To(ContainElement("confirmThreshold"))
+			Expect(r.String()).To(ContainSubstring("unsupported:"))
+		})
+	})
+
+	When("a view reverts", func() {
+		It("should list it as unsupported and report the other views", func() {
+			wallet, err := abi.JSON(strings.NewReader(bindings.WalletABI))
+			Expect(err).ToNot(HaveOccurred())
+			caller, err := bindings.NewWalletCaller(WalletProxyAddress, failingCaller{ContractCaller: Backend, selector: wallet.Methods["relayNonce"].ID()})
+			Expect(err).ToNot(HaveOccurred())
+
+			r, err := inspect.Wallet(context.Background(), caller)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(r.Unsupported).To(ContainElement("relayNonce"))
+			Expect(r.Unsupported).ToNot(ContainElement("WALLET_VERSION"))
+			Expect(r.RelayNonce).To(BeNil())
+			Expect(r.Owner).To(Equal(Owner.Address()))
+			Expect(r.String()).To(ContainSubstring("relay nonce:         -"))
+		})
+	})
+
+	When("the node fails to read the whitelist", func() {
+		It("should fail rather than report a partial whitelist", func() {
+			wallet, err := abi.JSON(strings.NewReader(bindings.WalletABI))
+			Expect(err).ToNot(HaveOccurred())
+			nodeErr := errors.New("connection refused")
+			caller, err := bindings.NewWalletCaller(WalletProxyAddress, failingCaller{ContractCaller: Backend, selector: wallet.Methods["whitelistArray"].ID(), err: nodeErr})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = inspect.Wallet(context.Background(), caller)
+			Expect(err).To(MatchError(ContainSubstring("connection refused")))
+		})
+	})
+
+	When("the contract isn't owned", func() {
+		It("should fail", func() {
+			caller, err := bindings.NewWalletCaller(TokenWhitelistAddress, Backend)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = inspect.Wallet(context.Background(), caller)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+// failingCaller fails the calls to the given method, either by reverting or with a node error.
+type failingCaller struct {
+	bind.ContractCaller
+	selector []byte
+	err      error
+}
+
+func (c failingCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if bytes.HasPrefix(call.Data, c.selector) {
+		return nil, c.err
+	}
+	return c.ContractCaller.CallContract(ctx, call, blockNumber)
+}
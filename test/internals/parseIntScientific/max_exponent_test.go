@@ -0,0 +1,15 @@
+package parseIntScientific_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+)
+
+var _ = Describe("MaxExponent", func() {
+	It("should match parseint.MaxScientificExponent", func() {
+		max, err := ParseIntScientificExporter.MaxExponent(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(max.Uint64()).To(BeEquivalentTo(parseint.MaxScientificExponent))
+	})
+})
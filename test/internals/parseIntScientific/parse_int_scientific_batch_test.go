@@ -0,0 +1,65 @@
+package parseIntScientific_test
+
+import (
+	"math/big"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseIntScientificBatch", func() {
+
+	When("every string is valid", func() {
+		It("should parse each of them with the given decimals", func() {
+			res, err := ParseIntScientificExporter.ParseIntScientificBatch(nil, []string{"1.5", "0.002e1", "12e-1"}, big.NewInt(3))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(3))
+			Expect(res[0].String()).To(Equal("1500"))
+			Expect(res[1].String()).To(Equal("20"))
+			Expect(res[2].String()).To(Equal("1200"))
+		})
+	})
+
+	When("every string is valid and no decimals are requested", func() {
+		It("should parse each of them like parseIntScientific", func() {
+			res, err := ParseIntScientificExporter.ParseIntScientificBatch(nil, []string{"100", "1.5e2", "0.5"}, big.NewInt(0))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(HaveLen(3))
+			Expect(res[0].String()).To(Equal("100"))
+			Expect(res[1].String()).To(Equal("150"))
+			Expect(res[2].String()).To(Equal("0"))
+		})
+	})
+
+	When("no string is passed", func() {
+		It("should return an empty list", func() {
+			res, err := ParseIntScientificExporter.ParseIntScientificBatch(nil, []string{}, big.NewInt(3))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(BeEmpty())
+		})
+	})
+
+	When("a string in the middle is invalid", func() {
+		It("should revert with its index", func() {
+			_, err := ParseIntScientificExporter.ParseIntScientificBatch(nil, []string{"1", "2", "3", "1.2.3", "5"}, big.NewInt(0))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid number at index 3"))
+		})
+	})
+
+	When("the last string is invalid", func() {
+		It("should revert with its index", func() {
+			_, err := ParseIntScientificExporter.ParseIntScientificBatch(nil, []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "1e"}, big.NewInt(0))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid number at index 10"))
+		})
+	})
+
+	When("a string overflows", func() {
+		It("should revert with its index", func() {
+			_, err := ParseIntScientificExporter.ParseIntScientificBatch(nil, []string{"1", "1e78"}, big.NewInt(0))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid number at index 1"))
+		})
+	})
+})
@@ -0,0 +1,39 @@
+package parseIntScientific_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseIntScientificSignMagnitude", func() {
+
+	table.DescribeTable("should return the sign and the magnitude",
+		func(input string, negative bool, magnitude string) {
+			res, err := ParseIntScientificExporter.ParseIntScientificSignMagnitude(nil, input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Negative).To(Equal(negative))
+			Expect(res.Magnitude.String()).To(Equal(magnitude))
+		},
+		table.Entry("a positive number", "1e3", false, "1000"),
+		table.Entry("a negative number", "-1e3", true, "1000"),
+		table.Entry("a negative number with a negative exponent", "-1234e-2", true, "12"),
+		table.Entry("'0'", "0", false, "0"),
+		table.Entry("'-0'", "-0", false, "0"),
+		table.Entry("a negative number rounded to zero", "-1e-3", false, "0"),
+		table.Entry("a negative magnitude beyond the int256 range", "-"+maxUint256, true, maxUint256),
+	)
+
+	table.DescribeTable("should revert",
+		func(input string, reason string) {
+			_, err := ParseIntScientificExporter.ParseIntScientificSignMagnitude(nil, input)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(reason))
+		},
+		table.Entry("a lone '-'", "-", "missing integral part"),
+		table.Entry("'+0'", "+0", "+ sign not immediately after e"),
+		table.Entry("a duplicate leading '-'", "--1", "- sign not immediately after e"),
+		table.Entry("a '-' after the exponent", "-1e3-", "- sign not immediately after e"),
+		table.Entry("a duplicate '-' after the exponent", "-1e--3", "duplicate -"),
+	)
+})
@@ -0,0 +1,43 @@
+package parseIntScientific_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// minInt256 is -(2^255), its absolute value is the largest int256 plus one.
+const minInt256 = "-57896044618658097711785492504343953926634992332820282019728792003956564819968"
+const maxInt256 = "57896044618658097711785492504343953926634992332820282019728792003956564819967"
+
+var _ = Describe("ParseIntScientificSigned", func() {
+
+	table.DescribeTable("should parse signed numbers",
+		func(input string, expected string) {
+			res, err := ParseIntScientificExporter.ParseIntScientificSigned(nil, input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.String()).To(Equal(expected))
+		},
+		table.Entry("a positive number", "123.45e1", "1234"),
+		table.Entry("a negative number", "-123.45e1", "-1234"),
+		table.Entry("a negative number with a negative exponent", "-1234e-2", "-12"),
+		table.Entry("a negative number rounded to zero", "-1e-3", "0"),
+		table.Entry("the largest int256", maxInt256, maxInt256),
+		table.Entry("the smallest int256", minInt256, minInt256),
+	)
+
+	table.DescribeTable("should revert",
+		func(input string, reason string) {
+			_, err := ParseIntScientificExporter.ParseIntScientificSigned(nil, input)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(reason))
+		},
+		table.Entry("a lone '-'", "-", "missing integral part"),
+		table.Entry("a duplicate leading '-'", "--1", "- sign not immediately after e"),
+		table.Entry("a '-' not immediately after 'e'", "-1e3-", "- sign not immediately after e"),
+		table.Entry("a duplicate '-' after 'e'", "-1e--3", "duplicate -"),
+		table.Entry("a '-' before the integral part", "-.5", "missing integral part"),
+		table.Entry("a positive number above the largest int256", "57896044618658097711785492504343953926634992332820282019728792003956564819968", "int256 overflow"),
+		table.Entry("a negative number below the smallest int256", "-57896044618658097711785492504343953926634992332820282019728792003956564819969", "int256 overflow"),
+	)
+})
@@ -0,0 +1,55 @@
+package parseIntScientific_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+const maxUint256 = "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+
+var _ = Describe("TryParseIntScientific", func() {
+
+	table.DescribeTable("should return the parsed number when a valid encoded string is passed",
+		func(input string, expected string) {
+			res, err := ParseIntScientificExporter.TryParseIntScientific(nil, input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Ok).To(BeTrue())
+			Expect(res.Value.String()).To(Equal(expected))
+		},
+		table.Entry("an integer", "123", "123"),
+		table.Entry("a decimal number", "0.0123", "0"),
+		table.Entry("a positive exponent", "1.5e+1", "15"),
+		table.Entry("a negative exponent", "123E-3", "0"),
+		table.Entry("the largest value", maxUint256, maxUint256),
+	)
+
+	table.DescribeTable("should return false instead of reverting when an invalid string is passed",
+		func(input string) {
+			res, err := ParseIntScientificExporter.TryParseIntScientific(nil, input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Ok).To(BeFalse())
+			Expect(res.Value.String()).To(Equal("0"))
+		},
+		table.Entry("an empty exponent", "1e"),
+		table.Entry("an empty signed exponent", "1e-"),
+		table.Entry("a missing integral part before '.'", ".1"),
+		table.Entry("a missing integral part before 'e'", "e5"),
+		table.Entry("a duplicate decimal point", "1.01.23"),
+		table.Entry("a decimal point after the exponent", "1e2.5"),
+		table.Entry("a duplicate '-'", "1e--2"),
+		table.Entry("a duplicate '+'", "1e++2"),
+		table.Entry("an extra sign", "1e+-2"),
+		table.Entry("a sign not immediately after 'e'", "1e2-"),
+		table.Entry("a duplicate exponent symbol", "1e2E3"),
+		table.Entry("an invalid digit", "1.0t23"),
+		table.Entry("an exponent > 77", "1e78"),
+		table.Entry("a negative exponent > 77", "1e-78"),
+		table.Entry("more than 77 decimal digits", "0."+strings.Repeat("0", 78)),
+		table.Entry("an overflowing integral part", maxUint256+"0"),
+		table.Entry("an overflowing exponent", "1e"+maxUint256+"0"),
+		table.Entry("an integral part overflowing once shifted", maxUint256+"e1"),
+	)
+})
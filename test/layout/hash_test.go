@@ -0,0 +1,93 @@
+package layout_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/layout"
+)
+
+// storageLayout renders a solc --storage-layout output with the given storage entries.
+func storageLayout(storage string) []byte {
+	return []byte(fmt.Sprintf(`{
+	"storage": [%s],
+	"types": {
+		"t_uint256": {"encoding": "inplace", "label": "uint256", "numberOfBytes": "32"},
+		"t_bytes32": {"encoding": "inplace", "label": "bytes32", "numberOfBytes": "32"},
+		"t_address": {"encoding": "inplace", "label": "address", "numberOfBytes": "20"},
+		"t_struct(PendingTransfer)1234_storage": {
+			"encoding": "inplace",
+			"label": "struct Wallet.PendingTransfer",
+			"numberOfBytes": "64",
+			"members": [
+				{"astId": 1230, "contract": "wallet.sol:Wallet", "label": "to", "offset": 0, "slot": "0", "type": "t_address"},
+				{"astId": 1232, "contract": "wallet.sol:Wallet", "label": "amount", "offset": 0, "slot": "1", "type": "t_uint256"}
+			]
+		}
+	}
+}`, storage))
+}
+
+const (
+	relayNonce  = `{"astId": 1, "contract": "wallet.sol:Wallet", "label": "relayNonce", "offset": 0, "slot": "0", "type": "t_uint256"}`
+	licenceNode = `{"astId": 2, "contract": "wallet.sol:Wallet", "label": "_licenceNode", "offset": 0, "slot": "1", "type": "t_bytes32"}`
+	pending     = `{"astId": 3, "contract": "wallet.sol:Wallet", "label": "_pending", "offset": 0, "slot": "2", "type": "t_struct(PendingTransfer)1234_storage"}`
+)
+
+var _ = Describe("Hash", func() {
+
+	var original string
+
+	BeforeEach(func() {
+		var err error
+		original, err = layout.Hash(storageLayout(relayNonce + "," + licenceNode + "," + pending))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(original).To(HavePrefix("0x"))
+	})
+
+	It("should not depend on the order of the entries in the JSON", func() {
+		h, err := layout.Hash(storageLayout(pending + "," + licenceNode + "," + relayNonce))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(h).To(Equal(original))
+	})
+
+	It("should not depend on the compiler assigned ids", func() {
+		h, err := layout.Hash(storageLayout(`{"astId": 9, "contract": "wallet.sol:Wallet", "label": "relayNonce", "offset": 0, "slot": "0", "type": "t_uint256"},` + licenceNode + "," + pending))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(h).To(Equal(original))
+	})
+
+	When("the variables are reordered", func() {
+		It("should change", func() {
+			h, err := layout.Hash(storageLayout(
+				`{"astId": 2, "contract": "wallet.sol:Wallet", "label": "_licenceNode", "offset": 0, "slot": "0", "type": "t_bytes32"},` +
+					`{"astId": 1, "contract": "wallet.sol:Wallet", "label": "relayNonce", "offset": 0, "slot": "1", "type": "t_uint256"},` + pending))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(h).ToNot(Equal(original))
+		})
+	})
+
+	When("a variable is appended", func() {
+		It("should change", func() {
+			h, err := layout.Hash(storageLayout(relayNonce + "," + licenceNode + "," + pending +
+				`,{"astId": 4, "contract": "wallet.sol:Wallet", "label": "confirmThreshold", "offset": 0, "slot": "4", "type": "t_uint256"}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(h).ToNot(Equal(original))
+		})
+	})
+
+	When("the storage entries are missing", func() {
+		It("should fail", func() {
+			_, err := layout.Hash([]byte(`[{"type": "function", "name": "owner"}]`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("a variable has an unknown type", func() {
+		It("should fail", func() {
+			_, err := layout.Hash(storageLayout(`{"astId": 1, "contract": "wallet.sol:Wallet", "label": "x", "offset": 0, "slot": "0", "type": "t_bool"}`))
+			Expect(err).To(MatchError("unknown type t_bool of x"))
+		})
+	})
+})
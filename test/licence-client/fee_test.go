@@ -0,0 +1,48 @@
+package licenceclient_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/licence"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("Fee", func() {
+
+	DescribeTable("should match the fee taken by the deployed licence",
+		func(scaled int64, amount *big.Int) {
+			_, tx, l, err := bindings.DeployLicence(BankAccount.TransactOpts(), Backend, big.NewInt(scaled), CryptoFloatAddress, TokenHolderAddress, common.Address{}, ENSRegistryAddress, ControllerName)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+
+			tx, err = l.Load(RandomAccount.TransactOpts(ethertest.WithValue(amount)), common.Address{}, amount)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+
+			it, err := l.FilterTransferredToTokenHolder(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			Expect(licence.Fee(amount, big.NewInt(scaled)).String()).To(Equal(it.Event.Amount.String()))
+		},
+		Entry("1% of 101 ETH", int64(10), EthToWei(101)),
+		Entry("1% of a single wei", int64(10), big.NewInt(1)),
+		Entry("1% of an amount that doesn't divide evenly", int64(10), big.NewInt(1234567)),
+		Entry("the smallest fee", int64(1), FinneyToWei(1)),
+		Entry("the smallest fee of an amount that doesn't divide evenly", int64(1), big.NewInt(999999)),
+		Entry("2.5% of 3 ETH", int64(25), EthToWei(3)),
+		Entry("the largest fee", int64(1000), big.NewInt(1000001)),
+		Entry("the largest fee of an odd amount", int64(1000), big.NewInt(7)),
+	)
+
+	It("should take 1% of 101 ETH as 1 ETH", func() {
+		Expect(licence.Fee(EthToWei(101), big.NewInt(10)).String()).To(Equal(EthToWei(1).String()))
+	})
+})
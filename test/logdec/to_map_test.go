@@ -0,0 +1,59 @@
+package logdec_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/logdec"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("ToMap", func() {
+
+	var log types.Log
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(1))
+
+		tx, err := WalletProxy.Transfer(Owner.TransactOpts(), RandomAccount.Address(), common.Address{}, big.NewInt(1000))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		r, err := Backend.TransactionReceipt(context.Background(), tx.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(r.Logs).To(HaveLen(1))
+		log = *r.Logs[0]
+	})
+
+	It("should decode a Transferred event", func() {
+		name, fields, err := logdec.ToMap(bindings.WalletABI, log)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(name).To(Equal("Transferred"))
+		Expect(fields).To(HaveLen(3))
+		Expect(fields["_to"]).To(Equal(RandomAccount.Address()))
+		Expect(fields["_asset"]).To(Equal(common.Address{}))
+		Expect(fields["_amount"]).To(Equal(big.NewInt(1000)))
+	})
+
+	When("the first topic doesn't match any event", func() {
+		It("should fail", func() {
+			log.Topics = []common.Hash{common.HexToHash("0x1")}
+			_, _, err := logdec.ToMap(bindings.WalletABI, log)
+			Expect(err).To(MatchError("unknown event topic 0x0000000000000000000000000000000000000000000000000000000000000001"))
+		})
+	})
+
+	When("the log has no topics", func() {
+		It("should fail", func() {
+			log.Topics = nil
+			_, _, err := logdec.ToMap(bindings.WalletABI, log)
+			Expect(err).To(MatchError("log has no topics, anonymous events can't be decoded"))
+		})
+	})
+})
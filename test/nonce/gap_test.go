@@ -0,0 +1,113 @@
+package nonce_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/nonce"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("Gap recovery", func() {
+
+	var manager *nonce.Manager
+	var backend nonceBackend
+	var confirmed uint64
+
+	BeforeEach(func() {
+		backend = nonceBackend{Backend}
+		manager = nonce.NewManager()
+		var err error
+		confirmed, err = backend.NonceAt(context.Background(), BankAccount.Address(), nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	send := func(n uint64) *types.Transaction {
+		opts := BankAccount.TransactOpts()
+		tx, err := opts.Signer(types.HomesteadSigner{}, opts.From, types.NewTransaction(n, RandomAccount.Address(), EthToWei(1), 21000, big.NewInt(1), nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(Backend.SendTransaction(context.Background(), tx)).To(Succeed())
+		return tx
+	}
+
+	When("no nonce has been issued", func() {
+		It("should not detect a gap", func() {
+			hasGap, _, err := manager.DetectGap(context.Background(), backend, BankAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hasGap).To(BeFalse())
+		})
+	})
+
+	When("the transaction of an issued nonce is pending", func() {
+		BeforeEach(func() {
+			n, err := manager.Next(context.Background(), backend, BankAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(confirmed))
+			send(n)
+		})
+
+		It("should not detect a gap", func() {
+			hasGap, _, err := manager.DetectGap(context.Background(), backend, BankAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hasGap).To(BeFalse())
+		})
+
+		It("should issue the following nonce", func() {
+			n, err := manager.Next(context.Background(), backend, BankAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(confirmed + 1))
+		})
+	})
+
+	When("the transaction of an issued nonce has been dropped", func() {
+		BeforeEach(func() {
+			n, err := manager.Next(context.Background(), backend, BankAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(confirmed))
+		})
+
+		It("should detect the gap at the dropped nonce", func() {
+			hasGap, gapNonce, err := manager.DetectGap(context.Background(), backend, BankAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hasGap).To(BeTrue())
+			Expect(gapNonce).To(Equal(confirmed))
+		})
+
+		When("the gap is filled", func() {
+			var fill *types.Transaction
+
+			BeforeEach(func() {
+				_, gapNonce, err := manager.DetectGap(context.Background(), backend, BankAccount.Address())
+				Expect(err).ToNot(HaveOccurred())
+				fill, err = manager.FillGap(context.Background(), backend, BankAccount.TransactOpts(), gapNonce)
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(IsSuccessful(fill)).To(BeTrue())
+			})
+
+			It("should send nothing to the sender itself at the dropped nonce", func() {
+				Expect(fill.Nonce()).To(Equal(confirmed))
+				Expect(*fill.To()).To(Equal(BankAccount.Address()))
+				Expect(fill.Value().Sign()).To(BeZero())
+			})
+
+			It("should not detect a gap anymore", func() {
+				hasGap, _, err := manager.DetectGap(context.Background(), backend, BankAccount.Address())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(hasGap).To(BeFalse())
+			})
+
+			It("should let the following nonce be mined", func() {
+				n, err := manager.Next(context.Background(), backend, BankAccount.Address())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(n).To(Equal(confirmed + 1))
+				tx := send(n)
+				Backend.Commit()
+				Expect(IsSuccessful(tx)).To(BeTrue())
+			})
+		})
+	})
+})
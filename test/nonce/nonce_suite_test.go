@@ -0,0 +1,41 @@
+package nonce_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+func TestNonceSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Nonce Suite")
+}
+
+var _ = BeforeEach(func() {
+	err := InitializeBackend()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterEach(func() {
+	err := Backend.Close()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+// nonceBackend adds the confirmed nonce lookup to the simulated backend.
+type nonceBackend struct {
+	ethertest.TestBackend
+}
+
+func (n nonceBackend) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	st, err := n.Blockchain().State()
+	if err != nil {
+		return 0, err
+	}
+	return st.GetNonce(account), nil
+}
@@ -0,0 +1,207 @@
+package notify_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/notify"
+	"github.com/tokencard/contracts/v3/pkg/wallet"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+// droppedSub is a log subscription the test can fail, as if the connection to the node was lost.
+type droppedSub struct {
+	ethereum.Subscription
+	err chan error
+}
+
+func (s *droppedSub) Err() <-chan error {
+	return s.err
+}
+
+// flakyBackend adds the header access missing from the simulated backend, and lets the test go offline.
+type flakyBackend struct {
+	ethertest.TestBackend
+
+	mu         sync.Mutex
+	offline    bool
+	subs       []*droppedSub
+	subscribed chan struct{}
+}
+
+func (f *flakyBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return f.Blockchain().CurrentHeader(), nil
+}
+
+func (f *flakyBackend) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.offline {
+		return nil, errors.New("offline")
+	}
+	sub, err := f.TestBackend.SubscribeFilterLogs(ctx, q, ch)
+	if err != nil {
+		return nil, err
+	}
+	d := &droppedSub{Subscription: sub, err: make(chan error, 1)}
+	f.subs = append(f.subs, d)
+	f.subscribed <- struct{}{}
+	return d, nil
+}
+
+// disconnect fails the current subscription and any new one until reconnect is called.
+func (f *flakyBackend) disconnect() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.offline = true
+	f.subs[len(f.subs)-1].err <- errors.New("connection lost")
+}
+
+func (f *flakyBackend) reconnect() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.offline = false
+}
+
+var _ = Describe("WatchLimitChanges", func() {
+
+	var backend *flakyBackend
+	var changes chan notify.LimitChange
+	var cancel context.CancelFunc
+	var done chan error
+
+	var first, second *wallet.Client
+	var firstAddress, secondAddress common.Address
+
+	// changeLimit has the owner submit a limit update and the controller confirm it.
+	changeLimit := func(w *wallet.Client, amount *big.Int) *types.Transaction {
+		tx, err := w.SubmitSpendLimitUpdate(Owner.TransactOpts(), amount)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		tx, err = w.ConfirmSpendLimitUpdate(Controller.TransactOpts(), amount)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+		return tx
+	}
+
+	BeforeEach(func() {
+		var err error
+		first, firstAddress, err = NewFundedWallet(Owner.TransactOpts(), big.NewInt(0))
+		Expect(err).ToNot(HaveOccurred())
+		second, secondAddress, err = NewFundedWallet(Owner.TransactOpts(), big.NewInt(0))
+		Expect(err).ToNot(HaveOccurred())
+
+		// Set the initial limits, so that later changes need the controller's confirmation.
+		for _, w := range []*wallet.Client{first, second} {
+			tx, err := w.SetSpendLimit(Owner.TransactOpts(), EthToWei(10))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		}
+
+		notify.ResubscribeDelay = 10 * time.Millisecond
+		backend = &flakyBackend{TestBackend: Backend, subscribed: make(chan struct{}, 10)}
+		changes = make(chan notify.LimitChange, 10)
+		done = make(chan error, 1)
+
+		walletABI, err := abi.JSON(strings.NewReader(bindings.WalletABI))
+		Expect(err).ToNot(HaveOccurred())
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		go func() {
+			done <- notify.WatchLimitChanges(ctx, backend, walletABI, []common.Address{firstAddress, secondAddress}, func(c notify.LimitChange) {
+				changes <- c
+			})
+		}()
+		Eventually(backend.subscribed).Should(Receive())
+	})
+
+	AfterEach(func() {
+		cancel()
+		var err error
+		Eventually(done).Should(Receive(&err))
+		Expect(errors.Cause(err)).To(Equal(context.Canceled))
+	})
+
+	It("should not report the changes made before the watch started", func() {
+		Consistently(changes).ShouldNot(Receive())
+	})
+
+	When("the controller confirms a limit change", func() {
+
+		var tx *types.Transaction
+
+		BeforeEach(func() {
+			tx = changeLimit(first, EthToWei(20))
+		})
+
+		It("should call the handler with the old and new limits", func() {
+			var c notify.LimitChange
+			Eventually(changes).Should(Receive(&c))
+			Expect(c.Wallet).To(Equal(firstAddress))
+			Expect(c.Old.String()).To(Equal(EthToWei(10).String()))
+			Expect(c.New.String()).To(Equal(EthToWei(20).String()))
+			Expect(c.TxHash).To(Equal(tx.Hash()))
+			Consistently(changes).ShouldNot(Receive())
+		})
+
+		When("the limits of both wallets change again", func() {
+			BeforeEach(func() {
+				Eventually(changes).Should(Receive())
+				changeLimit(second, EthToWei(5))
+				changeLimit(first, EthToWei(30))
+			})
+
+			It("should track the limits of each wallet", func() {
+				var c notify.LimitChange
+				Eventually(changes).Should(Receive(&c))
+				Expect(c.Wallet).To(Equal(secondAddress))
+				Expect(c.Old.String()).To(Equal(EthToWei(10).String()))
+				Expect(c.New.String()).To(Equal(EthToWei(5).String()))
+
+				Eventually(changes).Should(Receive(&c))
+				Expect(c.Wallet).To(Equal(firstAddress))
+				Expect(c.Old.String()).To(Equal(EthToWei(20).String()))
+				Expect(c.New.String()).To(Equal(EthToWei(30).String()))
+			})
+		})
+	})
+
+	When("the limit changes while the subscription is down", func() {
+
+		var tx *types.Transaction
+
+		BeforeEach(func() {
+			backend.disconnect()
+			tx = changeLimit(second, EthToWei(50))
+			backend.reconnect()
+			Eventually(backend.subscribed).Should(Receive())
+		})
+
+		It("should resubscribe and call the handler once", func() {
+			var c notify.LimitChange
+			Eventually(changes).Should(Receive(&c))
+			Expect(c.Wallet).To(Equal(secondAddress))
+			Expect(c.Old.String()).To(Equal(EthToWei(10).String()))
+			Expect(c.New.String()).To(Equal(EthToWei(50).String()))
+			Expect(c.TxHash).To(Equal(tx.Hash()))
+			Consistently(changes).ShouldNot(Receive())
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package oracleclient_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/oracle"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("Convert", func() {
+
+	var tkn = common.HexToAddress("0x1")
+	var usd = common.HexToAddress("0x2")
+	var zrx = common.HexToAddress("0x3")
+
+	BeforeEach(func() {
+		tx, err := TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{tkn, usd, zrx},
+			StringsToByte32("TKN", "USD", "ZRX"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(8)), DecimalsToMagnitude(big.NewInt(6)), DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{true, true, true},
+			[]bool{true, false, false},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		// 1 TKN = 0.001 ETH and 1 USD = 0.005 ETH, ZRX has no rate.
+		for token, rate := range map[common.Address]*big.Int{tkn: FinneyToWei(1), usd: FinneyToWei(5)} {
+			tx, err := TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), token, rate, big.NewInt(20180913153211))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		}
+	})
+
+	It("should convert 10 TKN to 2 USD", func() {
+		amount, err := oracle.Convert(context.Background(), TokenWhitelist, tkn, usd, big.NewInt(1000000000))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(amount.String()).To(Equal("2000000"))
+	})
+
+	It("should convert 2 USD to 10 TKN", func() {
+		amount, err := oracle.Convert(context.Background(), TokenWhitelist, usd, tkn, big.NewInt(2000000))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(amount.String()).To(Equal("1000000000"))
+	})
+
+	It("should convert 10 TKN to 0.01 ETH", func() {
+		amount, err := oracle.Convert(context.Background(), TokenWhitelist, tkn, common.Address{}, big.NewInt(1000000000))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(amount.String()).To(Equal(FinneyToWei(10).String()))
+	})
+
+	It("should convert 1 ETH to 200 USD", func() {
+		amount, err := oracle.Convert(context.Background(), TokenWhitelist, common.Address{}, usd, EthToWei(1))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(amount.String()).To(Equal("200000000"))
+	})
+
+	When("a token has no rate", func() {
+		It("should fail with ErrNoRate", func() {
+			_, err := oracle.Convert(context.Background(), TokenWhitelist, zrx, usd, big.NewInt(1))
+			Expect(errors.Cause(err)).To(Equal(oracle.ErrNoRate))
+		})
+	})
+
+	When("a token isn't whitelisted", func() {
+		It("should fail", func() {
+			_, err := oracle.Convert(context.Background(), TokenWhitelist, tkn, common.HexToAddress("0x4"), big.NewInt(1))
+			Expect(err).To(MatchError("token 0x0000000000000000000000000000000000000004 is not available"))
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package oracleclient_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+func TestOracleClientSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Oracle Client Suite")
+}
+
+var _ = BeforeEach(func() {
+	err := InitializeBackend()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterEach(func() {
+	err := Backend.Close()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+// headerReader reads the latest header of the test chain.
+type headerReader struct {
+	ethertest.TestBackend
+}
+
+func (h headerReader) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return h.Blockchain().CurrentHeader(), nil
+}
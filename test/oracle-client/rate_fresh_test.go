@@ -0,0 +1,78 @@
+package oracleclient_test
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/oracle"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("RateFresh", func() {
+
+	var token = common.HexToAddress("0x1")
+	var updated time.Time
+
+	BeforeEach(func() {
+		updated = time.Unix(int64(Backend.Blockchain().CurrentBlock().Time()), 0).Add(-10 * time.Minute).UTC()
+		date, ok := new(big.Int).SetString(updated.Format("20060102150405"), 10)
+		Expect(ok).To(BeTrue())
+
+		tx, err := TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{token, common.HexToAddress("0x2")},
+			StringsToByte32("BNT", "ZRX"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(18)), DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{false, false},
+			[]bool{false, false},
+			big.NewInt(0),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		tx, err = TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), token, big.NewInt(1000), date)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+	})
+
+	It("should be fresh within the max age", func() {
+		fresh, at, err := oracle.RateFresh(context.Background(), TokenWhitelist, headerReader{Backend}, token, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fresh).To(BeTrue())
+		Expect(at.Unix()).To(Equal(updated.Unix()))
+	})
+
+	When("the max age has passed", func() {
+		BeforeEach(func() {
+			Backend.AdjustTime(time.Hour)
+			Backend.Commit()
+		})
+
+		It("should be stale", func() {
+			fresh, at, err := oracle.RateFresh(context.Background(), TokenWhitelist, headerReader{Backend}, token, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fresh).To(BeFalse())
+			Expect(at.Unix()).To(Equal(updated.Unix()))
+		})
+	})
+
+	When("the rate has no update time", func() {
+		It("should fail with ErrNoUpdateTime", func() {
+			_, _, err := oracle.RateFresh(context.Background(), TokenWhitelist, headerReader{Backend}, common.HexToAddress("0x2"), time.Hour)
+			Expect(err).To(Equal(oracle.ErrNoUpdateTime))
+		})
+	})
+
+	When("the token isn't whitelisted", func() {
+		It("should fail", func() {
+			_, _, err := oracle.RateFresh(context.Background(), TokenWhitelist, headerReader{Backend}, common.HexToAddress("0x3"), time.Hour)
+			Expect(err).To(MatchError("token 0x0000000000000000000000000000000000000003 is not available"))
+		})
+	})
+})
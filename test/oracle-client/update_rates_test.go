@@ -0,0 +1,92 @@
+package oracleclient_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/oracle"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("ValidateRates", func() {
+
+	It("should return an error for each invalid rate only", func() {
+		errs := oracle.ValidateRates([]string{"0.001", "", "1.2.3", "1.5e-3", "0", "12a"})
+		Expect(errs).To(HaveLen(6))
+		Expect(errs[0]).ToNot(HaveOccurred())
+		Expect(errs[1]).To(Equal(parseint.ErrEmpty))
+		Expect(errs[2]).To(MatchError("only one decimal point is allowed"))
+		Expect(errs[3]).ToNot(HaveOccurred())
+		Expect(errs[4]).To(Equal(oracle.ErrZeroRate))
+		Expect(errs[5]).To(MatchError("only digits, a decimal point and an exponent (e.g. 1.5e3) are allowed"))
+	})
+})
+
+var _ = Describe("UpdateRates", func() {
+
+	var tkn = common.HexToAddress("0x1")
+	var usd = common.HexToAddress("0x2")
+	var updatedAt = time.Date(2018, 9, 13, 15, 32, 11, 0, time.UTC)
+
+	BeforeEach(func() {
+		tx, err := TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{tkn, usd},
+			StringsToByte32("TKN", "USD"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(8)), DecimalsToMagnitude(big.NewInt(6))},
+			[]bool{true, true},
+			[]bool{true, false},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+	})
+
+	When("all of the rates are valid", func() {
+		BeforeEach(func() {
+			txs, err := oracle.UpdateRates(ControllerAdmin.TransactOpts(), TokenWhitelist, []common.Address{tkn, usd}, []string{"0.001", "5e-3"}, updatedAt)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(txs).To(HaveLen(2))
+			Backend.Commit()
+			for _, tx := range txs {
+				Expect(IsSuccessful(tx)).To(BeTrue())
+			}
+		})
+
+		It("should update the rates in wei", func() {
+			_, _, rate, _, _, _, lastUpdate, err := TokenWhitelist.GetTokenInfo(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rate.String()).To(Equal(FinneyToWei(1).String()))
+			Expect(lastUpdate.String()).To(Equal("20180913153211"))
+
+			_, _, rate, _, _, _, _, err = TokenWhitelist.GetTokenInfo(nil, usd)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rate.String()).To(Equal(FinneyToWei(5).String()))
+		})
+	})
+
+	When("one of the rates is invalid", func() {
+		It("should not update any rate", func() {
+			txs, err := oracle.UpdateRates(ControllerAdmin.TransactOpts(), TokenWhitelist, []common.Address{tkn, usd}, []string{"0.001", "5e-"}, updatedAt)
+			Expect(err).To(MatchError(`invalid rate "5e-" of token ` + usd.Hex() + ": the exponent is missing after e"))
+			Expect(txs).To(BeEmpty())
+			Backend.Commit()
+
+			_, _, rate, _, _, _, _, err := TokenWhitelist.GetTokenInfo(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rate.Sign()).To(BeZero())
+		})
+	})
+
+	When("the number of rates doesn't match the tokens", func() {
+		It("should fail", func() {
+			_, err := oracle.UpdateRates(ControllerAdmin.TransactOpts(), TokenWhitelist, []common.Address{tkn, usd}, []string{"0.001"}, updatedAt)
+			Expect(err).To(MatchError("got 1 rates for 2 tokens"))
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package oracleclient_test
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/oracle"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+)
+
+var _ = Describe("ValidateRateCSV", func() {
+
+	const csv = `token,rate
+0x0000000000000000000000000000000000000001,0.001
+0x0000000000000000000000000000000000000002, 1.5e-3
+
+0x0000000000000000000000000000000000000003,2.50000
+0x0000000000000000000000000000000000000004,1.2.3
+0x0000000000000000000000000000000000000005,
+0x0000000000000000000000000000000000000006,0
+0x12,0.1
+0x0000000000000000000000000000000000000001,0.002
+0x0000000000000000000000000000000000000007
+0x0000000000000000000000000000000000000008,0.0000000000000012345
+`
+
+	var report oracle.Report
+
+	BeforeEach(func() {
+		var err error
+		report, err = oracle.ValidateRateCSV(strings.NewReader(csv))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should report every row but the header and the blank lines", func() {
+		Expect(report.Rows).To(HaveLen(10))
+		var lines []int
+		for _, row := range report.Rows {
+			lines = append(lines, row.Line)
+		}
+		Expect(lines).To(Equal([]int{2, 3, 5, 6, 7, 8, 9, 10, 11, 12}))
+		Expect(report.Valid()).To(BeFalse())
+	})
+
+	It("should canonicalize the valid rates", func() {
+		Expect(report.Rows[0].Err).ToNot(HaveOccurred())
+		Expect(report.Rows[0].Token).To(Equal(common.HexToAddress("0x1")))
+		Expect(report.Rows[0].Canonical).To(Equal("0.001"))
+
+		Expect(report.Rows[1].Err).ToNot(HaveOccurred())
+		Expect(report.Rows[1].Raw).To(Equal("1.5e-3"))
+		Expect(report.Rows[1].Canonical).To(Equal("0.0015"))
+
+		Expect(report.Rows[2].Err).ToNot(HaveOccurred())
+		Expect(report.Rows[2].Raw).To(Equal("2.50000"))
+		Expect(report.Rows[2].Canonical).To(Equal("2.5"))
+	})
+
+	It("should truncate the precision the oracle doesn't store", func() {
+		Expect(report.Rows[9].Err).ToNot(HaveOccurred())
+		Expect(report.Rows[9].Canonical).To(Equal("0.000000000000001234"))
+	})
+
+	It("should report the invalid rates", func() {
+		Expect(report.Rows[3].Err).To(MatchError("only one decimal point is allowed"))
+		Expect(report.Rows[3].Canonical).To(BeEmpty())
+		Expect(report.Rows[4].Err).To(Equal(parseint.ErrEmpty))
+		Expect(report.Rows[5].Err).To(Equal(oracle.ErrZeroRate))
+	})
+
+	It("should report the invalid rows", func() {
+		Expect(report.Rows[6].Err).To(MatchError(`invalid token address "0x12"`))
+		Expect(report.Rows[7].Err).To(MatchError("duplicate token, its rate is already set on line 2"))
+		Expect(report.Rows[8].Err).To(MatchError("expected a token and a rate, got 1 fields"))
+	})
+
+	When("all of the rows are valid", func() {
+		It("should be valid", func() {
+			report, err := oracle.ValidateRateCSV(strings.NewReader("0x0000000000000000000000000000000000000001,1e-2\n"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Valid()).To(BeTrue())
+			Expect(report.Rows).To(HaveLen(1))
+			Expect(report.Rows[0].Canonical).To(Equal("0.01"))
+		})
+	})
+})
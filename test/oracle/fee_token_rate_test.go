@@ -0,0 +1,106 @@
+package oracle_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("feeTokenRateIn", func() {
+
+	var tkn = common.HexToAddress("0x1")
+	var usd = common.HexToAddress("0x2")
+	var dai = common.HexToAddress("0x3")
+
+	BeforeEach(func() {
+		tx, err := TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{tkn, usd, dai},
+			StringsToByte32("TKN", "USD", "DAI"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(8)), DecimalsToMagnitude(big.NewInt(6)), DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{true, true, true},
+			[]bool{true, false, false},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		// 1 TKN = 0.001 ETH, 1 USD = 0.005 ETH and 1 DAI = 2 ETH.
+		rates := map[common.Address]*big.Int{
+			tkn: FinneyToWei(1),
+			usd: FinneyToWei(5),
+			dai: EthToWei(2),
+		}
+		for token, rate := range rates {
+			tx, err := TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), token, rate, big.NewInt(20180913153211))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		}
+	})
+
+	When("the fee token is not set", func() {
+		It("should fail", func() {
+			_, err := Oracle.FeeTokenRateIn(nil, usd)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("a random account sets the fee token", func() {
+		It("should fail", func() {
+			tx, err := Oracle.SetFeeToken(RandomAccount.TransactOpts(ethertest.WithGasLimit(100000)), tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the admin sets the fee token to TKN", func() {
+		BeforeEach(func() {
+			tx, err := Oracle.SetFeeToken(ControllerAdmin.TransactOpts(), tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SetFeeToken event", func() {
+			it, err := Oracle.FilterSetFeeToken(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(ControllerAdmin.Address()))
+			Expect(evt.FeeToken).To(Equal(tkn))
+		})
+
+		It("should quote 1 TKN in ETH", func() {
+			rate, err := Oracle.FeeTokenRateIn(nil, common.HexToAddress("0x0"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rate.String()).To(Equal(FinneyToWei(1).String()))
+		})
+
+		It("should quote 1 TKN as 0.2 USD", func() {
+			rate, err := Oracle.FeeTokenRateIn(nil, usd)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rate.String()).To(Equal("200000"))
+		})
+
+		It("should quote 1 TKN as 0.0005 DAI", func() {
+			rate, err := Oracle.FeeTokenRateIn(nil, dai)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rate.String()).To(Equal("500000000000000"))
+		})
+
+		When("the quote token is not whitelisted", func() {
+			It("should fail", func() {
+				_, err := Oracle.FeeTokenRateIn(nil, common.HexToAddress("0x4"))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,102 @@
+package oracle_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("initialize", func() {
+
+	var tkn = common.HexToAddress("0x1")
+	var feeToken = common.HexToAddress("0x2")
+	var adapter common.Address
+
+	BeforeEach(func() {
+		var tx *types.Transaction
+		var err error
+		adapter, tx, _, err = mocks.DeployRateAdapter(BankAccount.TransactOpts(), Backend, FinneyToWei(3), big.NewInt(1600000000))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	When("a random account tries to initialize the oracle", func() {
+		It("should fail", func() {
+			tx, err := Oracle.Initialize(RandomAccount.TransactOpts(ethertest.WithGasLimit(200000)), []common.Address{tkn}, []common.Address{adapter}, big.NewInt(3600), feeToken)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the parameter lengths do not match", func() {
+		It("should fail", func() {
+			tx, err := Oracle.Initialize(ControllerAdmin.TransactOpts(ethertest.WithGasLimit(200000)), []common.Address{tkn}, nil, big.NewInt(3600), feeToken)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the admin initializes the oracle", func() {
+		BeforeEach(func() {
+			tx, err := Oracle.Initialize(ControllerAdmin.TransactOpts(), []common.Address{tkn}, []common.Address{adapter}, big.NewInt(3600), feeToken)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should be initialized", func() {
+			initialized, err := Oracle.Initialized(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(initialized).To(BeTrue())
+		})
+
+		It("should register the adapters", func() {
+			a, err := Oracle.RateAdapter(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(a).To(Equal(adapter))
+		})
+
+		It("should set the max rate age and the fee token", func() {
+			age, err := Oracle.MaxRateAge(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(age.String()).To(Equal("3600"))
+
+			ft, err := Oracle.FeeToken(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ft).To(Equal(feeToken))
+		})
+
+		It("should emit a SetAdapter event", func() {
+			it, err := Oracle.FilterSetAdapter(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(ControllerAdmin.Address()))
+			Expect(evt.Token).To(Equal(tkn))
+			Expect(evt.Adapter).To(Equal(adapter))
+		})
+
+		When("the admin initializes the oracle again", func() {
+			It("should fail", func() {
+				tx, err := Oracle.Initialize(ControllerAdmin.TransactOpts(ethertest.WithGasLimit(200000)), nil, nil, big.NewInt(0), common.Address{})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeFalse())
+
+				age, err := Oracle.MaxRateAge(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(age.String()).To(Equal("3600"))
+			})
+		})
+	})
+})
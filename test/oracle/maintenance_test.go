@@ -0,0 +1,114 @@
+package oracle_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("maintenance", func() {
+
+	var tkn = common.HexToAddress("0x1")
+
+	BeforeEach(func() {
+		tx, err := TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{tkn},
+			StringsToByte32("TKN"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(8))},
+			[]bool{true},
+			[]bool{true},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), tkn, FinneyToWei(1), big.NewInt(20180913153211))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	It("should not be under maintenance by default", func() {
+		rate, maintenance, err := Oracle.TokenRate(nil, tkn)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rate.String()).To(Equal(FinneyToWei(1).String()))
+		Expect(maintenance).To(BeFalse())
+	})
+
+	When("a random account sets the maintenance", func() {
+		It("should fail", func() {
+			tx, err := Oracle.SetMaintenance(RandomAccount.TransactOpts(ethertest.WithGasLimit(100000)), true)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the controller sets the maintenance", func() {
+		BeforeEach(func() {
+			tx, err := Oracle.SetMaintenance(Controller.TransactOpts(), true)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SetMaintenance event", func() {
+			it, err := Oracle.FilterSetMaintenance(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Controller.Address()))
+			Expect(evt.Maintenance).To(BeTrue())
+		})
+
+		It("should keep serving the last rate with the maintenance flag", func() {
+			rate, maintenance, err := Oracle.TokenRate(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rate.String()).To(Equal(FinneyToWei(1).String()))
+			Expect(maintenance).To(BeTrue())
+		})
+
+		It("should block the rate updates", func() {
+			tx, err := Oracle.UpdateTokenRates(Controller.TransactOpts(ethertest.WithValue(big.NewInt(100000000)), ethertest.WithGasLimit(gasLimit)), big.NewInt(gasLimit))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+
+		It("should block the rate updates of a list of tokens", func() {
+			tx, err := Oracle.UpdateTokenRatesList(Controller.TransactOpts(ethertest.WithValue(big.NewInt(100000000)), ethertest.WithGasLimit(gasLimit)), big.NewInt(gasLimit), []common.Address{tkn})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+
+		When("the controller ends the maintenance", func() {
+			BeforeEach(func() {
+				tx, err := Oracle.SetMaintenance(Controller.TransactOpts(), false)
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should unflag the reads", func() {
+				_, maintenance, err := Oracle.TokenRate(nil, tkn)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(maintenance).To(BeFalse())
+			})
+
+			It("should allow the rate updates", func() {
+				tx, err := Oracle.UpdateTokenRates(Controller.TransactOpts(ethertest.WithValue(big.NewInt(100000000))), big.NewInt(gasLimit))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+		})
+	})
+})
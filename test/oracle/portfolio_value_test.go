@@ -0,0 +1,101 @@
+package oracle_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("portfolioValue", func() {
+
+	var wallet = common.HexToAddress("0x1234")
+
+	BeforeEach(func() {
+		tx, err := TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{ERC20Contract1Address, ERC20Contract2Address},
+			StringsToByte32("ERC1", "ERC2"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(18)), DecimalsToMagnitude(big.NewInt(8))},
+			[]bool{true, true},
+			[]bool{false, false},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		// 1 ERC1 = 2 ETH and 1 ERC2 = 0.001 ETH.
+		rates := map[common.Address]*big.Int{
+			ERC20Contract1Address: EthToWei(2),
+			ERC20Contract2Address: FinneyToWei(1),
+		}
+		for token, rate := range rates {
+			tx, err := TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), token, rate, big.NewInt(20180913153211))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		}
+
+		err = BankAccount.Transfer(Backend, wallet, EthToWei(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	When("the wallet only holds ether", func() {
+		It("should return its ether balance", func() {
+			value, err := Oracle.PortfolioValue(nil, wallet, []common.Address{ERC20Contract1Address, ERC20Contract2Address})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal(EthToWei(1).String()))
+		})
+	})
+
+	When("the wallet holds tokens", func() {
+		BeforeEach(func() {
+			// 3 ERC1 and 5 ERC2.
+			tx, err := ERC20Contract1.Credit(BankAccount.TransactOpts(), wallet, EthToWei(3))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+
+			tx, err = ERC20Contract2.Credit(BankAccount.TransactOpts(), wallet, big.NewInt(500000000))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should add the value of each token to the ether balance", func() {
+			value, err := Oracle.PortfolioValue(nil, wallet, []common.Address{ERC20Contract1Address, ERC20Contract2Address})
+			Expect(err).ToNot(HaveOccurred())
+			// 1 ETH + 3 * 2 ETH + 5 * 0.001 ETH
+			Expect(value.String()).To(Equal(FinneyToWei(7005).String()))
+		})
+
+		It("should only include the given tokens", func() {
+			value, err := Oracle.PortfolioValue(nil, wallet, []common.Address{ERC20Contract2Address})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal(FinneyToWei(1005).String()))
+		})
+
+		It("should skip the tokens it doesn't hold", func() {
+			value, err := Oracle.PortfolioValue(nil, wallet, []common.Address{ERC20Contract1Address, RandomTokenAddress})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value.String()).To(Equal(FinneyToWei(7000).String()))
+		})
+	})
+
+	When("the wallet holds a token that isn't whitelisted", func() {
+		BeforeEach(func() {
+			tx, err := RandomToken.Credit(BankAccount.TransactOpts(), wallet, big.NewInt(1000))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should fail", func() {
+			_, err := Oracle.PortfolioValue(nil, wallet, []common.Address{RandomTokenAddress})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
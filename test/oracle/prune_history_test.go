@@ -0,0 +1,147 @@
+package oracle_test
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("pruneHistory", func() {
+
+	tkn := common.HexToAddress("0xfe209bdE5CA32fa20E6728A005F26D651FFF5982")
+
+	var id [32]byte
+	var key *ecdsa.PrivateKey
+
+	update := func(result, date string) {
+		tx, err := Oracle.UpdateTokenRates(Controller.TransactOpts(ethertest.WithValue(big.NewInt(100000000))), big.NewInt(gasLimit))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = Oracle.Callback(OraclizeConnectorOwner.TransactOpts(ethertest.WithGasLimit(500000)), id, result, signProof(result, date, key))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	}
+
+	prune := func(keepLast int64) bool {
+		tx, err := Oracle.PruneHistory(Controller.TransactOpts(ethertest.WithGasLimit(300000)), tkn, big.NewInt(keepLast))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		return isSuccessful(tx)
+	}
+
+	BeforeEach(func() {
+		id = stringToQueryID("https://min-api.cryptocompare.com/data/price?fsym=TKN&tsyms=ETH&sign=true")
+
+		var err error
+		key, err = crypto.GenerateKey()
+		Expect(err).ToNot(HaveOccurred())
+		tx, err := Oracle.UpdateCryptoCompareAPIPublicKey(ControllerAdmin.TransactOpts(), crypto.FromECDSAPub(&key.PublicKey)[1:])
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{tkn},
+			StringsToByte32("TKN"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{true},
+			[]bool{true},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		update("{\"ETH\":0.002}", "Wed, 03 Oct 2018 17:00:22 GMT")
+		update("{\"ETH\":0.005}", "Wed, 03 Oct 2018 17:10:22 GMT")
+		update("{\"ETH\":0.001}", "Wed, 03 Oct 2018 17:20:22 GMT")
+		update("{\"ETH\":0.004}", "Wed, 03 Oct 2018 17:30:22 GMT")
+	})
+
+	When("a random account tries to prune the history", func() {
+		It("should fail", func() {
+			tx, err := Oracle.PruneHistory(RandomAccount.TransactOpts(ethertest.WithGasLimit(300000)), tkn, big.NewInt(2))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the controller tries to keep as many rates as there are", func() {
+		It("should fail", func() {
+			Expect(prune(4)).To(BeFalse())
+		})
+	})
+
+	When("the controller keeps the last 2 rates", func() {
+		BeforeEach(func() {
+			Expect(prune(2)).To(BeTrue())
+		})
+
+		It("should emit a PrunedHistory event", func() {
+			it, err := Oracle.FilterPrunedHistory(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Controller.Address()))
+			Expect(evt.Token).To(Equal(tkn))
+			Expect(evt.KeepLast.String()).To(Equal("2"))
+		})
+
+		It("should only keep the most recent rates", func() {
+			stats, err := Oracle.RateStats(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stats.Min.String()).To(Equal(FinneyToWei(1).String()))
+			Expect(stats.Max.String()).To(Equal(FinneyToWei(4).String()))
+			Expect(stats.Avg.String()).To(Equal("2500000000000000"))
+		})
+
+		It("should record the next rate after the kept ones", func() {
+			update("{\"ETH\":0.006}", "Wed, 03 Oct 2018 17:40:22 GMT")
+			// (1*1 + 4*2 + 6*3) / (1+2+3) = 4.5 finney
+			avg, err := Oracle.RateRecencyWeighted(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(avg.String()).To(Equal("4500000000000000"))
+		})
+	})
+
+	When("the history has wrapped around", func() {
+		BeforeEach(func() {
+			for i := 0; i < 6; i++ {
+				update(fmt.Sprintf("{\"ETH\":0.00%d}", i+3), fmt.Sprintf("Wed, 03 Oct 2018 18:%02d:22 GMT", i))
+			}
+			Expect(prune(3)).To(BeTrue())
+		})
+
+		It("should only keep the most recent rates", func() {
+			stats, err := Oracle.RateStats(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stats.Min.String()).To(Equal(FinneyToWei(6).String()))
+			Expect(stats.Max.String()).To(Equal(FinneyToWei(8).String()))
+			Expect(stats.Avg.String()).To(Equal(FinneyToWei(7).String()))
+		})
+	})
+
+	When("the controller drops every rate", func() {
+		BeforeEach(func() {
+			Expect(prune(0)).To(BeTrue())
+		})
+
+		It("should have no rate history", func() {
+			_, err := Oracle.RateStats(nil, tkn)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package oracle_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("tokenRateFromAdapter", func() {
+
+	var tkn = common.HexToAddress("0x1")
+	var adapter common.Address
+
+	BeforeEach(func() {
+		var tx *types.Transaction
+		var err error
+		adapter, tx, _, err = mocks.DeployRateAdapter(BankAccount.TransactOpts(), Backend, FinneyToWei(3), big.NewInt(1600000000))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	When("the token has no adapter", func() {
+		It("should fail", func() {
+			_, _, err := Oracle.TokenRateFromAdapter(nil, tkn)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("a random account tries to set an adapter", func() {
+		It("should fail", func() {
+			tx, err := Oracle.SetAdapter(RandomAccount.TransactOpts(ethertest.WithGasLimit(100000)), tkn, adapter)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the admin sets the token's adapter", func() {
+		BeforeEach(func() {
+			tx, err := Oracle.SetAdapter(ControllerAdmin.TransactOpts(), tkn, adapter)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SetAdapter event", func() {
+			it, err := Oracle.FilterSetAdapter(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(ControllerAdmin.Address()))
+			Expect(evt.Token).To(Equal(tkn))
+			Expect(evt.Adapter).To(Equal(adapter))
+		})
+
+		It("should register the adapter", func() {
+			a, err := Oracle.RateAdapter(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(a).To(Equal(adapter))
+		})
+
+		It("should return the adapter's rate and timestamp", func() {
+			rate, timestamp, err := Oracle.TokenRateFromAdapter(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rate.String()).To(Equal(FinneyToWei(3).String()))
+			Expect(timestamp.String()).To(Equal("1600000000"))
+		})
+
+		When("the admin removes the adapter", func() {
+			BeforeEach(func() {
+				tx, err := Oracle.SetAdapter(ControllerAdmin.TransactOpts(), tkn, common.Address{})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should fail to read the rate", func() {
+				_, _, err := Oracle.TokenRateFromAdapter(nil, tkn)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,104 @@
+package oracle_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("rateChangeBps", func() {
+
+	tkn := common.HexToAddress("0xfe209bdE5CA32fa20E6728A005F26D651FFF5982")
+
+	var id [32]byte
+	var key *ecdsa.PrivateKey
+
+	update := func(result, date string) {
+		tx, err := Oracle.UpdateTokenRates(Controller.TransactOpts(ethertest.WithValue(big.NewInt(100000000))), big.NewInt(gasLimit))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = Oracle.Callback(OraclizeConnectorOwner.TransactOpts(ethertest.WithGasLimit(500000)), id, result, signProof(result, date, key))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	}
+
+	BeforeEach(func() {
+		id = stringToQueryID("https://min-api.cryptocompare.com/data/price?fsym=TKN&tsyms=ETH&sign=true")
+
+		var err error
+		key, err = crypto.GenerateKey()
+		Expect(err).ToNot(HaveOccurred())
+		tx, err := Oracle.UpdateCryptoCompareAPIPublicKey(ControllerAdmin.TransactOpts(), crypto.FromECDSAPub(&key.PublicKey)[1:])
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{tkn},
+			StringsToByte32("TKN"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{true},
+			[]bool{true},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	rateChange := func() string {
+		change, err := Oracle.RateChangeBps(nil, tkn)
+		Expect(err).ToNot(HaveOccurred())
+		return change.String()
+	}
+
+	When("the token's rate was updated once", func() {
+		BeforeEach(func() {
+			update("{\"ETH\":0.002}", "Wed, 03 Oct 2018 17:00:22 GMT")
+		})
+
+		It("should return 0", func() {
+			Expect(rateChange()).To(Equal("0"))
+		})
+
+		When("the rate increases", func() {
+			BeforeEach(func() {
+				update("{\"ETH\":0.005}", "Wed, 03 Oct 2018 17:10:22 GMT")
+			})
+
+			It("should return a positive change", func() {
+				Expect(rateChange()).To(Equal("15000"))
+			})
+
+			When("the rate decreases", func() {
+				BeforeEach(func() {
+					update("{\"ETH\":0.001}", "Wed, 03 Oct 2018 17:20:22 GMT")
+				})
+
+				It("should return a negative change", func() {
+					Expect(rateChange()).To(Equal("-8000"))
+				})
+			})
+		})
+
+		When("the rate decreases by a fraction of a basis point", func() {
+			BeforeEach(func() {
+				update("{\"ETH\":0.0019999}", "Wed, 03 Oct 2018 17:10:22 GMT")
+			})
+
+			It("should round towards zero", func() {
+				Expect(rateChange()).To(Equal("0"))
+			})
+		})
+	})
+})
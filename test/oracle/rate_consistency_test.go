@@ -0,0 +1,131 @@
+package oracle_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("rateConsistency", func() {
+
+	var tkn = common.HexToAddress("0x1")
+
+	setAdapterRate := func(rate *big.Int) {
+		adapter, tx, _, err := mocks.DeployRateAdapter(BankAccount.TransactOpts(), Backend, rate, big.NewInt(1600000000))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = Oracle.SetAdapter(ControllerAdmin.TransactOpts(), tkn, adapter)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	}
+
+	When("the token is not whitelisted", func() {
+		BeforeEach(func() {
+			setAdapterRate(FinneyToWei(2))
+		})
+
+		It("should fail", func() {
+			_, err := Oracle.RateConsistency(nil, tkn)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("the token is whitelisted", func() {
+		BeforeEach(func() {
+			tx, err := TokenWhitelist.AddTokens(
+				ControllerAdmin.TransactOpts(),
+				[]common.Address{tkn},
+				StringsToByte32("TKN"),
+				[]*big.Int{DecimalsToMagnitude(big.NewInt(8))},
+				[]bool{true},
+				[]bool{false},
+				big.NewInt(20180913153211),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		When("no rate was pushed", func() {
+			BeforeEach(func() {
+				setAdapterRate(FinneyToWei(2))
+			})
+
+			It("should fail", func() {
+				_, err := Oracle.RateConsistency(nil, tkn)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		When("a rate of 0.002 ETH was pushed", func() {
+			BeforeEach(func() {
+				tx, err := TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), tkn, FinneyToWei(2), big.NewInt(20180913153211))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			When("the token has no adapter", func() {
+				It("should fail", func() {
+					_, err := Oracle.RateConsistency(nil, tkn)
+					Expect(err).To(HaveOccurred())
+				})
+			})
+
+			When("the adapter quotes no rate", func() {
+				BeforeEach(func() {
+					setAdapterRate(big.NewInt(0))
+				})
+
+				It("should fail", func() {
+					_, err := Oracle.RateConsistency(nil, tkn)
+					Expect(err).To(HaveOccurred())
+				})
+			})
+
+			When("the adapter quotes the same rate", func() {
+				BeforeEach(func() {
+					setAdapterRate(FinneyToWei(2))
+				})
+
+				It("should return 0", func() {
+					bps, err := Oracle.RateConsistency(nil, tkn)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(bps.String()).To(Equal("0"))
+				})
+			})
+
+			When("the adapter quotes a higher rate", func() {
+				BeforeEach(func() {
+					setAdapterRate(FinneyToWei(3))
+				})
+
+				It("should return the difference in basis points of the pushed rate", func() {
+					bps, err := Oracle.RateConsistency(nil, tkn)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(bps.String()).To(Equal("5000"))
+				})
+			})
+
+			When("the adapter quotes a slightly lower rate", func() {
+				BeforeEach(func() {
+					// 0.00199 ETH is 0.5% below the pushed rate.
+					setAdapterRate(new(big.Int).Sub(FinneyToWei(2), big.NewInt(10000000000000)))
+				})
+
+				It("should return the difference in basis points of the pushed rate", func() {
+					bps, err := Oracle.RateConsistency(nil, tkn)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(bps.String()).To(Equal("50"))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,99 @@
+package oracle_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("rateRecencyWeighted", func() {
+
+	tkn := common.HexToAddress("0xfe209bdE5CA32fa20E6728A005F26D651FFF5982")
+
+	var id [32]byte
+	var key *ecdsa.PrivateKey
+
+	update := func(result, date string) {
+		tx, err := Oracle.UpdateTokenRates(Controller.TransactOpts(ethertest.WithValue(big.NewInt(100000000))), big.NewInt(gasLimit))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = Oracle.Callback(OraclizeConnectorOwner.TransactOpts(ethertest.WithGasLimit(500000)), id, result, signProof(result, date, key))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	}
+
+	BeforeEach(func() {
+		id = stringToQueryID("https://min-api.cryptocompare.com/data/price?fsym=TKN&tsyms=ETH&sign=true")
+
+		var err error
+		key, err = crypto.GenerateKey()
+		Expect(err).ToNot(HaveOccurred())
+		tx, err := Oracle.UpdateCryptoCompareAPIPublicKey(ControllerAdmin.TransactOpts(), crypto.FromECDSAPub(&key.PublicKey)[1:])
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{tkn},
+			StringsToByte32("TKN"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{true},
+			[]bool{true},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	When("the token's rate was never updated", func() {
+		It("should fail", func() {
+			_, err := Oracle.RateRecencyWeighted(nil, tkn)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("the token's rate was updated several times", func() {
+		BeforeEach(func() {
+			update("{\"ETH\":0.002}", "Wed, 03 Oct 2018 17:00:22 GMT")
+			update("{\"ETH\":0.005}", "Wed, 03 Oct 2018 17:10:22 GMT")
+			update("{\"ETH\":0.001}", "Wed, 03 Oct 2018 17:20:22 GMT")
+			update("{\"ETH\":0.004}", "Wed, 03 Oct 2018 17:30:22 GMT")
+		})
+
+		It("should weigh the rates by their recency", func() {
+			// (2*1 + 5*2 + 1*3 + 4*4) / (1+2+3+4) = 3.1 finney
+			avg, err := Oracle.RateRecencyWeighted(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(avg.String()).To(Equal("3100000000000000"))
+		})
+
+		When("more rates than the history holds are recorded", func() {
+			BeforeEach(func() {
+				update("{\"ETH\":0.006}", "Wed, 03 Oct 2018 17:40:22 GMT")
+				update("{\"ETH\":0.007}", "Wed, 03 Oct 2018 17:50:22 GMT")
+				update("{\"ETH\":0.008}", "Wed, 03 Oct 2018 18:00:22 GMT")
+				update("{\"ETH\":0.009}", "Wed, 03 Oct 2018 18:10:22 GMT")
+				update("{\"ETH\":0.01}", "Wed, 03 Oct 2018 18:20:22 GMT")
+			})
+
+			It("should only weigh the most recent rates", func() {
+				// The oldest rate was overwritten:
+				// (5*1 + 1*2 + 4*3 + 6*4 + 7*5 + 8*6 + 9*7 + 10*8) / (1+2+...+8) = 269/36 finney, rounded down in wei.
+				avg, err := Oracle.RateRecencyWeighted(nil, tkn)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(avg.String()).To(Equal("7472222222222222"))
+			})
+		})
+	})
+})
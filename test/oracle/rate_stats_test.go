@@ -0,0 +1,115 @@
+package oracle_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+// signProof builds a CryptoCompare style proof of the result, dated at the given HTTP date and signed with key.
+func signProof(result, date string, key *ecdsa.PrivateKey) []byte {
+	digest := sha256.Sum256([]byte(result))
+	headers := fmt.Sprintf("date: %s\ndigest: SHA-256=%s", date, base64.StdEncoding.EncodeToString(digest[:]))
+	Expect(headers).To(HaveLen(96))
+	hash := sha256.Sum256([]byte(headers))
+	sig, err := crypto.Sign(hash[:], key)
+	Expect(err).ToNot(HaveOccurred())
+
+	proof := append([]byte{0x00, byte(len(sig))}, sig...)
+	proof = append(proof, 0x00, byte(len(headers)))
+	return append(proof, headers...)
+}
+
+var _ = Describe("rateStats", func() {
+
+	tkn := common.HexToAddress("0xfe209bdE5CA32fa20E6728A005F26D651FFF5982")
+
+	var id [32]byte
+	var key *ecdsa.PrivateKey
+
+	update := func(result, date string) {
+		tx, err := Oracle.UpdateTokenRates(Controller.TransactOpts(ethertest.WithValue(big.NewInt(100000000))), big.NewInt(gasLimit))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = Oracle.Callback(OraclizeConnectorOwner.TransactOpts(ethertest.WithGasLimit(500000)), id, result, signProof(result, date, key))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	}
+
+	BeforeEach(func() {
+		id = stringToQueryID("https://min-api.cryptocompare.com/data/price?fsym=TKN&tsyms=ETH&sign=true")
+
+		var err error
+		key, err = crypto.GenerateKey()
+		Expect(err).ToNot(HaveOccurred())
+		tx, err := Oracle.UpdateCryptoCompareAPIPublicKey(ControllerAdmin.TransactOpts(), crypto.FromECDSAPub(&key.PublicKey)[1:])
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{tkn},
+			StringsToByte32("TKN"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{true},
+			[]bool{true},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	When("the token's rate was never updated", func() {
+		It("should fail", func() {
+			_, err := Oracle.RateStats(nil, tkn)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("the token's rate was updated several times", func() {
+		BeforeEach(func() {
+			update("{\"ETH\":0.002}", "Wed, 03 Oct 2018 17:00:22 GMT")
+			update("{\"ETH\":0.005}", "Wed, 03 Oct 2018 17:10:22 GMT")
+			update("{\"ETH\":0.001}", "Wed, 03 Oct 2018 17:20:22 GMT")
+			update("{\"ETH\":0.004}", "Wed, 03 Oct 2018 17:30:22 GMT")
+		})
+
+		It("should return the min, max and average of the rates", func() {
+			stats, err := Oracle.RateStats(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stats.Min.String()).To(Equal(FinneyToWei(1).String()))
+			Expect(stats.Max.String()).To(Equal(FinneyToWei(5).String()))
+			Expect(stats.Avg.String()).To(Equal(FinneyToWei(3).String()))
+		})
+
+		When("more rates than the history holds are recorded", func() {
+			BeforeEach(func() {
+				for i := 0; i < 8; i++ {
+					update("{\"ETH\":0.003}", fmt.Sprintf("Wed, 03 Oct 2018 18:%02d:22 GMT", i))
+				}
+			})
+
+			It("should only consider the most recent rates", func() {
+				stats, err := Oracle.RateStats(nil, tkn)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stats.Min.String()).To(Equal(FinneyToWei(3).String()))
+				Expect(stats.Max.String()).To(Equal(FinneyToWei(3).String()))
+				Expect(stats.Avg.String()).To(Equal(FinneyToWei(3).String()))
+			})
+		})
+	})
+})
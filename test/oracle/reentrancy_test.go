@@ -0,0 +1,104 @@
+package oracle_test
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("reentrancy", func() {
+
+	const result = "{\"ETH\":0.001}"
+
+	var id [32]byte
+	var proof []byte
+
+	BeforeEach(func() {
+		id = stringToQueryID("https://min-api.cryptocompare.com/data/price?fsym=BNT&tsyms=ETH&sign=true")
+
+		key, err := crypto.GenerateKey()
+		Expect(err).ToNot(HaveOccurred())
+		tx, err := Oracle.UpdateCryptoCompareAPIPublicKey(ControllerAdmin.TransactOpts(), crypto.FromECDSAPub(&key.PublicKey)[1:])
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+		proof = signProof(result, "Wed, 03 Oct 2018 17:00:22 GMT", key)
+
+		tx, err = TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{common.HexToAddress("0x1")},
+			StringsToByte32("BNT"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{false},
+			[]bool{false},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	When("the oraclize connector is the callback address and calls back into __callback during an update", func() {
+		BeforeEach(func() {
+			// The connector is let in by __callback, only the reentrancy guard can stop it.
+			tx, err := OraclizeConnector.SetCbAddress(BankAccount.TransactOpts(), OraclizeConnectorAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+
+			a, err := abi.JSON(strings.NewReader(bindings.OracleABI))
+			Expect(err).ToNot(HaveOccurred())
+			data, err := a.Pack("__callback", id, result, proof)
+			Expect(err).ToNot(HaveOccurred())
+
+			tx, err = OraclizeConnector.SetReentry(BankAccount.TransactOpts(), OracleAddress, data)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should block the reentrant call", func() {
+			tx, err := Oracle.UpdateTokenRates(Controller.TransactOpts(ethertest.WithGasLimit(1000000), ethertest.WithValue(big.NewInt(100000000))), big.NewInt(gasLimit))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			Expect(TestRig.LastExecuted()).To(MatchRegexp(`.*require\(!_entered, "reentrant call"\);`))
+		})
+
+		It("should block it during a list update as well", func() {
+			tx, err := Oracle.UpdateTokenRatesList(Controller.TransactOpts(ethertest.WithGasLimit(1000000), ethertest.WithValue(big.NewInt(100000000))), big.NewInt(gasLimit), []common.Address{common.HexToAddress("0x1")})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			Expect(TestRig.LastExecuted()).To(MatchRegexp(`.*require\(!_entered, "reentrant call"\);`))
+		})
+	})
+
+	When("the oraclize connector doesn't call back", func() {
+		BeforeEach(func() {
+			tx, err := Oracle.UpdateTokenRates(Controller.TransactOpts(ethertest.WithValue(big.NewInt(100000000))), big.NewInt(gasLimit))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should accept the same callback once the update is over", func() {
+			tx, err := Oracle.Callback(OraclizeConnectorOwner.TransactOpts(ethertest.WithGasLimit(500000)), id, result, proof)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+
+			_, _, rate, _, _, _, _, err := TokenWhitelist.GetTokenInfo(nil, common.HexToAddress("0x1"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rate.String()).To(Equal(FinneyToWei(1).String()))
+		})
+	})
+})
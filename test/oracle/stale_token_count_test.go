@@ -0,0 +1,92 @@
+package oracle_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("staleTokenCount", func() {
+
+	staleTokenCount := func() string {
+		count, err := Oracle.StaleTokenCount(nil)
+		Expect(err).ToNot(HaveOccurred())
+		return count.String()
+	}
+
+	It("should be 0 without tokens", func() {
+		Expect(staleTokenCount()).To(Equal("0"))
+	})
+
+	When("tokens are whitelisted", func() {
+		BeforeEach(func() {
+			tokens := []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")}
+			tx, err := TokenWhitelist.AddTokens(
+				ControllerAdmin.TransactOpts(),
+				tokens,
+				StringsToByte32("BNT", "TKN", "ZRX"),
+				[]*big.Int{DecimalsToMagnitude(big.NewInt(18)), DecimalsToMagnitude(big.NewInt(8)), DecimalsToMagnitude(big.NewInt(18))},
+				[]bool{false, true, true},
+				[]bool{false, true, false},
+				big.NewInt(0),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+
+			// The first rate goes stale after 30 minutes, the second one after 3 hours and the third one was never set.
+			now := blockTime()
+			tx, err = TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), tokens[0], big.NewInt(1000), encodedDate(now.Add(-30*time.Minute)))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+			tx, err = TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), tokens[1], big.NewInt(1000), encodedDate(now.Add(2*time.Hour)))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should be 0 while staleness is disabled", func() {
+			Expect(staleTokenCount()).To(Equal("0"))
+		})
+
+		When("the admin sets the max rate age to one hour", func() {
+			BeforeEach(func() {
+				tx, err := Oracle.SetMaxRateAge(ControllerAdmin.TransactOpts(), big.NewInt(3600))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should only count the never updated token", func() {
+				Expect(staleTokenCount()).To(Equal("1"))
+			})
+
+			When("an hour has passed", func() {
+				BeforeEach(func() {
+					Backend.AdjustTime(time.Hour)
+					Backend.Commit()
+				})
+
+				It("should count the older rate as well", func() {
+					Expect(staleTokenCount()).To(Equal("2"))
+				})
+
+				When("another three hours have passed", func() {
+					BeforeEach(func() {
+						Backend.AdjustTime(3 * time.Hour)
+						Backend.Commit()
+					})
+
+					It("should count every token", func() {
+						Expect(staleTokenCount()).To(Equal("3"))
+					})
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,114 @@
+package oracle_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+// encodedDate returns the date format used by the token whitelist e.g. 20180913153211.
+func encodedDate(t time.Time) *big.Int {
+	d, ok := new(big.Int).SetString(t.UTC().Format("20060102150405"), 10)
+	Expect(ok).To(BeTrue())
+	return d
+}
+
+func blockTime() time.Time {
+	return time.Unix(int64(Backend.Blockchain().CurrentBlock().Time()), 0)
+}
+
+var _ = Describe("stalenessReport", func() {
+
+	var tokens []common.Address
+
+	BeforeEach(func() {
+		tokens = []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")}
+		tx, err := TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			tokens,
+			StringsToByte32("BNT", "TKN", "ZRX"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(18)), DecimalsToMagnitude(big.NewInt(8)), DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{false, true, true},
+			[]bool{false, true, false},
+			big.NewInt(0),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		now := blockTime()
+		tx, err = TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), tokens[0], big.NewInt(1000), encodedDate(now.Add(-30*time.Minute)))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+		tx, err = TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), tokens[1], big.NewInt(1000), encodedDate(now.Add(2*time.Hour)))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	It("should have staleness disabled by default", func() {
+		age, err := Oracle.MaxRateAge(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(age.String()).To(Equal("0"))
+
+		addresses, stale, err := Oracle.StalenessReport(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(addresses).To(Equal(tokens))
+		Expect(stale).To(Equal([]bool{false, false, false}))
+	})
+
+	When("a random account sets the max rate age", func() {
+		It("should fail", func() {
+			tx, err := Oracle.SetMaxRateAge(RandomAccount.TransactOpts(ethertest.WithGasLimit(100000)), big.NewInt(3600))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the admin sets the max rate age to one hour", func() {
+		BeforeEach(func() {
+			tx, err := Oracle.SetMaxRateAge(ControllerAdmin.TransactOpts(), big.NewInt(3600))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SetMaxRateAge event", func() {
+			it, err := Oracle.FilterSetMaxRateAge(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(ControllerAdmin.Address()))
+			Expect(evt.MaxRateAge.String()).To(Equal("3600"))
+		})
+
+		It("should only report the never updated token as stale", func() {
+			addresses, stale, err := Oracle.StalenessReport(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(addresses).To(Equal(tokens))
+			Expect(stale).To(Equal([]bool{false, false, true}))
+		})
+
+		When("an hour has passed", func() {
+			BeforeEach(func() {
+				Backend.AdjustTime(time.Hour)
+				Backend.Commit()
+			})
+
+			It("should report the older rate as stale as well", func() {
+				addresses, stale, err := Oracle.StalenessReport(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(addresses).To(Equal(tokens))
+				Expect(stale).To(Equal([]bool{true, false, true}))
+			})
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package oracle_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("updateScheduleInfo", func() {
+
+	var tkn = common.HexToAddress("0x1")
+	var updatedAt time.Time
+
+	BeforeEach(func() {
+		tx, err := TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{tkn},
+			StringsToByte32("BNT"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{true},
+			[]bool{false},
+			big.NewInt(0),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		updatedAt = blockTime().Add(-30 * time.Minute)
+		tx, err = TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), tkn, big.NewInt(1000), encodedDate(updatedAt))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	When("the token isn't whitelisted", func() {
+		It("should fail", func() {
+			_, err := Oracle.UpdateScheduleInfo(nil, common.HexToAddress("0x2"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("rates never go stale", func() {
+		It("should not report a next due time", func() {
+			info, err := Oracle.UpdateScheduleInfo(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Interval.String()).To(Equal("0"))
+			Expect(info.LastUpdate.Int64()).To(Equal(updatedAt.Unix()))
+			Expect(info.NextDue.String()).To(Equal("0"))
+		})
+	})
+
+	When("the max rate age is one hour", func() {
+		BeforeEach(func() {
+			tx, err := Oracle.SetMaxRateAge(ControllerAdmin.TransactOpts(), big.NewInt(3600))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should be due an hour after the last update", func() {
+			info, err := Oracle.UpdateScheduleInfo(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Interval.String()).To(Equal("3600"))
+			Expect(info.LastUpdate.Int64()).To(Equal(updatedAt.Unix()))
+			Expect(info.NextDue.String()).To(Equal(new(big.Int).Add(info.LastUpdate, info.Interval).String()))
+		})
+	})
+})
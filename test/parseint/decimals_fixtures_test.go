@@ -0,0 +1,60 @@
+package parseint_test
+
+import (
+	"math/big"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+)
+
+var _ = Describe("Parse with decimals", func() {
+
+	var exporter *simulatedExporter
+
+	BeforeEach(func() {
+		var err error
+		exporter, err = newSimulatedExporter()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := exporter.backend.Close()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	expectOutcome := func(got *big.Int, err error, want outcome, description string) {
+		if want.revert != nil {
+			Expect(err).To(Equal(want.revert), description)
+		} else {
+			Expect(err).ToNot(HaveOccurred(), description)
+			Expect(got.String()).To(Equal(want.value), description)
+		}
+	}
+
+	// Both the exporter and Parse are checked against the same fixture, so that they can't drift apart.
+	table.DescribeTable("should agree with the deployed exporter",
+		func(input string, decimals uint, want outcome) {
+			got, err := exporter.parse(input, decimals)
+			expectOutcome(got, err, want, "exporter")
+			got, err = parseint.Parse(input, decimals)
+			expectOutcome(got, err, want, "Parse")
+		},
+		table.Entry("an integer in wei", "1", uint(18), outcome{value: "1000000000000000000"}),
+		table.Entry("a decimal number in wei", "0.00208", uint(18), outcome{value: "2080000000000000"}),
+		table.Entry("truncated decimals", "123.456", uint(2), outcome{value: "12345"}),
+		table.Entry("a negative exponent within the decimals", "1e-18", uint(18), outcome{value: "1"}),
+		table.Entry("a negative exponent beyond the decimals", "1e-19", uint(18), outcome{value: "0"}),
+		table.Entry("an empty string", "", uint(18), outcome{value: "0"}),
+		table.Entry("a missing exponent", "1e", uint(18), outcome{revert: parseint.ErrMissingExponent}),
+		table.Entry("the largest exponent with decimals", "1e59", uint(18), outcome{value: "1" + strings.Repeat("0", 77)}),
+		table.Entry("an exponent > 77 once the decimals are added", "1e60", uint(18), outcome{revert: parseint.ErrExponentTooLarge}),
+		table.Entry("a negative exponent > 77", "1e-96", uint(18), outcome{revert: parseint.ErrExponentTooLarge}),
+		table.Entry("78 decimal digits", "0."+strings.Repeat("0", 78), uint(0), outcome{revert: parseint.ErrTooManyDecimals}),
+		table.Entry("77 decimal digits left after the shift", "1."+strings.Repeat("0", 77)+"1", uint(1), outcome{value: "10"}),
+		table.Entry("the largest integral part shifted", maxUint256, uint(1), outcome{revert: parseint.ErrOverflow}),
+		table.Entry("the largest exponent shifted", "1e"+maxUint256, uint(1), outcome{revert: parseint.ErrOverflow}),
+	)
+})
@@ -0,0 +1,67 @@
+package parseint_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+)
+
+var _ = Describe("DecodeRevert", func() {
+
+	var exporter *simulatedExporter
+
+	BeforeEach(func() {
+		var err error
+		exporter, err = newSimulatedExporter()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := exporter.backend.Close()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	table.DescribeTable("should map each revert of the exporter to its error",
+		func(input string, decimals uint, want error) {
+			ret, err := exporter.call(input, decimals)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parseint.DecodeRevert(ret)).To(Equal(want))
+		},
+		table.Entry("a missing integral part", ".5", uint(0), parseint.ErrMissingIntegral),
+		table.Entry("a duplicate decimal point", "1.2.3", uint(0), parseint.ErrDuplicateDecimalPoint),
+		table.Entry("a decimal point after the exponent", "1e3.5", uint(0), parseint.ErrDecimalAfterExponent),
+		table.Entry("a duplicate '-'", "1e--3", uint(0), parseint.ErrDuplicateMinus),
+		table.Entry("an extra sign", "1e+-3", uint(0), parseint.ErrExtraSign),
+		table.Entry("a misplaced '-'", "1e3-", uint(0), parseint.ErrMisplacedMinus),
+		table.Entry("a duplicate '+'", "1e++3", uint(0), parseint.ErrDuplicatePlus),
+		table.Entry("a misplaced '+'", "1e3+", uint(0), parseint.ErrMisplacedPlus),
+		table.Entry("a duplicate exponent symbol", "1e3e4", uint(0), parseint.ErrDuplicateExponent),
+		table.Entry("an invalid digit", "12a", uint(0), parseint.ErrInvalidDigit),
+		table.Entry("a missing exponent", "1e", uint(0), parseint.ErrMissingExponent),
+		table.Entry("an exponent > 77", "1e78", uint(0), parseint.ErrExponentTooLarge),
+		table.Entry("more than 77 decimal digits", "0."+zeros(78), uint(0), parseint.ErrTooManyDecimals),
+		table.Entry("an addition overflow", maxUint256[:77]+"9", uint(0), parseint.ErrOverflow),
+		table.Entry("a multiplication overflow", "2e77", uint(0), parseint.ErrOverflow),
+	)
+
+	It("should not map a successful result", func() {
+		ret, err := exporter.call("1", 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(new(big.Int).SetBytes(ret).String()).To(Equal("1"))
+		Expect(parseint.DecodeRevert(ret)).To(BeNil())
+	})
+
+	It("should not map other revert reasons", func() {
+		stringType, err := abi.NewType("string", "", nil)
+		Expect(err).ToNot(HaveOccurred())
+		reason, err := abi.Arguments{{Type: stringType}}.Pack("other reason")
+		Expect(err).ToNot(HaveOccurred())
+		data := append(crypto.Keccak256([]byte("Error(string)"))[:4], reason...)
+		Expect(parseint.DecodeRevert(data)).To(BeNil())
+	})
+})
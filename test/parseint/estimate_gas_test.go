@@ -0,0 +1,61 @@
+package parseint_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("EstimateGas", func() {
+
+	var exporter common.Address
+	var exporterABI abi.ABI
+
+	BeforeEach(func() {
+		err := InitializeBackend()
+		Expect(err).ToNot(HaveOccurred())
+
+		exporter, _, _, err = mocks.DeployParseIntScientificExporter(RandomAccount.TransactOpts(), Backend)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+
+		exporterABI, err = abi.JSON(strings.NewReader(mocks.ParseIntScientificExporterABI))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := Backend.Close()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	estimate := func(input string, decimals uint) uint64 {
+		data, err := exporterABI.Pack("parseIntScientificDecimals", input, big.NewInt(int64(decimals)))
+		Expect(err).ToNot(HaveOccurred())
+		gas, err := Backend.EstimateGas(context.Background(), ethereum.CallMsg{From: RandomAccount.Address(), To: &exporter, Data: data})
+		Expect(err).ToNot(HaveOccurred())
+		return gas
+	}
+
+	It("should be within 2% of the node's estimate", func() {
+		for _, input := range []string{"0", "7", "123", "1.5", "0.001702", "1234567890.0987654321", "1e3", "1.5E-3", "123.456e+12", "99999999999999999999999999999999"} {
+			for _, decimals := range []uint{0, 6, 18} {
+				want := float64(estimate(input, decimals))
+				got := float64(parseint.EstimateGas(input, decimals))
+				Expect(got).To(BeNumerically("~", want, want*0.02), "input %q with %d decimals", input, decimals)
+			}
+		}
+	})
+
+	It("should grow with the length of the input", func() {
+		Expect(parseint.EstimateGas("12345", 18)).To(BeNumerically(">", parseint.EstimateGas("1", 18)))
+	})
+})
@@ -0,0 +1,75 @@
+package parseint_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+)
+
+// simulatedExporter is an exporter deployed on its own simulated backend, so it can be shared across fuzz iterations.
+type simulatedExporter struct {
+	mu      sync.Mutex
+	backend *backends.SimulatedBackend
+	address common.Address
+	abi     abi.ABI
+}
+
+func newSimulatedExporter() (*simulatedExporter, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	deployer := bind.NewKeyedTransactor(key)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		deployer.From: {Balance: new(big.Int).Lsh(big.NewInt(1), 100)},
+	}, 10000000)
+	address, _, _, err := mocks.DeployParseIntScientificExporter(deployer, backend)
+	if err != nil {
+		return nil, errors.Wrap(err, "deploying exporter")
+	}
+	backend.Commit()
+	exporterABI, err := abi.JSON(strings.NewReader(mocks.ParseIntScientificExporterABI))
+	if err != nil {
+		return nil, err
+	}
+	return &simulatedExporter{backend: backend, address: address, abi: exporterABI}, nil
+}
+
+// call returns the data parseIntScientificDecimals returns or reverts with.
+func (e *simulatedExporter) call(in string, decimals uint) ([]byte, error) {
+	data, err := e.abi.Pack("parseIntScientificDecimals", in, new(big.Int).SetUint64(uint64(decimals)))
+	if err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	// The simulated backend returns the revert data rather than an error.
+	return e.backend.CallContract(context.Background(), ethereum.CallMsg{To: &e.address, Data: data}, nil)
+}
+
+// parse calls parseIntScientificDecimals and normalizes a revert into the error parseint.Parse fails with.
+func (e *simulatedExporter) parse(in string, decimals uint) (*big.Int, error) {
+	ret, err := e.call(in, decimals)
+	if err != nil {
+		return nil, err
+	}
+	if len(ret) == 32 {
+		return new(big.Int).SetBytes(ret), nil
+	}
+	if err := parseint.DecodeRevert(ret); err != nil {
+		return nil, err
+	}
+	return nil, errors.Errorf("unknown revert %x", ret)
+}
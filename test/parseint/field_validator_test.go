@@ -0,0 +1,110 @@
+package parseint_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+)
+
+var _ = Describe("FieldValidator", func() {
+
+	validate := parseint.FieldValidator(18)
+
+	When("'123' is passed", func() {
+		It("should accept it", func() {
+			Expect(validate("123")).To(Succeed())
+		})
+	})
+
+	When("'0.5' is passed", func() {
+		It("should accept it", func() {
+			Expect(validate("0.5")).To(Succeed())
+		})
+	})
+
+	When("'1.5e3' is passed", func() {
+		It("should accept it", func() {
+			Expect(validate("1.5e3")).To(Succeed())
+		})
+	})
+
+	When("'15E-1' is passed", func() {
+		It("should accept it", func() {
+			Expect(validate("15E-1")).To(Succeed())
+		})
+	})
+
+	When("'1e+2' is passed", func() {
+		It("should accept it", func() {
+			Expect(validate("1e+2")).To(Succeed())
+		})
+	})
+
+	When("'' is passed", func() {
+		It("should fail with 'please enter an amount'", func() {
+			Expect(validate("")).To(MatchError("please enter an amount"))
+		})
+	})
+
+	When("'.5' is passed", func() {
+		It("should fail with 'the amount must start with a digit'", func() {
+			Expect(validate(".5")).To(MatchError("the amount must start with a digit"))
+		})
+	})
+
+	When("'1.2.3' is passed", func() {
+		It("should fail with 'only one decimal point is allowed'", func() {
+			Expect(validate("1.2.3")).To(MatchError("only one decimal point is allowed"))
+		})
+	})
+
+	When("'1e1.5' is passed", func() {
+		It("should fail with 'the exponent must be a whole number'", func() {
+			Expect(validate("1e1.5")).To(MatchError("the exponent must be a whole number"))
+		})
+	})
+
+	When("'1e+-1' is passed", func() {
+		It("should fail with 'the exponent can only have one sign'", func() {
+			Expect(validate("1e+-1")).To(MatchError("the exponent can only have one sign"))
+		})
+	})
+
+	When("'-1' is passed", func() {
+		It("should fail with 'a sign is only allowed right after the exponent symbol'", func() {
+			Expect(validate("-1")).To(MatchError("a sign is only allowed right after the exponent symbol"))
+		})
+	})
+
+	When("'1e1e1' is passed", func() {
+		It("should fail with 'only one exponent is allowed'", func() {
+			Expect(validate("1e1e1")).To(MatchError("only one exponent is allowed"))
+		})
+	})
+
+	When("'12a' is passed", func() {
+		It("should fail with 'only digits, a decimal point and an exponent (e.g. 1.5e3) are allowed'", func() {
+			Expect(validate("12a")).To(MatchError("only digits, a decimal point and an exponent (e.g. 1.5e3) are allowed"))
+		})
+	})
+
+	When("'1e' is passed", func() {
+		It("should fail with 'the exponent is missing after e'", func() {
+			Expect(validate("1e")).To(MatchError("the exponent is missing after e"))
+		})
+	})
+
+	When("'1e78' is passed", func() {
+		It("should fail with 'the exponent is too large'", func() {
+			Expect(validate("1e78")).To(MatchError("the exponent is too large"))
+		})
+	})
+
+	When("more than 77 decimals are passed", func() {
+		It("should fail with 'too many decimal places'", func() {
+			Expect(validate("0." + strings.Repeat("0", 77) + "1e78")).To(MatchError("too many decimal places"))
+		})
+	})
+})
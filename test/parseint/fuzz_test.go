@@ -0,0 +1,39 @@
+package parseint_test
+
+import (
+	"testing"
+
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+)
+
+// fuzzMaxLen bounds the inputs sent to the exporter, longer ones only repeat the grammar at a higher gas cost.
+const fuzzMaxLen = 128
+
+func FuzzParse(f *testing.F) {
+	for _, in := range append(parseint.TrickyCorpus(), "1e0", "0.0e3", ".5", "12345e-3") {
+		f.Add(in, uint8(0))
+		f.Add(in, uint8(18))
+	}
+
+	exporter, err := newSimulatedExporter()
+	if err != nil {
+		f.Fatal(err)
+	}
+	defer exporter.backend.Close()
+
+	f.Fuzz(func(t *testing.T, in string, decimals uint8) {
+		if len(in) > fuzzMaxLen {
+			t.Skip()
+		}
+		want, wantErr := exporter.parse(in, uint(decimals))
+		got, err := parseint.Parse(in, uint(decimals))
+		switch {
+		case wantErr != nil && err != wantErr:
+			t.Fatalf("Parse(%q, %d) failed with %v, the contract with %v", in, decimals, err, wantErr)
+		case wantErr == nil && err != nil:
+			t.Fatalf("Parse(%q, %d) failed with %v, the contract returned %s", in, decimals, err, want)
+		case wantErr == nil && got.Cmp(want) != 0:
+			t.Fatalf("Parse(%q, %d) returned %s, the contract %s", in, decimals, got, want)
+		}
+	})
+}
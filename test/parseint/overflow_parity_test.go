@@ -0,0 +1,71 @@
+package parseint_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("Parse overflows", func() {
+
+	var exporter common.Address
+	var exporterABI abi.ABI
+
+	BeforeEach(func() {
+		err := InitializeBackend()
+		Expect(err).ToNot(HaveOccurred())
+
+		exporter, _, _, err = mocks.DeployParseIntScientificExporter(RandomAccount.TransactOpts(), Backend)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+
+		exporterABI, err = abi.JSON(strings.NewReader(mocks.ParseIntScientificExporterABI))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		err := Backend.Close()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// revertReason returns the reason the exporter reverts with, or an empty string if it doesn't revert.
+	revertReason := func(input string, decimals uint) string {
+		data, err := exporterABI.Pack("parseIntScientificDecimals", input, new(big.Int).SetUint64(uint64(decimals)))
+		Expect(err).ToNot(HaveOccurred())
+		ret, err := Backend.CallContract(context.Background(), ethereum.CallMsg{To: &exporter, Data: data}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		if len(ret) <= 68 {
+			return ""
+		}
+		return strings.TrimRight(string(ret[68:]), "\x00")
+	}
+
+	table.DescribeTable("should fail for the same reason as the contract",
+		func(input string, decimals uint, reason string) {
+			Expect(revertReason(input, decimals)).To(Equal(reason))
+			_, err := parseint.Parse(input, decimals)
+			Expect(err).To(Equal(parseint.ErrOverflow))
+		},
+		// The integral part overflows once the decimal is appended, before the exponent is found too large.
+		table.Entry("decimals appended to the largest integral part", maxUint256+".5e100", uint(0), "SafeMath: multiplication overflow"),
+		// The exponent overflows once the requested decimals are added to it.
+		table.Entry("the largest exponent with decimals", "1e"+maxUint256, uint(1), "SafeMath: addition overflow"),
+		table.Entry("an integral part above the largest value", maxUint256[:77]+"9", uint(0), "SafeMath: addition overflow"),
+	)
+
+	It("should find the largest exponent without decimals too large", func() {
+		Expect(revertReason("1e"+maxUint256, 0)).To(Equal("exponent > 77"))
+		_, err := parseint.Parse("1e"+maxUint256, 0)
+		Expect(err).To(Equal(parseint.ErrExponentTooLarge))
+	})
+})
@@ -0,0 +1,13 @@
+package parseint_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestParseIntSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ParseInt Suite")
+}
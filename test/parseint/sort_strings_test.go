@@ -0,0 +1,35 @@
+package parseint_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+)
+
+var _ = Describe("SortStrings", func() {
+
+	It("should sort by numeric value regardless of notation", func() {
+		sorted, err := parseint.SortStrings([]string{"1e1", "5", "20"}, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sorted).To(Equal([]string{"5", "1e1", "20"}))
+	})
+
+	It("should use the decimals to compare fractional values", func() {
+		sorted, err := parseint.SortStrings([]string{"0.3", "2.5e-1", "0.1E+1"}, 18)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sorted).To(Equal([]string{"2.5e-1", "0.3", "0.1E+1"}))
+	})
+
+	It("should keep the order of equal values", func() {
+		sorted, err := parseint.SortStrings([]string{"10", "1e1", "1", "10.0"}, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sorted).To(Equal([]string{"1", "10", "1e1", "10.0"}))
+	})
+
+	When("one of the inputs is invalid", func() {
+		It("should name the offending input", func() {
+			_, err := parseint.SortStrings([]string{"1", "1.2.3", "2"}, 0)
+			Expect(err).To(MatchError(`parsing "1.2.3": duplicate decimal point`))
+		})
+	})
+})
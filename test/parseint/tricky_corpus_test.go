@@ -0,0 +1,138 @@
+package parseint_test
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	"github.com/tokencard/contracts/v3/pkg/parseint"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+// outcome is the expected result of parsing an input without decimals: its value, or the reason it reverts for.
+type outcome struct {
+	value  string
+	revert error
+}
+
+const maxUint256 = "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+
+var trickyOutcomes = map[string]outcome{
+	"1e3":         {value: "1000"},
+	"1E3":         {value: "1000"},
+	"1e0":         {value: "1"},
+	"1.5e3":       {value: "1500"},
+	"1.5E-3":      {value: "0"},
+	"123e-2":      {value: "1"},
+	"123.456e+12": {value: "123456000000000"},
+	"0.1E+1":      {value: "1"},
+	"0":           {value: "0"},
+	"0001":        {value: "1"},
+	"007.50":      {value: "7"},
+	"0.000":       {value: "0"},
+	"00e5":        {value: "0"},
+	"000123e-1":   {value: "12"},
+	maxUint256:    {value: maxUint256},
+	"115792089237316195423570985008687907853269984665640564039457584007913129639936": {revert: parseint.ErrOverflow},
+	"1e77":  {value: "1" + zeros(77)},
+	"11e76": {value: "11" + zeros(76)},
+	"2e77":  {revert: parseint.ErrOverflow},
+	"1e78":  {revert: parseint.ErrExponentTooLarge},
+	"1e-77": {value: "0"},
+	"1e-78": {revert: parseint.ErrExponentTooLarge},
+	"-1":    {revert: parseint.ErrMisplacedMinus},
+	"+1":    {revert: parseint.ErrMisplacedPlus},
+	// A sign right after the first digit is taken for the exponent's, as the 'e' position defaults to 0.
+	"1-e3":  {revert: parseint.ErrMissingExponent},
+	"1+e3":  {revert: parseint.ErrMissingExponent},
+	"1e3-":  {revert: parseint.ErrMisplacedMinus},
+	"1e3+":  {revert: parseint.ErrMisplacedPlus},
+	"1e--3": {revert: parseint.ErrDuplicateMinus},
+	"1e++3": {revert: parseint.ErrDuplicatePlus},
+	"1e+-3": {revert: parseint.ErrExtraSign},
+	"1e-+3": {revert: parseint.ErrExtraSign},
+	// The contract parses an empty string as 0.
+	"":      {value: "0"},
+	".5":    {revert: parseint.ErrMissingIntegral},
+	"e5":    {revert: parseint.ErrMissingIntegral},
+	".e5":   {revert: parseint.ErrMissingIntegral},
+	"E-3":   {revert: parseint.ErrMissingIntegral},
+	"1.":    {value: "1"},
+	"1.e3":  {value: "1000"},
+	"1e":    {revert: parseint.ErrMissingExponent},
+	"1e-":   {revert: parseint.ErrMissingExponent},
+	"1e+":   {revert: parseint.ErrMissingExponent},
+	"1.2.3": {revert: parseint.ErrDuplicateDecimalPoint},
+	"1e3.5": {revert: parseint.ErrDecimalAfterExponent},
+	"1e3e4": {revert: parseint.ErrDuplicateExponent},
+	"12a":   {revert: parseint.ErrInvalidDigit},
+	"1 ":    {revert: parseint.ErrInvalidDigit},
+	"0x10":  {revert: parseint.ErrInvalidDigit},
+}
+
+func zeros(n int) string {
+	z := make([]byte, n)
+	for i := range z {
+		z[i] = '0'
+	}
+	return string(z)
+}
+
+var _ = Describe("TrickyCorpus", func() {
+
+	var exporter *mocks.ParseIntScientificExporter
+
+	BeforeEach(func() {
+		err := InitializeBackend()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, _, exporter, err = mocks.DeployParseIntScientificExporter(RandomAccount.TransactOpts(), Backend)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+	})
+
+	AfterEach(func() {
+		err := Backend.Close()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should document the outcome of every input", func() {
+		corpus := parseint.TrickyCorpus()
+		Expect(corpus).To(HaveLen(len(trickyOutcomes)))
+		for _, in := range corpus {
+			Expect(trickyOutcomes).To(HaveKey(in))
+		}
+	})
+
+	It("should match the outcomes of the deployed exporter", func() {
+		for _, in := range parseint.TrickyCorpus() {
+			want := trickyOutcomes[in]
+			got, err := exporter.ParseIntScientific(&bind.CallOpts{}, in)
+			if want.revert != nil {
+				Expect(err).To(HaveOccurred(), "input %q", in)
+			} else {
+				Expect(err).ToNot(HaveOccurred(), "input %q", in)
+				Expect(got.String()).To(Equal(want.value), "input %q", in)
+			}
+		}
+	})
+
+	It("should match the outcomes of Parse", func() {
+		for _, in := range parseint.TrickyCorpus() {
+			want := trickyOutcomes[in]
+			got, err := parseint.Parse(in, 0)
+			if want.revert != nil {
+				Expect(err).To(Equal(want.revert), "input %q", in)
+			} else {
+				Expect(err).ToNot(HaveOccurred(), "input %q", in)
+				Expect(got.String()).To(Equal(want.value), "input %q", in)
+			}
+		}
+	})
+
+	It("should return a copy", func() {
+		corpus := parseint.TrickyCorpus()
+		corpus[0] = "changed"
+		Expect(parseint.TrickyCorpus()[0]).ToNot(Equal("changed"))
+	})
+})
@@ -0,0 +1,52 @@
+package proxyscan_test
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/bindings/externals/upgradeability"
+	"github.com/tokencard/contracts/v3/pkg/proxyscan"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("Detect", func() {
+
+	var implementationAddress, proxyAddress common.Address
+
+	BeforeEach(func() {
+		var tx *types.Transaction
+		var err error
+		implementationAddress, tx, _, err = bindings.DeployWallet(BankAccount.TransactOpts(), Backend)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		proxyAddress, tx, _, err = upgradeability.DeployUpgradeabilityProxy(Owner.TransactOpts(), Backend, implementationAddress, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+	})
+
+	It("should map proxies to their implementation and omit other addresses", func() {
+		proxies, err := proxyscan.Detect(context.Background(), storageReader{Backend}, []common.Address{
+			proxyAddress,
+			implementationAddress,
+			TKNBurnerAddress,
+			RandomAccount.Address(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(proxies).To(Equal(map[common.Address]common.Address{proxyAddress: implementationAddress}))
+	})
+
+	When("no addresses are given", func() {
+		It("should return an empty map", func() {
+			proxies, err := proxyscan.Detect(context.Background(), storageReader{Backend}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(proxies).To(BeEmpty())
+		})
+	})
+})
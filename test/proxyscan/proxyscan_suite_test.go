@@ -0,0 +1,41 @@
+package proxyscan_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+func TestProxyScanSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Proxy Scan Suite")
+}
+
+var _ = BeforeEach(func() {
+	err := InitializeBackend()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterEach(func() {
+	err := Backend.Close()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+// storageReader reads the storage of the latest state of the test chain.
+type storageReader struct {
+	ethertest.TestBackend
+}
+
+func (s storageReader) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	st, err := s.Blockchain().State()
+	if err != nil {
+		return nil, err
+	}
+	return st.GetState(account, key).Bytes(), nil
+}
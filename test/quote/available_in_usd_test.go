@@ -0,0 +1,78 @@
+package quote_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/quote"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("AvailableInUSD", func() {
+
+	// $200.5 per ETH.
+	var usdPerEthE8 = big.NewInt(20050000000)
+
+	It("should value the daily spend limit at the given rate", func() {
+		usd, err := quote.AvailableInUSD(context.Background(), WalletProxy, TokenWhitelist, usdPerEthE8)
+		Expect(err).ToNot(HaveOccurred())
+		// 100 ETH * $200.5
+		Expect(usd.String()).To(Equal("2005000000000"))
+	})
+
+	When("part of the spend limit has been spent", func() {
+		BeforeEach(func() {
+			BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(1))
+
+			// 0.333 ETH
+			tx, err := WalletProxy.Transfer(Owner.TransactOpts(), RandomAccount.Address(), common.Address{}, FinneyToWei(333))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should value what's left", func() {
+			usd, err := quote.AvailableInUSD(context.Background(), WalletProxy, TokenWhitelist, usdPerEthE8)
+			Expect(err).ToNot(HaveOccurred())
+			// 99.667 ETH * $200.5
+			Expect(usd.String()).To(Equal("1998323350000"))
+		})
+	})
+
+	When("no rate is given", func() {
+		BeforeEach(func() {
+			// 1 USDC = 0.005 ETH, i.e. $200 per ETH.
+			tx, err := TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), StablecoinAddress, FinneyToWei(5), big.NewInt(20180913153211))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should use the stablecoin rate of the oracle", func() {
+			usd, err := quote.AvailableInUSD(context.Background(), WalletProxy, TokenWhitelist, nil)
+			Expect(err).ToNot(HaveOccurred())
+			// 100 ETH * $200
+			Expect(usd.String()).To(Equal("2000000000000"))
+		})
+	})
+
+	When("the spend limit is exhausted", func() {
+		BeforeEach(func() {
+			BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(100))
+
+			tx, err := WalletProxy.Transfer(Owner.TransactOpts(), RandomAccount.Address(), common.Address{}, EthToWei(100))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should return zero", func() {
+			usd, err := quote.AvailableInUSD(context.Background(), WalletProxy, TokenWhitelist, usdPerEthE8)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usd.Sign()).To(BeZero())
+		})
+	})
+})
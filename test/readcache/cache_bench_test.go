@@ -0,0 +1,58 @@
+package readcache_test
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tokencard/contracts/v3/pkg/readcache"
+)
+
+// slowCaller answers every call after a delay, like a remote node would.
+type slowCaller struct {
+	calls int64
+}
+
+func (s *slowCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (s *slowCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	atomic.AddInt64(&s.calls, 1)
+	time.Sleep(time.Millisecond)
+	return make([]byte, 32), nil
+}
+
+func benchmarkConcurrentReads(b *testing.B, wrap func(bind.ContractCaller) bind.ContractCaller) {
+	backend := &slowCaller{}
+	caller := wrap(backend)
+	to := common.HexToAddress("0x1")
+	call := ethereum.CallMsg{To: &to, Data: []byte{0x12, 0x34, 0x56, 0x78}}
+	b.SetParallelism(16)
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := caller.CallContract(context.Background(), call, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.ReportMetric(float64(atomic.LoadInt64(&backend.calls))/float64(b.N), "rpcs/op")
+}
+
+func BenchmarkConcurrentReadsUncached(b *testing.B) {
+	benchmarkConcurrentReads(b, func(c bind.ContractCaller) bind.ContractCaller { return c })
+}
+
+func BenchmarkConcurrentReadsCollapsed(b *testing.B) {
+	benchmarkConcurrentReads(b, func(c bind.ContractCaller) bind.ContractCaller { return readcache.New(c, 0) })
+}
+
+func BenchmarkConcurrentReadsCached(b *testing.B) {
+	benchmarkConcurrentReads(b, func(c bind.ContractCaller) bind.ContractCaller { return readcache.New(c, time.Second) })
+}
@@ -0,0 +1,94 @@
+package readcache_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/readcache"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var errUnavailable = errors.New("backend unavailable")
+
+var _ = Describe("Cache", func() {
+
+	var counter *countingCaller
+
+	stablecoinSymbol := func(cache *readcache.Cache) (string, error) {
+		whitelist, err := bindings.NewTokenWhitelistCaller(TokenWhitelistAddress, cache)
+		Expect(err).ToNot(HaveOccurred())
+		symbol, _, _, _, _, _, _, err := whitelist.GetStablecoinInfo(nil)
+		return symbol, err
+	}
+
+	BeforeEach(func() {
+		counter = &countingCaller{ContractCaller: Backend}
+	})
+
+	When("many goroutines read the same value concurrently", func() {
+		It("should make a single call and share its result", func() {
+			counter.gate = make(chan struct{})
+			cache := readcache.New(counter, 0)
+
+			const readers = 20
+			var wg sync.WaitGroup
+			symbols := make([]string, readers)
+			errs := make([]error, readers)
+			for i := 0; i < readers; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					symbols[i], errs[i] = stablecoinSymbol(cache)
+				}(i)
+			}
+			// Let the readers pile up on the call in flight before it completes.
+			Eventually(func() int64 { return atomic.LoadInt64(&counter.calls) }).Should(Equal(int64(1)))
+			time.Sleep(50 * time.Millisecond)
+			close(counter.gate)
+			wg.Wait()
+
+			Expect(atomic.LoadInt64(&counter.calls)).To(Equal(int64(1)))
+			for i := 0; i < readers; i++ {
+				Expect(errs[i]).ToNot(HaveOccurred())
+				Expect(symbols[i]).To(Equal("USDC"))
+			}
+		})
+	})
+
+	When("a value has been read", func() {
+		It("should serve it from memory until it expires", func() {
+			cache := readcache.New(counter, 100*time.Millisecond)
+			for i := 0; i < 3; i++ {
+				symbol, err := stablecoinSymbol(cache)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(symbol).To(Equal("USDC"))
+			}
+			Expect(counter.calls).To(Equal(int64(1)))
+
+			time.Sleep(150 * time.Millisecond)
+			_, err := stablecoinSymbol(cache)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(counter.calls).To(Equal(int64(2)))
+		})
+	})
+
+	When("a read fails", func() {
+		It("should not cache the error", func() {
+			counter.failures = 1
+			cache := readcache.New(counter, time.Minute)
+
+			_, err := stablecoinSymbol(cache)
+			Expect(errors.Cause(err)).To(Equal(errUnavailable))
+
+			symbol, err := stablecoinSymbol(cache)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(symbol).To(Equal("USDC"))
+			Expect(counter.calls).To(Equal(int64(2)))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package readcache_test
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+func TestReadCacheSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReadCache Suite")
+}
+
+var _ = BeforeEach(func() {
+	err := InitializeBackend()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterEach(func() {
+	err := Backend.Close()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+// countingCaller counts the contract calls made through it. While gate is set, calls wait for it to be closed,
+// and while failures is positive, calls fail.
+type countingCaller struct {
+	bind.ContractCaller
+	calls    int64
+	failures int64
+	gate     chan struct{}
+}
+
+func (c *countingCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	atomic.AddInt64(&c.calls, 1)
+	if c.gate != nil {
+		<-c.gate
+	}
+	if atomic.AddInt64(&c.failures, -1) >= 0 {
+		return nil, errUnavailable
+	}
+	return c.ContractCaller.CallContract(ctx, call, blockNumber)
+}
+
+func (c *countingCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.ContractCaller.CodeAt(ctx, contract, blockNumber)
+}
@@ -0,0 +1,159 @@
+package reconcile_test
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/reconcile"
+	"github.com/tokencard/contracts/v3/pkg/wallet"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+// walletChain adds the header access missing from the simulated backend to the wallet client.
+type walletChain struct {
+	*wallet.Client
+}
+
+func (walletChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if number == nil {
+		return Backend.Blockchain().CurrentHeader(), nil
+	}
+	return Backend.Blockchain().GetHeaderByNumber(number.Uint64()), nil
+}
+
+var _ = Describe("Spend", func() {
+
+	var w *wallet.Client
+	var ethTx, tokenTx *types.Transaction
+
+	transfer := func(asset common.Address, amount *big.Int) *types.Transaction {
+		tx, err := w.Transfer(Owner.TransactOpts(), RandomAccount.Address(), asset, amount)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+		return tx
+	}
+
+	spend := func(ledger []reconcile.LedgerEntry, window time.Duration) reconcile.Report {
+		report, err := reconcile.Spend(context.Background(), walletChain{w}, TokenWhitelist, ledger, window)
+		Expect(err).ToNot(HaveOccurred())
+		return report
+	}
+
+	BeforeEach(func() {
+		var walletAddress common.Address
+		var err error
+		w, walletAddress, err = NewFundedWallet(Owner.TransactOpts(), EthToWei(10))
+		Expect(err).ToNot(HaveOccurred())
+
+		// 1 ERC20 = 0.001 ETH.
+		tx, err := TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{ERC20Contract1Address},
+			StringsToByte32("ERC"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(0))},
+			[]bool{true},
+			[]bool{false},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+		tx, err = TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), ERC20Contract1Address, FinneyToWei(1), big.NewInt(20180913153211))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		tx, err = ERC20Contract1.Credit(BankAccount.TransactOpts(), walletAddress, big.NewInt(1000))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		ethTx = transfer(common.Address{}, EthToWei(1))
+		tokenTx = transfer(ERC20Contract1Address, big.NewInt(500))
+	})
+
+	When("the ledger matches the transfers", func() {
+		It("should be reconciled", func() {
+			report := spend([]reconcile.LedgerEntry{
+				{TxHash: ethTx.Hash(), Asset: common.Address{}, Amount: EthToWei(1)},
+				{TxHash: tokenTx.Hash(), Asset: ERC20Contract1Address, Amount: big.NewInt(500)},
+			}, time.Hour)
+			Expect(report.Reconciled()).To(BeTrue())
+			Expect(report.ToBlock).To(Equal(Backend.Blockchain().CurrentHeader().Number.Uint64()))
+			Expect(report.OnChain.String()).To(Equal(FinneyToWei(1500).String()))
+			Expect(report.Ledger.String()).To(Equal(FinneyToWei(1500).String()))
+		})
+	})
+
+	When("the ledger records a transfer in several entries", func() {
+		It("should be reconciled", func() {
+			report := spend([]reconcile.LedgerEntry{
+				{TxHash: ethTx.Hash(), Asset: common.Address{}, Amount: FinneyToWei(400)},
+				{TxHash: ethTx.Hash(), Asset: common.Address{}, Amount: FinneyToWei(600)},
+				{TxHash: tokenTx.Hash(), Asset: ERC20Contract1Address, Amount: big.NewInt(500)},
+			}, time.Hour)
+			Expect(report.Reconciled()).To(BeTrue())
+		})
+	})
+
+	When("the ledger doesn't match the transfers", func() {
+
+		var other = common.HexToHash("0x1234")
+		var report reconcile.Report
+
+		BeforeEach(func() {
+			report = spend([]reconcile.LedgerEntry{
+				{TxHash: ethTx.Hash(), Asset: common.Address{}, Amount: FinneyToWei(900)},
+				{TxHash: other, Asset: common.Address{}, Amount: FinneyToWei(200)},
+			}, time.Hour)
+		})
+
+		It("should not be reconciled", func() {
+			Expect(report.Reconciled()).To(BeFalse())
+			Expect(report.OnChain.String()).To(Equal(FinneyToWei(1500).String()))
+			Expect(report.Ledger.String()).To(Equal(FinneyToWei(1100).String()))
+		})
+
+		It("should report each discrepancy", func() {
+			Expect(report.Discrepancies).To(HaveLen(3))
+
+			Expect(report.Discrepancies[0].TxHash).To(Equal(ethTx.Hash()))
+			Expect(report.Discrepancies[0].Recorded.String()).To(Equal(FinneyToWei(900).String()))
+			Expect(report.Discrepancies[0].Transferred.String()).To(Equal(EthToWei(1).String()))
+
+			Expect(report.Discrepancies[1].TxHash).To(Equal(other))
+			Expect(report.Discrepancies[1].Recorded.String()).To(Equal(FinneyToWei(200).String()))
+			Expect(report.Discrepancies[1].Transferred.String()).To(Equal("0"))
+
+			Expect(report.Discrepancies[2].TxHash).To(Equal(tokenTx.Hash()))
+			Expect(report.Discrepancies[2].Asset).To(Equal(ERC20Contract1Address))
+			Expect(report.Discrepancies[2].Recorded.String()).To(Equal("0"))
+			Expect(report.Discrepancies[2].Transferred.String()).To(Equal("500"))
+		})
+	})
+
+	When("some transfers are older than the window", func() {
+
+		var lateTx *types.Transaction
+
+		BeforeEach(func() {
+			Backend.AdjustTime(2 * time.Hour)
+			Backend.Commit()
+			lateTx = transfer(common.Address{}, FinneyToWei(300))
+		})
+
+		It("should only reconcile the transfers within the window", func() {
+			report := spend([]reconcile.LedgerEntry{
+				{TxHash: lateTx.Hash(), Asset: common.Address{}, Amount: FinneyToWei(300)},
+			}, time.Hour)
+			Expect(report.Reconciled()).To(BeTrue())
+			Expect(report.OnChain.String()).To(Equal(FinneyToWei(300).String()))
+		})
+	})
+})
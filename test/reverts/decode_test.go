@@ -0,0 +1,113 @@
+package reverts_test
+
+import (
+	stderrors "errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/reverts"
+)
+
+var _ = Describe("Decode", func() {
+
+	var uint256Type, _ = abi.NewType("uint256", "", nil)
+	var addressType, _ = abi.NewType("address", "", nil)
+
+	insufficient := reverts.CustomError{
+		Name:   "InsufficientBalance",
+		Inputs: abi.Arguments{{Name: "available", Type: uint256Type}, {Name: "required", Type: uint256Type}},
+	}
+
+	pack := func(signature string, args abi.Arguments, values ...interface{}) []byte {
+		data, err := args.Pack(values...)
+		Expect(err).ToNot(HaveOccurred())
+		return append(crypto.Keccak256([]byte(signature))[:4], data...)
+	}
+
+	When("the data is empty", func() {
+		It("should be an unknown revert", func() {
+			r := reverts.Decode(nil)
+			Expect(r.Kind).To(Equal(reverts.Unknown))
+			Expect(stderrors.Is(r, reverts.ErrUnknown)).To(BeTrue())
+			Expect(stderrors.Is(r, reverts.ErrReason)).To(BeFalse())
+			Expect(r.Error()).To(Equal("execution reverted"))
+		})
+	})
+
+	When("the data is too short for a selector", func() {
+		It("should be an unknown revert", func() {
+			Expect(reverts.Decode([]byte{0x08, 0xc3, 0x79}).Kind).To(Equal(reverts.Unknown))
+		})
+	})
+
+	When("the data is an Error(string)", func() {
+		It("should decode the reason", func() {
+			stringType, _ := abi.NewType("string", "", nil)
+			r := reverts.Decode(pack("Error(string)", abi.Arguments{{Type: stringType}}, "not enough ETH"))
+			Expect(r.Kind).To(Equal(reverts.Reason))
+			Expect(r.Reason).To(Equal("not enough ETH"))
+			Expect(stderrors.Is(r, reverts.ErrReason)).To(BeTrue())
+			Expect(r.Error()).To(Equal("execution reverted: not enough ETH"))
+		})
+
+		It("should use the well known selector", func() {
+			r := reverts.Decode(hexutil.MustDecode("0x08c379a0" +
+				"0000000000000000000000000000000000000000000000000000000000000020" +
+				"0000000000000000000000000000000000000000000000000000000000000002" +
+				"6f6b000000000000000000000000000000000000000000000000000000000000"))
+			Expect(r.Kind).To(Equal(reverts.Reason))
+			Expect(r.Reason).To(Equal("ok"))
+		})
+	})
+
+	When("the data is a Panic(uint256)", func() {
+		It("should decode the code", func() {
+			r := reverts.Decode(pack("Panic(uint256)", abi.Arguments{{Type: uint256Type}}, big.NewInt(0x11)))
+			Expect(r.Kind).To(Equal(reverts.Panic))
+			Expect(r.PanicCode.String()).To(Equal("17"))
+			Expect(stderrors.Is(r, reverts.ErrPanic)).To(BeTrue())
+			Expect(r.Error()).To(Equal("execution reverted: panic 0x11 (arithmetic overflow)"))
+		})
+	})
+
+	When("the data is a custom error", func() {
+
+		var data []byte
+
+		BeforeEach(func() {
+			data = pack("InsufficientBalance(uint256,uint256)", insufficient.Inputs, big.NewInt(1), big.NewInt(2))
+		})
+
+		It("should decode the arguments of a known error", func() {
+			r := reverts.NewDecoder(insufficient).Decode(data)
+			Expect(r.Kind).To(Equal(reverts.Custom))
+			Expect(r.Custom.Name).To(Equal("InsufficientBalance"))
+			Expect(r.Custom.Selector[:]).To(Equal(insufficient.Selector()))
+			Expect(r.Custom.Args).To(Equal([]interface{}{big.NewInt(1), big.NewInt(2)}))
+			Expect(stderrors.Is(r, reverts.ErrCustom)).To(BeTrue())
+			Expect(r.Error()).To(Equal("execution reverted: InsufficientBalance(1, 2)"))
+		})
+
+		It("should keep the selector and data of an unknown error", func() {
+			r := reverts.Decode(data)
+			Expect(r.Kind).To(Equal(reverts.Custom))
+			Expect(r.Custom.Name).To(BeEmpty())
+			Expect(r.Custom.Selector[:]).To(Equal(insufficient.Selector()))
+			Expect(r.Custom.Args).To(BeNil())
+			Expect(r.Custom.Data).To(Equal(data[4:]))
+			Expect(stderrors.Is(r, reverts.ErrCustom)).To(BeTrue())
+		})
+
+		It("should not match a custom error whose arguments don't decode", func() {
+			owner := reverts.CustomError{Name: "NotOwner", Inputs: abi.Arguments{{Type: addressType}}}
+			r := reverts.NewDecoder(owner).Decode(append(owner.Selector(), common.Address{}.Bytes()...))
+			Expect(r.Kind).To(Equal(reverts.Custom))
+			Expect(r.Custom.Name).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package reverts_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRevertsSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Reverts Suite")
+}
@@ -608,6 +608,13 @@ func InitializeBackend() error {
 	return nil
 }
 
+// IsSuccessful returns true if the mined transaction didn't revert.
+func IsSuccessful(tx *types.Transaction) bool {
+	r, err := Backend.TransactionReceipt(context.Background(), tx.Hash())
+	Expect(err).ToNot(HaveOccurred())
+	return r.Status == types.ReceiptStatusSuccessful
+}
+
 func verifyTransaction(tx *types.Transaction) error {
 	receipt, err := Backend.TransactionReceipt(context.Background(), tx.Hash())
 	if err != nil {
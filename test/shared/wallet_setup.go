@@ -0,0 +1,60 @@
+package shared
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/bindings/externals/upgradeability"
+	"github.com/tokencard/contracts/v3/pkg/wallet"
+)
+
+// NewFundedWallet deploys a wallet behind a proxy, initialized with a daily spend limit of 100 ETH, funds it
+// with ethWei from the bank account and returns a client transacting as owner, along with the wallet's address.
+func NewFundedWallet(owner *bind.TransactOpts, ethWei *big.Int) (*wallet.Client, common.Address, error) {
+	implementationAddress, tx, _, err := bindings.DeployWallet(BankAccount.TransactOpts(), Backend)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	Backend.Commit()
+	err = verifyTransaction(tx)
+	if err != nil {
+		return nil, common.Address{}, errors.Wrap(err, "deploying wallet implementation")
+	}
+
+	walletAddress, tx, _, err := upgradeability.DeployUpgradeabilityProxy(owner, Backend, implementationAddress, nil)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	Backend.Commit()
+	err = verifyTransaction(tx)
+	if err != nil {
+		return nil, common.Address{}, errors.Wrap(err, "deploying wallet proxy")
+	}
+
+	client, err := wallet.NewClient(walletAddress, owner, Backend)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	tx, err = client.InitializeWallet(owner, owner.From, true, ENSRegistryAddress, TokenWhitelistName, ControllerName, LicenceName, EthToWei(100))
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	Backend.Commit()
+	err = verifyTransaction(tx)
+	if err != nil {
+		return nil, common.Address{}, errors.Wrap(err, "initializing wallet")
+	}
+
+	if ethWei.Sign() > 0 {
+		err = BankAccount.Transfer(Backend, walletAddress, ethWei)
+		if err != nil {
+			return nil, common.Address{}, errors.Wrap(err, "funding wallet")
+		}
+	}
+
+	return client, walletAddress, nil
+}
@@ -0,0 +1,228 @@
+package spendsim_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/spendsim"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+// revertReason decodes the reason of an Error(string) revert, the call data is empty if the call succeeded.
+func revertReason(ret []byte) string {
+	if len(ret) < 4 {
+		return ""
+	}
+	var reason string
+	err := abi.Arguments{{Type: stringType}}.Unpack(&reason, ret[4:])
+	Expect(err).ToNot(HaveOccurred())
+	return reason
+}
+
+var stringType, _ = abi.NewType("string", "", nil)
+
+// recipient is the destination of the simulated transfers, the accounts are only created once the specs run.
+var recipient = common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+type schedule struct {
+	limit *big.Int
+	after time.Duration
+}
+
+type scenario struct {
+	setup     func()
+	whitelist []common.Address
+	schedule  *schedule
+	wait      time.Duration
+	to        common.Address
+	amount    *big.Int
+}
+
+var _ = Describe("Simulate", func() {
+
+	send := func(to common.Address, amount *big.Int) {
+		tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(500000)), to, common.Address{}, amount)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+	}
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(150))
+	})
+
+	DescribeTable("should agree with the deployed wallet",
+		func(s scenario, allowed bool, reason string) {
+			if s.setup != nil {
+				s.setup()
+			}
+
+			// The stored spend limit can only be read before any scheduled change or daily reset is due.
+			value, err := WalletProxy.SpendLimitValue(nil)
+			Expect(err).ToNot(HaveOccurred())
+			available, err := WalletProxy.SpendLimitAvailable(nil)
+			Expect(err).ToNot(HaveOccurred())
+			threshold, err := WalletProxy.ConfirmThreshold(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			config := spendsim.WalletConfig{
+				SpendLimit:       value,
+				ConfirmThreshold: threshold,
+				Whitelist:        map[common.Address]bool{},
+			}
+			for _, a := range s.whitelist {
+				config.Whitelist[a] = true
+			}
+			if s.schedule != nil {
+				at := Backend.Blockchain().CurrentBlock().Time() + uint64(s.schedule.after/time.Second)
+				tx, err := WalletProxy.ScheduleLimitChange(Owner.TransactOpts(), s.schedule.limit, new(big.Int).SetUint64(at))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(IsSuccessful(tx)).To(BeTrue())
+
+				tx, err = WalletProxy.ConfirmLimitChange(Controller.TransactOpts(), s.schedule.limit, new(big.Int).SetUint64(at))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(IsSuccessful(tx)).To(BeTrue())
+				config.ScheduledLimit = s.schedule.limit
+				config.ScheduledLimitAt = at
+			}
+			if s.wait != 0 {
+				Backend.AdjustTime(s.wait)
+				Backend.Commit()
+			}
+
+			balance, err := WalletProxy.GetBalance(nil, common.Address{})
+			Expect(err).ToNot(HaveOccurred())
+			held, err := WalletProxy.HeldBalance(nil, common.Address{})
+			Expect(err).ToNot(HaveOccurred())
+			state := spendsim.WalletState{
+				Now:            Backend.Blockchain().CurrentBlock().Time(),
+				Available:      available,
+				LimitTimestamp: LimitTimestamp,
+				Balance:        balance,
+				Held:           held,
+			}
+
+			res, err := spendsim.Simulate(config, state, spendsim.TransferLeg{To: s.to, Amount: s.amount})
+			Expect(err).ToNot(HaveOccurred())
+
+			a, err := abi.JSON(strings.NewReader(bindings.WalletABI))
+			Expect(err).ToNot(HaveOccurred())
+			data, err := a.Pack("transfer", s.to, common.Address{}, s.amount)
+			Expect(err).ToNot(HaveOccurred())
+			ret, err := Backend.CallContract(context.Background(), ethereum.CallMsg{From: Owner.Address(), To: &WalletProxyAddress, Gas: 500000, Data: data}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(res.Allowed).To(Equal(len(ret) == 0))
+			Expect(res.Reason).To(Equal(revertReason(ret)))
+			Expect(res.Allowed).To(Equal(allowed))
+			Expect(res.Reason).To(Equal(reason))
+
+			if res.Allowed {
+				send(s.to, s.amount)
+				av, err := WalletProxy.SpendLimitAvailable(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(av.String()).To(Equal(res.Available.String()))
+			}
+		},
+		Entry("a transfer within the limit", scenario{
+			to:     recipient,
+			amount: EthToWei(1),
+		}, true, ""),
+		Entry("a transfer above the limit", scenario{
+			to:     recipient,
+			amount: EthToWei(101),
+		}, false, spendsim.ReasonLimitExceeded),
+		Entry("a transfer above the limit to a whitelisted address", scenario{
+			setup: func() {
+				tx, err := WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{recipient})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(IsSuccessful(tx)).To(BeTrue())
+			},
+			whitelist: []common.Address{recipient},
+			to:        recipient,
+			amount:    EthToWei(101),
+		}, true, ""),
+		Entry("a transfer above the balance to a whitelisted address", scenario{
+			setup: func() {
+				tx, err := WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{recipient})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(IsSuccessful(tx)).To(BeTrue())
+			},
+			whitelist: []common.Address{recipient},
+			to:        recipient,
+			amount:    EthToWei(151),
+		}, false, spendsim.ReasonBalanceOnHold),
+		Entry("a transfer above the confirmation threshold", scenario{
+			setup: func() {
+				tx, err := WalletProxy.SetConfirmThreshold(Owner.TransactOpts(), EthToWei(5))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(IsSuccessful(tx)).To(BeTrue())
+			},
+			to:     recipient,
+			amount: EthToWei(6),
+		}, false, spendsim.ReasonConfirmationRequired),
+		Entry("a transfer of nothing", scenario{
+			to:     recipient,
+			amount: big.NewInt(0),
+		}, false, spendsim.ReasonZeroValue),
+		Entry("a transfer to the zero address", scenario{
+			to:     common.Address{},
+			amount: EthToWei(1),
+		}, false, spendsim.ReasonZeroDestination),
+		Entry("a transfer above what's left of the limit", scenario{
+			setup:  func() { send(recipient, EthToWei(60)) },
+			to:     recipient,
+			amount: EthToWei(50),
+		}, false, spendsim.ReasonLimitExceeded),
+		Entry("a transfer once the limit has reset", scenario{
+			setup:  func() { send(recipient, EthToWei(60)) },
+			wait:   24*time.Hour + time.Second,
+			to:     recipient,
+			amount: EthToWei(50),
+		}, true, ""),
+		Entry("a transfer above a due scheduled decrease", scenario{
+			schedule: &schedule{limit: EthToWei(10), after: time.Hour},
+			wait:     2 * time.Hour,
+			to:       recipient,
+			amount:   EthToWei(20),
+		}, false, spendsim.ReasonLimitExceeded),
+		Entry("a transfer above a scheduled decrease that isn't due", scenario{
+			schedule: &schedule{limit: EthToWei(10), after: 2 * time.Hour},
+			wait:     time.Hour,
+			to:       recipient,
+			amount:   EthToWei(20),
+		}, true, ""),
+		Entry("a transfer above the old limit once a scheduled increase and the reset are due", scenario{
+			setup:    func() { send(recipient, EthToWei(60)) },
+			schedule: &schedule{limit: EthToWei(200), after: time.Hour},
+			wait:     25 * time.Hour,
+			to:       recipient,
+			amount:   EthToWei(101),
+		}, false, spendsim.ReasonLimitExceeded),
+		Entry("a transfer of a held deposit", scenario{
+			setup: func() {
+				tx, err := WalletProxy.SetDepositHold(Controller.TransactOpts(), EthToWei(50), big.NewInt(24*60*60))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(IsSuccessful(tx)).To(BeTrue())
+			},
+			to:     recipient,
+			amount: EthToWei(1),
+		}, false, spendsim.ReasonBalanceOnHold),
+	)
+})
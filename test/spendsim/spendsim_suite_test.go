@@ -0,0 +1,38 @@
+package spendsim_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var WalletProxy *bindings.Wallet
+var WalletProxyAddress common.Address
+
+// LimitTimestamp is when the wallet's daily spend limit period started, i.e. when it was initialized.
+var LimitTimestamp uint64
+
+func TestSpendsimSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Spendsim Suite")
+}
+
+var _ = BeforeEach(func() {
+	err := InitializeBackend()
+	Expect(err).ToNot(HaveOccurred())
+
+	client, address, err := NewFundedWallet(Owner.TransactOpts(), big.NewInt(0))
+	Expect(err).ToNot(HaveOccurred())
+	WalletProxy, WalletProxyAddress = client.Wallet, address
+	LimitTimestamp = Backend.Blockchain().CurrentBlock().Time()
+})
+
+var _ = AfterEach(func() {
+	err := Backend.Close()
+	Expect(err).ToNot(HaveOccurred())
+})
@@ -0,0 +1,56 @@
+package storage_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/storage"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("MappingSlot", func() {
+
+	It("should compute the slot of key 0 in the mapping at slot 0", func() {
+		// keccak256 of 64 zero bytes.
+		slot := storage.UintMappingSlot(0, big.NewInt(0))
+		Expect(slot).To(Equal(common.HexToHash("0xad3228b676f7d3cd4284a5443f17f1962b36e491b30a40b2405849e597ba5fb5")))
+	})
+
+	It("should pad address and uint256 keys", func() {
+		key := RandomAccount.Address()
+		Expect(storage.AddressMappingSlot(1, key)).To(Equal(storage.MappingSlot(1, common.LeftPadBytes(key.Bytes(), 32))))
+		Expect(storage.UintMappingSlot(1, big.NewInt(5))).To(Equal(storage.MappingSlot(1, common.LeftPadBytes([]byte{5}, 32))))
+	})
+
+	// The token mock declares totalSupply at slot 0, balanceOf at slot 1 and allowance at slot 2.
+	When("a token balance is credited", func() {
+		BeforeEach(func() {
+			tx, err := Stablecoin.Credit(BankAccount.TransactOpts(), RandomAccount.Address(), big.NewInt(1234))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should find the balance at the slot of the address", func() {
+			value := storageAt(StablecoinAddress, storage.AddressMappingSlot(1, RandomAccount.Address()))
+			Expect(value.Big().String()).To(Equal("1234"))
+		})
+	})
+
+	When("a token allowance is approved", func() {
+		BeforeEach(func() {
+			tx, err := Stablecoin.Approve(RandomAccount.TransactOpts(), BankAccount.Address(), big.NewInt(42))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should find the allowance at the nested slot of the owner and spender", func() {
+			owner := storage.AddressMappingSlot(2, RandomAccount.Address())
+			value := storageAt(StablecoinAddress, storage.NestedMappingSlot(owner, common.LeftPadBytes(BankAccount.Address().Bytes(), 32)))
+			Expect(value.Big().String()).To(Equal("42"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package subscribe_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSubscribeSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Subscribe Suite")
+}
@@ -0,0 +1,84 @@
+package subscribe_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/subscribe"
+)
+
+var _ = Describe("Throttle", func() {
+
+	// burst sends n events at once, numbered by their Index, and closes the channel.
+	burst := func(in chan<- subscribe.Event, n int) {
+		for i := 0; i < n; i++ {
+			in <- subscribe.Event{Index: uint(i)}
+		}
+		close(in)
+	}
+
+	collect := func(out <-chan subscribe.Event) ([]uint, []time.Time) {
+		var indexes []uint
+		var times []time.Time
+		for e := range out {
+			indexes = append(indexes, e.Index)
+			times = append(times, time.Now())
+		}
+		return indexes, times
+	}
+
+	When("a burst fits in the buffer", func() {
+		It("should deliver every event in order at the max rate", func() {
+			in := make(chan subscribe.Event)
+			out := subscribe.Throttle(in, 20)
+			go burst(in, 10)
+
+			indexes, times := collect(out)
+			Expect(indexes).To(Equal([]uint{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}))
+			for i := 1; i < len(times); i++ {
+				Expect(times[i].Sub(times[i-1])).To(BeNumerically(">=", 45*time.Millisecond))
+			}
+			Expect(times[9].Sub(times[0])).To(BeNumerically(">=", 450*time.Millisecond))
+		})
+	})
+
+	When("a burst overflows the buffer with the drop policy", func() {
+		It("should drop the overflowing events and keep the order", func() {
+			in := make(chan subscribe.Event)
+			out := subscribe.Throttle(in, 10, subscribe.WithBuffer(2))
+			go burst(in, 10)
+
+			indexes, _ := collect(out)
+			Expect(len(indexes)).To(BeNumerically(">=", 2))
+			Expect(len(indexes)).To(BeNumerically("<", 10))
+			Expect(indexes[0]).To(Equal(uint(0)))
+			for i := 1; i < len(indexes); i++ {
+				Expect(indexes[i]).To(BeNumerically(">", indexes[i-1]))
+			}
+		})
+	})
+
+	When("a burst overflows the buffer with the block policy", func() {
+		It("should deliver every event and hold back the sender", func() {
+			in := make(chan subscribe.Event)
+			out := subscribe.Throttle(in, 20, subscribe.WithBuffer(1), subscribe.WithPolicy(subscribe.Block))
+			sent := make(chan time.Time, 1)
+			start := time.Now()
+			go func() {
+				burst(in, 6)
+				sent <- time.Now()
+			}()
+
+			indexes, _ := collect(out)
+			Expect(indexes).To(Equal([]uint{0, 1, 2, 3, 4, 5}))
+			Expect((<-sent).Sub(start)).To(BeNumerically(">=", 100*time.Millisecond))
+		})
+	})
+
+	When("the rate isn't positive", func() {
+		It("should panic", func() {
+			Expect(func() { subscribe.Throttle(make(chan subscribe.Event), 0) }).To(Panic())
+		})
+	})
+})
@@ -0,0 +1,212 @@
+package sync_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/bindings/checkpoint"
+	bsync "github.com/tokencard/contracts/v3/pkg/bindings/sync"
+	"github.com/tokencard/contracts/v3/pkg/export"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+// chainBackend adds the header access and subscription missing from the simulated backend.
+type chainBackend struct {
+	ethertest.TestBackend
+}
+
+func (b chainBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if number == nil {
+		return b.Blockchain().CurrentHeader(), nil
+	}
+	return b.Blockchain().GetHeaderByNumber(number.Uint64()), nil
+}
+
+func (b chainBackend) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	events := make(chan core.ChainHeadEvent, 16)
+	sub := b.Blockchain().SubscribeChainHeadEvent(events)
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-events:
+				select {
+				case ch <- ev.Block.Header():
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// failingStore stops saving checkpoints after the first saves, as if the indexer crashed.
+type failingStore struct {
+	checkpoint.Store
+	saves int
+}
+
+func (f *failingStore) Save(ctx context.Context, cp checkpoint.Checkpoint) error {
+	if f.saves == 0 {
+		return errors.New("store unavailable")
+	}
+	f.saves--
+	return f.Store.Save(ctx, cp)
+}
+
+// recorder collects the events handled by a sync.
+type recorder struct {
+	mu     sync.Mutex
+	events []export.DecodedEvent
+}
+
+func (r *recorder) handle(e export.DecodedEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) transfers() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n := 0
+	for _, e := range r.events {
+		if e.Name == "Transferred" {
+			n++
+		}
+	}
+	return n
+}
+
+type eventID struct {
+	tx    common.Hash
+	index uint
+}
+
+var _ = Describe("Full", func() {
+
+	var abis map[common.Address]string
+
+	transfer := func() {
+		tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(500000)), RandomAccount.Address(), common.Address{}, FinneyToWei(1))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+	}
+
+	BeforeEach(func() {
+		abis = map[common.Address]string{WalletProxyAddress: bindings.WalletABI}
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(1))
+		for i := 0; i < 6; i++ {
+			transfer()
+		}
+	})
+
+	When("a sync is interrupted and resumed", func() {
+
+		var store *checkpoint.MemoryStore
+		var first, second *recorder
+		var interrupted checkpoint.Checkpoint
+		var cancel context.CancelFunc
+		var done chan error
+
+		BeforeEach(func() {
+			store = &checkpoint.MemoryStore{}
+			first, second = &recorder{}, &recorder{}
+			err := bsync.Full(context.Background(), chainBackend{Backend}, abis, &failingStore{Store: store, saves: 3}, first.handle, bsync.WithPageSize(2), bsync.WithConfirmations(0))
+			Expect(err).To(MatchError("saving checkpoint: store unavailable"))
+
+			var ok bool
+			interrupted, ok, err = store.Load(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(interrupted.BlockNumber).To(Equal(uint64(5)))
+
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(context.Background())
+			done = make(chan error, 1)
+			go func() {
+				done <- bsync.Full(ctx, chainBackend{Backend}, abis, store, second.handle, bsync.WithPageSize(2), bsync.WithConfirmations(0))
+			}()
+			Eventually(second.transfers, 5*time.Second).Should(Equal(6))
+		})
+
+		AfterEach(func() {
+			cancel()
+			var err error
+			Eventually(done).Should(Receive(&err))
+			Expect(errors.Cause(err)).To(Equal(context.Canceled))
+		})
+
+		It("should resume after the checkpoint", func() {
+			Expect(second.events).ToNot(BeEmpty())
+			Expect(second.events[0].BlockNumber).To(BeNumerically(">", interrupted.BlockNumber))
+		})
+
+		It("should handle every event in order", func() {
+			logs, err := Backend.FilterLogs(context.Background(), ethereum.FilterQuery{
+				FromBlock: big.NewInt(0),
+				Addresses: []common.Address{WalletProxyAddress},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			var handled []eventID
+			seen := map[eventID]bool{}
+			for _, e := range append(first.events, second.events...) {
+				id := eventID{e.TxHash, e.LogIndex}
+				Expect(e.Address).To(Equal(WalletProxyAddress))
+				if !seen[id] {
+					seen[id] = true
+					handled = append(handled, id)
+				}
+			}
+			// The proxy's own events, e.g. Upgraded, aren't part of the wallet ABI and are skipped.
+			walletABI, err := abi.JSON(strings.NewReader(bindings.WalletABI))
+			Expect(err).ToNot(HaveOccurred())
+			var expected []eventID
+			for _, l := range logs {
+				if _, err := walletABI.EventByID(l.Topics[0]); err != nil {
+					continue
+				}
+				expected = append(expected, eventID{l.TxHash, l.Index})
+			}
+			Expect(handled).To(Equal(expected))
+		})
+
+		It("should follow the events of new blocks", func() {
+			transfer()
+			Eventually(second.transfers, 5*time.Second).Should(Equal(7))
+		})
+	})
+
+	When("the checkpointed block is no longer canonical", func() {
+		It("should fail", func() {
+			store := &checkpoint.MemoryStore{}
+			err := store.Save(context.Background(), checkpoint.Checkpoint{BlockNumber: 3, BlockHash: common.HexToHash("0x1")})
+			Expect(err).ToNot(HaveOccurred())
+
+			var r recorder
+			err = bsync.Full(context.Background(), chainBackend{Backend}, abis, store, r.handle, bsync.WithConfirmations(0))
+			Expect(err).To(Equal(bsync.ErrReorgBeyondCheckpoint))
+			Expect(r.events).To(BeEmpty())
+		})
+	})
+})
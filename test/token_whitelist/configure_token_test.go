@@ -0,0 +1,84 @@
+package token_whitelist_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("configureToken", func() {
+
+	var tkn = common.HexToAddress("0x1")
+
+	configure := func(account *ethertest.Account) bool {
+		tx, err := TokenWhitelist.ConfigureToken(
+			account.TransactOpts(ethertest.WithGasLimit(300000)),
+			tkn,
+			StringsToByte32("TKN")[0],
+			DecimalsToMagnitude(big.NewInt(8)),
+			big.NewInt(555),
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		return isSuccessful(tx)
+	}
+
+	When("called by a random account", func() {
+		It("should fail", func() {
+			Expect(configure(RandomAccount)).To(BeFalse())
+		})
+	})
+
+	When("called by the controller admin", func() {
+		BeforeEach(func() {
+			Expect(configure(ControllerAdmin)).To(BeTrue())
+		})
+
+		It("should fully configure the token", func() {
+			symbol, magnitude, rate, available, loadable, redeemable, lastUpdate, err := TokenWhitelist.GetTokenInfo(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(symbol).To(Equal("TKN"))
+			Expect(magnitude.String()).To(Equal(DecimalsToMagnitude(big.NewInt(8)).String()))
+			Expect(rate.String()).To(Equal("555"))
+			Expect(available).To(BeTrue())
+			Expect(loadable).To(BeFalse())
+			Expect(redeemable).To(BeFalse())
+			Expect(lastUpdate.String()).To(Equal("20180913153211"))
+
+			tokens, err := TokenWhitelist.TokenAddressArray(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tokens).To(Equal([]common.Address{tkn}))
+
+			updater, err := TokenWhitelist.TokenLastUpdater(nil, tkn)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updater).To(Equal(ControllerAdmin.Address()))
+		})
+
+		It("should emit AddedToken and UpdatedTokenRate events", func() {
+			added, err := TokenWhitelist.FilterAddedToken(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(added.Next()).To(BeTrue())
+			Expect(added.Event.Token).To(Equal(tkn))
+			Expect(added.Event.Symbol).To(Equal("TKN"))
+			Expect(added.Next()).To(BeFalse())
+
+			updated, err := TokenWhitelist.FilterUpdatedTokenRate(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Next()).To(BeTrue())
+			Expect(updated.Event.Token).To(Equal(tkn))
+			Expect(updated.Event.Rate.String()).To(Equal("555"))
+			Expect(updated.Next()).To(BeFalse())
+		})
+
+		When("the token is configured again", func() {
+			It("should fail", func() {
+				Expect(configure(ControllerAdmin)).To(BeFalse())
+			})
+		})
+	})
+})
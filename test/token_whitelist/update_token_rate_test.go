@@ -28,6 +28,11 @@ var _ = Describe("updateTokenRate", func() {
 			Backend.Commit()
 			Expect(isSuccessful(tx)).To(BeTrue())
 		})
+		It("Should have no last updater", func() {
+			updater, err := TokenWhitelist.TokenLastUpdater(nil, common.HexToAddress("0x1"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updater).To(Equal(common.Address{}))
+		})
 		Context("When called by the controller admin", func() {
 			var tx *types.Transaction
 
@@ -67,6 +72,11 @@ var _ = Describe("updateTokenRate", func() {
 				Expect(evt.Token).To(Equal(common.HexToAddress("0x1")))
 				Expect(evt.Rate.String()).To(Equal(big.NewInt(555).String()))
 			})
+			It("Should record the controller admin as the last updater", func() {
+				updater, err := TokenWhitelist.TokenLastUpdater(nil, common.HexToAddress("0x1"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updater).To(Equal(ControllerAdmin.Address()))
+			})
 		})
 		Context("When not called by the controller", func() {
 			It("Should fail", func() {
@@ -0,0 +1,101 @@
+package txutil_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/txutil"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("InternalTransfers", func() {
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(1))
+	})
+
+	When("the wallet transfers ETH", func() {
+		var tx *types.Transaction
+
+		BeforeEach(func() {
+			var err error
+			tx, err = WalletProxy.Transfer(Owner.TransactOpts(), RandomAccount.Address(), common.Address{}, big.NewInt(1000))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should return the transfer made by the wallet", func() {
+			transfers, err := txutil.InternalTransfers(context.Background(), tracingBackend{Backend}, WalletProxyAddress, tx.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(transfers).To(Equal([]txutil.Transfer{
+				{From: WalletProxyAddress, To: RandomAccount.Address(), Value: big.NewInt(1000)},
+			}))
+		})
+	})
+
+	When("the wallet loads the card with ETH", func() {
+		var tx *types.Transaction
+
+		BeforeEach(func() {
+			var err error
+			tx, err = TokenWhitelist.AddTokens(
+				ControllerAdmin.TransactOpts(),
+				[]common.Address{common.HexToAddress("0x0")},
+				StringsToByte32("ETH"),
+				[]*big.Int{DecimalsToMagnitude(big.NewInt(18))},
+				[]bool{true},
+				[]bool{true},
+				big.NewInt(20180913153211),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+
+			tx, err = WalletProxy.LoadTokenCard(Owner.TransactOpts(ethertest.WithGasLimit(1000000)), common.Address{}, big.NewInt(1000))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should only return the transfer made by the wallet, not the ones the licence makes", func() {
+			transfers, err := txutil.InternalTransfers(context.Background(), tracingBackend{Backend}, WalletProxyAddress, tx.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(transfers).To(Equal([]txutil.Transfer{
+				{From: WalletProxyAddress, To: LicenceAddress, Value: big.NewInt(1000)},
+			}))
+		})
+
+		It("should return the licence's transfers for the licence", func() {
+			transfers, err := txutil.InternalTransfers(context.Background(), tracingBackend{Backend}, LicenceAddress, tx.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(transfers).To(HaveLen(2))
+			Expect(transfers[0].To).To(Equal(TokenHolderAddress))
+			Expect(transfers[1].To).To(Equal(CryptoFloatAddress))
+			Expect(new(big.Int).Add(transfers[0].Value, transfers[1].Value).String()).To(Equal("1000"))
+		})
+	})
+
+	When("the wallet doesn't transfer any ETH", func() {
+		var tx *types.Transaction
+
+		BeforeEach(func() {
+			var err error
+			tx, err = WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{RandomAccount.Address()})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should return no transfers", func() {
+			transfers, err := txutil.InternalTransfers(context.Background(), tracingBackend{Backend}, WalletProxyAddress, tx.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(transfers).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package txutil_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/pkg/errors"
+	"github.com/tokencard/ethertest"
+)
+
+// tracingBackend answers debug_traceTransaction requests for the test chain, which the simulated
+// backend doesn't expose, by replaying the transaction with the tracers of geth's debug API.
+type tracingBackend struct {
+	ethertest.TestBackend
+}
+
+func (b tracingBackend) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if method != "debug_traceTransaction" {
+		return errors.Errorf("unsupported method %s", method)
+	}
+	txHash := args[0].(common.Hash)
+	config := args[1].(map[string]string)
+	tracer, err := tracers.New(config["tracer"])
+	if err != nil {
+		return err
+	}
+	receipt, err := b.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return err
+	}
+
+	// Replay the block up to and including the traced transaction.
+	bc := b.Blockchain()
+	block := bc.GetBlockByHash(receipt.BlockHash)
+	parent := bc.GetBlockByHash(block.ParentHash())
+	st, err := bc.StateAt(parent.Root())
+	if err != nil {
+		return err
+	}
+	signer := types.MakeSigner(bc.Config(), block.Number())
+	for _, tx := range block.Transactions() {
+		msg, err := tx.AsMessage(signer)
+		if err != nil {
+			return err
+		}
+		cfg := vm.Config{}
+		if tx.Hash() == txHash {
+			cfg = vm.Config{Debug: true, Tracer: tracer}
+		}
+		st.Prepare(tx.Hash(), block.Hash(), 0)
+		evm := vm.NewEVM(core.NewEVMContext(msg, block.Header(), bc, nil), st, bc.Config(), cfg)
+		_, _, _, err = core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(math.MaxUint64))
+		if err != nil {
+			return err
+		}
+		if tx.Hash() == txHash {
+			break
+		}
+		st.Finalise(true)
+	}
+
+	trace, err := tracer.GetResult()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(trace, result)
+}
@@ -0,0 +1,102 @@
+package txutil_test
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/tokencard/contracts/v3/pkg/txutil"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+// headBackend reports the head of the test chain, the simulated backend has no HeaderByNumber.
+type headBackend struct {
+	ethertest.TestBackend
+}
+
+func (h headBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return h.Blockchain().CurrentHeader(), nil
+}
+
+var _ = Describe("WaitForState", func() {
+
+	var ctx context.Context
+	var cancel context.CancelFunc
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	When("a transfer is mined after the wait started", func() {
+
+		var available *big.Int
+		var tx *types.Transaction
+
+		BeforeEach(func() {
+			BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(1))
+
+			var err error
+			available, err = WalletProxy.SpendLimitAvailable(nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			tx, err = WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(500000)), RandomAccount.Address(), common.Address{}, FinneyToWei(500))
+			Expect(err).ToNot(HaveOccurred())
+			go func() {
+				time.Sleep(50 * time.Millisecond)
+				Backend.Commit()
+			}()
+		})
+
+		It("should return once the wallet's spent amount increased", func() {
+			err := txutil.WaitForState(ctx, headBackend{Backend}, func(ctx context.Context) (bool, error) {
+				av, err := WalletProxy.SpendLimitAvailable(&bind.CallOpts{Context: ctx})
+				if err != nil {
+					return false, err
+				}
+				return av.Cmp(available) < 0, nil
+			}, 10*time.Millisecond)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(IsSuccessful(tx)).To(BeTrue())
+
+			av, err := WalletProxy.SpendLimitAvailable(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(av.String()).To(Equal(new(big.Int).Sub(available, FinneyToWei(500)).String()))
+		})
+	})
+
+	When("the state never changes", func() {
+		It("should check the predicate once and fail once the context is done", func() {
+			ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+
+			calls := 0
+			err := txutil.WaitForState(ctx, headBackend{Backend}, func(ctx context.Context) (bool, error) {
+				calls++
+				return false, nil
+			}, 10*time.Millisecond)
+			Expect(errors.Cause(err)).To(Equal(context.DeadlineExceeded))
+			Expect(calls).To(Equal(1))
+		})
+	})
+
+	When("the predicate fails", func() {
+		It("should return its error", func() {
+			failure := errors.New("boom")
+			err := txutil.WaitForState(ctx, headBackend{Backend}, func(ctx context.Context) (bool, error) {
+				return false, failure
+			}, 10*time.Millisecond)
+			Expect(errors.Cause(err)).To(Equal(failure))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package verify_test
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	"github.com/tokencard/contracts/v3/pkg/verify"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("ParserBytecodeMatches", func() {
+
+	When("the parser is deployed", func() {
+		var parserAddress common.Address
+
+		BeforeEach(func() {
+			var err error
+			parserAddress, _, _, err = mocks.DeployParseIntScientificExporter(BankAccount.TransactOpts(), Backend)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+		})
+
+		It("should match", func() {
+			ok, err := verify.ParserBytecodeMatches(context.Background(), Backend, parserAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should not match another contract's code", func() {
+			ok, err := verify.BytecodeMatches(context.Background(), Backend, parserAddress, mocks.TokenBin)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("another contract is deployed", func() {
+		It("should not match", func() {
+			ok, err := verify.ParserBytecodeMatches(context.Background(), Backend, StablecoinAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("there is no contract", func() {
+		It("should not match", func() {
+			ok, err := verify.ParserBytecodeMatches(context.Background(), Backend, RandomAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package walletclient_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/bindings/externals/upgradeability"
+	"github.com/tokencard/contracts/v3/pkg/wallet"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("CanAfford", func() {
+
+	When("the wallet holds 1 ETH", func() {
+		BeforeEach(func() {
+			BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(1))
+		})
+
+		It("should afford sending 1 ETH", func() {
+			ok, shortfall, err := WalletClient.CanAfford(context.Background(), common.Address{}, EthToWei(1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(shortfall.Token.String()).To(Equal("0"))
+			Expect(shortfall.Gas.String()).To(Equal("0"))
+		})
+
+		It("should not afford sending 3 ETH and report a 2 ETH shortfall", func() {
+			ok, shortfall, err := WalletClient.CanAfford(context.Background(), common.Address{}, EthToWei(3))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+			Expect(shortfall.Token.String()).To(Equal(EthToWei(2).String()))
+			Expect(shortfall.Gas.String()).To(Equal("0"))
+		})
+
+		When("the transacting account isn't the owner", func() {
+			It("should fail as the transfer would revert", func() {
+				c, err := wallet.NewClient(WalletProxyAddress, RandomAccount.TransactOpts(), Backend)
+				Expect(err).ToNot(HaveOccurred())
+				_, _, err = c.CanAfford(context.Background(), common.Address{}, EthToWei(1))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		When("the transfer exceeds the spend limit", func() {
+			BeforeEach(func() {
+				BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(100))
+			})
+
+			It("should fail as the transfer would revert", func() {
+				_, _, err := WalletClient.CanAfford(context.Background(), common.Address{}, EthToWei(101))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	When("the owner has no ETH for gas", func() {
+		var client *wallet.Client
+
+		BeforeEach(func() {
+			broke := ethertest.NewAccount()
+			implementationAddress, tx, _, err := bindings.DeployWallet(BankAccount.TransactOpts(), Backend)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+			proxyAddress, tx, _, err := upgradeability.DeployUpgradeabilityProxy(BankAccount.TransactOpts(), Backend, implementationAddress, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+			client, err = wallet.NewClient(proxyAddress, broke.TransactOpts(), Backend)
+			Expect(err).ToNot(HaveOccurred())
+			tx, err = client.InitializeWallet(BankAccount.TransactOpts(), broke.Address(), true, ENSRegistryAddress, TokenWhitelistName, ControllerName, LicenceName, EthToWei(100))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+			BankAccount.MustTransfer(Backend, proxyAddress, EthToWei(1))
+		})
+
+		It("should report the gas cost of the transfer as shortfall", func() {
+			ctx := context.Background()
+			ok, shortfall, err := client.CanAfford(ctx, common.Address{}, EthToWei(1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+			Expect(shortfall.Token.String()).To(Equal("0"))
+			Expect(shortfall.Gas.Sign()).To(Equal(1))
+		})
+	})
+
+	When("the wallet doesn't hold any of the token", func() {
+		It("should report the full amount as shortfall", func() {
+			ok, shortfall, err := WalletClient.CanAfford(context.Background(), ERC20Contract1Address, big.NewInt(1000))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+			Expect(shortfall.Token.String()).To(Equal("1000"))
+		})
+	})
+})
@@ -0,0 +1,98 @@
+package walletclient_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	"github.com/tokencard/contracts/v3/pkg/wallet"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("Execute", func() {
+
+	var token *mocks.Token
+	var tokenAddress common.Address
+	var approve []byte
+	var spender = common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// execute mines blocks while Execute waits for its transaction.
+	execute := func(target common.Address, data []byte, opts ...wallet.ExecuteOption) (*types.Receipt, error) {
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case <-time.After(50 * time.Millisecond):
+					Backend.Commit()
+				}
+			}
+		}()
+		return WalletClient.Execute(context.Background(), target, big.NewInt(0), data, opts...)
+	}
+
+	allowance := func() *big.Int {
+		a, err := token.Allowance(nil, WalletProxyAddress, spender)
+		Expect(err).ToNot(HaveOccurred())
+		return a
+	}
+
+	BeforeEach(func() {
+		var tx *types.Transaction
+		var err error
+		tokenAddress, tx, token, err = mocks.DeployToken(BankAccount.TransactOpts(), Backend)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		erc20, err := abi.JSON(strings.NewReader(mocks.TokenABI))
+		Expect(err).ToNot(HaveOccurred())
+		approve, err = erc20.Pack("approve", spender, big.NewInt(300))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should approve the spender through the wallet", func() {
+		r, err := execute(tokenAddress, approve)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(r.Status).To(Equal(types.ReceiptStatusSuccessful))
+		Expect(allowance().String()).To(Equal("300"))
+	})
+
+	When("it is a dry run", func() {
+		It("should not send the transaction", func() {
+			r, err := execute(tokenAddress, approve, wallet.WithDryRun())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(r).To(BeNil())
+			Expect(allowance().String()).To(Equal("0"))
+		})
+	})
+
+	When("the confirmation is declined", func() {
+		It("should not send the transaction", func() {
+			var estimated uint64
+			_, err := execute(tokenAddress, approve, wallet.WithConfirm(func(gas uint64) bool {
+				estimated = gas
+				return false
+			}))
+			Expect(err).To(Equal(wallet.ErrNotConfirmed))
+			Expect(estimated).ToNot(BeZero())
+			Expect(allowance().String()).To(Equal("0"))
+		})
+	})
+
+	When("the target is the zero address", func() {
+		It("should fail", func() {
+			_, err := execute(common.Address{}, approve)
+			Expect(err).To(MatchError("zero target address"))
+		})
+	})
+})
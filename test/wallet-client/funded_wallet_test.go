@@ -0,0 +1,31 @@
+package walletclient_test
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("funded wallet", func() {
+
+	It("should be ready to transfer out of", func() {
+		client, address, err := NewFundedWallet(RandomAccount.TransactOpts(), EthToWei(3))
+		Expect(err).ToNot(HaveOccurred())
+
+		balance, err := Backend.BalanceAt(context.Background(), address, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(balance.String()).To(Equal(EthToWei(3).String()))
+
+		tx, err := client.Transfer(RandomAccount.TransactOpts(), Controller.Address(), common.Address{}, EthToWei(1))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		balance, err = Backend.BalanceAt(context.Background(), address, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(balance.String()).To(Equal(EthToWei(2).String()))
+	})
+})
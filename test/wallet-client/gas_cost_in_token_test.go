@@ -0,0 +1,93 @@
+package walletclient_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("GasCostInToken", func() {
+
+	var tkn = common.HexToAddress("0x1")
+	var gasPrice *big.Int
+
+	BeforeEach(func() {
+		tx, err := TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{tkn},
+			StringsToByte32("TKN"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(8))},
+			[]bool{true},
+			[]bool{false},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		gasPrice, err = Backend.SuggestGasPrice(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	When("the cost is asked in ETH", func() {
+		It("should be the gas times the gas price", func() {
+			cost, err := WalletClient.GasCostInToken(context.Background(), common.Address{}, 100000)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cost.String()).To(Equal(new(big.Int).Mul(gasPrice, big.NewInt(100000)).String()))
+		})
+	})
+
+	When("the token has no rate", func() {
+		It("should fail", func() {
+			_, err := WalletClient.GasCostInToken(context.Background(), tkn, 100000)
+			Expect(err).To(MatchError("token " + tkn.Hex() + " has no rate"))
+		})
+	})
+
+	When("the token isn't whitelisted", func() {
+		It("should fail", func() {
+			t := common.HexToAddress("0x2")
+			_, err := WalletClient.GasCostInToken(context.Background(), t, 100000)
+			Expect(err).To(MatchError("token " + t.Hex() + " isn't whitelisted"))
+		})
+	})
+
+	When("a whole token is worth 1 gwei", func() {
+		BeforeEach(func() {
+			tx, err := TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), tkn, big.NewInt(1000000000), big.NewInt(20180913153212))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should convert the cost to base units of the token", func() {
+			cost, err := WalletClient.GasCostInToken(context.Background(), tkn, 100000)
+			Expect(err).ToNot(HaveOccurred())
+			// 100000 gas * gas price wei, with 10^8 base units per 10^9 wei.
+			expected := new(big.Int).Mul(gasPrice, big.NewInt(100000*100000000))
+			expected.Div(expected, big.NewInt(1000000000))
+			Expect(cost.String()).To(Equal(expected.String()))
+		})
+	})
+
+	When("the conversion isn't exact", func() {
+		BeforeEach(func() {
+			tx, err := TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), tkn, big.NewInt(3000000000), big.NewInt(20180913153212))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should round the cost up", func() {
+			cost, err := WalletClient.GasCostInToken(context.Background(), tkn, 100000)
+			Expect(err).ToNot(HaveOccurred())
+			exact := new(big.Rat).SetFrac(new(big.Int).Mul(gasPrice, big.NewInt(100000*100000000)), big.NewInt(3000000000))
+			Expect(new(big.Rat).SetInt(cost).Cmp(exact)).To(Equal(1))
+			Expect(new(big.Rat).SetInt(new(big.Int).Sub(cost, big.NewInt(1))).Cmp(exact)).To(Equal(-1))
+		})
+	})
+})
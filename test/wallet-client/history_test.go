@@ -0,0 +1,82 @@
+package walletclient_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/wallet"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("History", func() {
+
+	var blocks []uint64
+
+	commit := func(tx *types.Transaction, err error) {
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+		blocks = append(blocks, Backend.Blockchain().CurrentBlock().NumberU64())
+	}
+
+	BeforeEach(func() {
+		blocks = nil
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(10))
+		tx, err := ERC20Contract1.Credit(BankAccount.TransactOpts(), WalletProxyAddress, big.NewInt(1000))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		commit(WalletClient.Transfer(Owner.TransactOpts(), RandomAccount.Address(), common.Address{}, EthToWei(1)))
+		commit(WalletClient.Transfer(Owner.TransactOpts(), BankAccount.Address(), ERC20Contract1Address, big.NewInt(300)))
+		commit(WalletClient.TopUpGas(Owner.TransactOpts(), FinneyToWei(1)))
+		commit(WalletClient.Transfer(Owner.TransactOpts(), RandomAccount.Address(), common.Address{}, EthToWei(2)))
+	})
+
+	It("should return every transfer in order", func() {
+		h, err := WalletClient.History(context.Background(), 0, blocks[3])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(h).To(HaveLen(4))
+
+		Expect(h[0].Kind).To(Equal(wallet.KindTransfer))
+		Expect(h[0].To).To(Equal(RandomAccount.Address()))
+		Expect(h[0].Asset).To(Equal(common.Address{}))
+		Expect(h[0].Amount.String()).To(Equal(EthToWei(1).String()))
+		Expect(h[0].BlockNumber).To(Equal(blocks[0]))
+
+		Expect(h[1].Kind).To(Equal(wallet.KindTransfer))
+		Expect(h[1].To).To(Equal(BankAccount.Address()))
+		Expect(h[1].Asset).To(Equal(ERC20Contract1Address))
+		Expect(h[1].Amount.String()).To(Equal("300"))
+		Expect(h[1].BlockNumber).To(Equal(blocks[1]))
+
+		Expect(h[2].Kind).To(Equal(wallet.KindGasTopUp))
+		Expect(h[2].To).To(Equal(Owner.Address()))
+		Expect(h[2].Amount.String()).To(Equal(FinneyToWei(1).String()))
+		Expect(h[2].BlockNumber).To(Equal(blocks[2]))
+
+		Expect(h[3].Kind).To(Equal(wallet.KindTransfer))
+		Expect(h[3].Amount.String()).To(Equal(EthToWei(2).String()))
+		Expect(h[3].BlockNumber).To(Equal(blocks[3]))
+		Expect(h[3].TxHash).ToNot(Equal(common.Hash{}))
+	})
+
+	It("should only return the transfers within the block range", func() {
+		h, err := WalletClient.History(context.Background(), blocks[1], blocks[2])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(h).To(HaveLen(2))
+		Expect(h[0].Asset).To(Equal(ERC20Contract1Address))
+		Expect(h[1].Kind).To(Equal(wallet.KindGasTopUp))
+	})
+
+	When("the block range is inverted", func() {
+		It("should fail", func() {
+			_, err := WalletClient.History(context.Background(), 2, 1)
+			Expect(err).To(MatchError("invalid block range 2-1"))
+		})
+	})
+})
@@ -0,0 +1,111 @@
+package walletclient_test
+
+import (
+	"context"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/wallet"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("MaxTransferable", func() {
+
+	It("should leave the owner with next to nothing after topping up the wallet", func() {
+		ctx := context.Background()
+		max, err := WalletClient.MaxTransferable(ctx, WalletProxyAddress)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(max.Sign()).To(Equal(1))
+
+		to := WalletProxyAddress
+		gas, err := Backend.EstimateGas(ctx, ethereum.CallMsg{From: Owner.Address(), To: &to, Value: max})
+		Expect(err).ToNot(HaveOccurred())
+		gasPrice, err := Backend.SuggestGasPrice(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		nonce, err := Backend.PendingNonceAt(ctx, Owner.Address())
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := Owner.TransactOpts()
+		tx, err := opts.Signer(types.HomesteadSigner{}, Owner.Address(), types.NewTransaction(nonce, to, max, gas, gasPrice, nil))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(Backend.SendTransaction(ctx, tx)).To(Succeed())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		remaining, err := Backend.BalanceAt(ctx, Owner.Address(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(remaining.Cmp(new(big.Int).Mul(gasPrice, big.NewInt(wallet.DefaultTransferGas)))).To(Equal(-1))
+	})
+
+	// maxTransferable is the owner's balance less the gas of sending that remainder to the given address.
+	maxTransferable := func(to common.Address) string {
+		ctx := context.Background()
+		balance, err := Backend.BalanceAt(ctx, Owner.Address(), nil)
+		Expect(err).ToNot(HaveOccurred())
+		gasPrice, err := Backend.SuggestGasPrice(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		gas, err := Backend.EstimateGas(ctx, ethereum.CallMsg{From: Owner.Address(), To: &to, Value: big.NewInt(1)})
+		Expect(err).ToNot(HaveOccurred())
+		return new(big.Int).Sub(balance, new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gas))).String()
+	}
+
+	When("the destination isn't whitelisted by the wallet", func() {
+		It("should return the balance less the gas of a plain transfer", func() {
+			to := RandomAccount.Address()
+			max, err := WalletClient.MaxTransferable(context.Background(), to)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(max.String()).To(Equal(maxTransferable(to)))
+
+			gasPrice, err := Backend.SuggestGasPrice(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			balance := Owner.Balance(Backend)
+			Expect(max.String()).To(Equal(new(big.Int).Sub(balance, new(big.Int).Mul(gasPrice, big.NewInt(21000))).String()))
+		})
+	})
+
+	When("the destination is whitelisted by the wallet", func() {
+		BeforeEach(func() {
+			tx, err := WalletClient.SetWhitelist(Owner.TransactOpts(), []common.Address{RandomAccount.Address()})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should return the same amount, as the owner sends it directly", func() {
+			to := RandomAccount.Address()
+			max, err := WalletClient.MaxTransferable(context.Background(), to)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(max.String()).To(Equal(maxTransferable(to)))
+
+			gasPrice, err := Backend.SuggestGasPrice(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			balance := Owner.Balance(Backend)
+			Expect(max.String()).To(Equal(new(big.Int).Sub(balance, new(big.Int).Mul(gasPrice, big.NewInt(21000))).String()))
+		})
+	})
+
+	When("the destination is the wallet", func() {
+		It("should return the balance less the gas of topping it up", func() {
+			max, err := WalletClient.MaxTransferable(context.Background(), WalletProxyAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(max.String()).To(Equal(maxTransferable(WalletProxyAddress)))
+		})
+	})
+
+	When("the owner can't even pay for the gas", func() {
+		It("should return zero", func() {
+			broke := ethertest.NewAccount()
+			client, err := wallet.NewClient(WalletProxyAddress, broke.TransactOpts(), Backend)
+			Expect(err).ToNot(HaveOccurred())
+
+			max, err := client.MaxTransferable(context.Background(), WalletProxyAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(max.String()).To(Equal("0"))
+		})
+	})
+})
@@ -0,0 +1,36 @@
+package walletclient_test
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("Owners", func() {
+
+	It("should return the owner with a threshold of 1", func() {
+		owners, threshold, err := WalletClient.Owners(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(owners).To(Equal([]common.Address{Owner.Address()}))
+		Expect(threshold).To(Equal(uint64(1)))
+	})
+
+	When("the ownership is transferred", func() {
+		BeforeEach(func() {
+			tx, err := WalletClient.TransferOwnership(Owner.TransactOpts(), RandomAccount.Address(), false)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should return the new owner", func() {
+			owners, threshold, err := WalletClient.Owners(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(owners).To(Equal([]common.Address{RandomAccount.Address()}))
+			Expect(threshold).To(Equal(uint64(1)))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package walletclient_test
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/wallet/sigverify"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("personal sign", func() {
+
+	message := []byte("Sign in to Monolith, nonce: 42")
+
+	It("should recover the signer", func() {
+		sig, err := sigverify.PersonalSign(crypto.FromECDSA(Owner.PrivKey()), message)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sig).To(HaveLen(65))
+		Expect(sig[64]).To(BeNumerically(">=", 27))
+
+		signer, err := sigverify.RecoverPersonal(message, sig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(signer).To(Equal(Owner.Address()))
+	})
+
+	It("should recover signatures with a 0/1 recovery id", func() {
+		sig, err := crypto.Sign(sigverify.PersonalHash(message), Owner.PrivKey())
+		Expect(err).ToNot(HaveOccurred())
+
+		signer, err := sigverify.RecoverPersonal(message, sig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(signer).To(Equal(Owner.Address()))
+	})
+
+	It("should not recover the signer of a different message", func() {
+		sig, err := sigverify.PersonalSign(crypto.FromECDSA(Owner.PrivKey()), message)
+		Expect(err).ToNot(HaveOccurred())
+
+		signer, err := sigverify.RecoverPersonal([]byte("Sign in to Monolith, nonce: 43"), sig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(signer).ToNot(Equal(Owner.Address()))
+	})
+
+	It("should reject signatures of the wrong length", func() {
+		_, err := sigverify.RecoverPersonal(message, make([]byte, 64))
+		Expect(err).To(MatchError("invalid signature length 64"))
+	})
+
+	When("the owner signs the message", func() {
+		It("should be an owner signature", func() {
+			sig, err := sigverify.PersonalSign(crypto.FromECDSA(Owner.PrivKey()), message)
+			Expect(err).ToNot(HaveOccurred())
+
+			ok, err := WalletClient.IsOwnerSignature(context.Background(), message, sig)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+		})
+	})
+
+	When("a random account signs the message", func() {
+		It("should not be an owner signature", func() {
+			sig, err := sigverify.PersonalSign(crypto.FromECDSA(RandomAccount.PrivKey()), message)
+			Expect(err).ToNot(HaveOccurred())
+
+			ok, err := WalletClient.IsOwnerSignature(context.Background(), message, sig)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
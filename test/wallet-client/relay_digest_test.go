@@ -0,0 +1,77 @@
+package walletclient_test
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/wallet/relay"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("relay Digest", func() {
+
+	var data []byte
+
+	sign := func(domain relay.Domain) []byte {
+		digest, err := relay.Digest(domain, relay.Operation{Nonce: big.NewInt(0), Data: data})
+		Expect(err).ToNot(HaveOccurred())
+		sig, err := crypto.Sign(digest[:], Owner.PrivKey())
+		Expect(err).ToNot(HaveOccurred())
+		sig[64] += 27
+		return sig
+	}
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(1))
+		a, err := abi.JSON(strings.NewReader(bindings.WalletABI))
+		Expect(err).ToNot(HaveOccurred())
+		data, err = a.Pack("transfer", RandomAccount.Address(), common.Address{}, EthToWei(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should match the digest verified by the wallet", func() {
+		sig := sign(relay.Domain{ChainID: big.NewInt(1337), VerifyingContract: WalletProxyAddress})
+		tx, err := WalletClient.ExecuteRelayedTransaction(Controller.TransactOpts(ethertest.WithGasLimit(500000)), big.NewInt(0), data, sig)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		nonce, err := WalletClient.RelayNonce(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nonce.String()).To(Equal("1"))
+	})
+
+	When("the digest is computed for another chain", func() {
+		It("should be rejected by the wallet", func() {
+			sig := sign(relay.Domain{ChainID: big.NewInt(1), VerifyingContract: WalletProxyAddress})
+			tx, err := WalletClient.ExecuteRelayedTransaction(Controller.TransactOpts(ethertest.WithGasLimit(500000)), big.NewInt(0), data, sig)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the digest is computed for another wallet", func() {
+		It("should be rejected by the wallet", func() {
+			sig := sign(relay.Domain{ChainID: big.NewInt(1337), VerifyingContract: RandomAccount.Address()})
+			tx, err := WalletClient.ExecuteRelayedTransaction(Controller.TransactOpts(ethertest.WithGasLimit(500000)), big.NewInt(0), data, sig)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the nonce is missing", func() {
+		It("should fail", func() {
+			_, err := relay.Digest(relay.Domain{ChainID: big.NewInt(1337)}, relay.Operation{Data: data})
+			Expect(err).To(MatchError("invalid nonce"))
+		})
+	})
+})
@@ -0,0 +1,33 @@
+package walletclient_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/wallet"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var WalletClient *wallet.Client
+var WalletProxyAddress common.Address
+
+func TestWalletClientSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Wallet Client Suite")
+}
+
+var _ = BeforeEach(func() {
+	err := InitializeBackend()
+	Expect(err).ToNot(HaveOccurred())
+
+	WalletClient, WalletProxyAddress, err = NewFundedWallet(Owner.TransactOpts(), big.NewInt(0))
+	Expect(err).ToNot(HaveOccurred())
+})
+
+var _ = AfterEach(func() {
+	err := Backend.Close()
+	Expect(err).ToNot(HaveOccurred())
+})
@@ -0,0 +1,287 @@
+package wallet_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("autoConvert", func() {
+
+	var altAddress, stableAddress, dexAddress common.Address
+	var alt, stable *mocks.Token
+
+	BeforeEach(func() {
+		var err error
+		altAddress, _, alt, err = mocks.DeployToken(BankAccount.TransactOpts(), Backend)
+		Expect(err).ToNot(HaveOccurred())
+		stableAddress, _, stable, err = mocks.DeployToken(BankAccount.TransactOpts(), Backend)
+		Expect(err).ToNot(HaveOccurred())
+		dexAddress, _, _, err = mocks.DeployDexAdapter(BankAccount.TransactOpts(), Backend)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+	})
+
+	setAutoConvert := func() {
+		tx, err := WalletProxy.SetAutoConvert(Owner.TransactOpts(), altAddress, dexAddress, stableAddress)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	}
+
+	deposit := func(amount int64) {
+		tx, err := alt.Credit(BankAccount.TransactOpts(), WalletProxyAddress, big.NewInt(amount))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = WalletProxy.RecordDeposit(RandomAccount.TransactOpts(ethertest.WithGasLimit(1000000)), altAddress)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	}
+
+	balanceOf := func(token *mocks.Token, owner common.Address) string {
+		b, err := token.BalanceOf(nil, owner)
+		Expect(err).ToNot(HaveOccurred())
+		return b.String()
+	}
+
+	It("should be disabled by default", func() {
+		conversion, err := WalletProxy.AutoConvert(nil, altAddress)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conversion.DexAdapter).To(Equal(common.Address{}))
+
+		deposit(100)
+		Expect(balanceOf(alt, WalletProxyAddress)).To(Equal("100"))
+	})
+
+	When("the exchange is not whitelisted", func() {
+		It("should fail to set the conversion", func() {
+			tx, err := WalletProxy.SetAutoConvert(Owner.TransactOpts(ethertest.WithGasLimit(100000)), altAddress, dexAddress, stableAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("exchange not whitelisted"))
+		})
+	})
+
+	When("the exchange is whitelisted", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{dexAddress})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		When("a random account tries to set the conversion", func() {
+			It("should fail", func() {
+				tx, err := WalletProxy.SetAutoConvert(RandomAccount.TransactOpts(ethertest.WithGasLimit(100000)), altAddress, dexAddress, stableAddress)
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeFalse())
+			})
+		})
+
+		When("the owner sets the token to be converted to itself", func() {
+			It("should fail", func() {
+				tx, err := WalletProxy.SetAutoConvert(Owner.TransactOpts(ethertest.WithGasLimit(100000)), altAddress, dexAddress, altAddress)
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeFalse())
+				returnData, _ := ethCall(tx)
+				Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("invalid target token"))
+			})
+		})
+
+		When("the owner sets the token to be converted to the stablecoin", func() {
+			BeforeEach(setAutoConvert)
+
+			It("should emit a SetAutoConvert event", func() {
+				it, err := WalletProxy.FilterSetAutoConvert(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(it.Next()).To(BeTrue())
+				evt := it.Event
+				Expect(it.Next()).To(BeFalse())
+				Expect(evt.Sender).To(Equal(Owner.Address()))
+				Expect(evt.Token).To(Equal(altAddress))
+				Expect(evt.DexAdapter).To(Equal(dexAddress))
+				Expect(evt.TargetToken).To(Equal(stableAddress))
+			})
+
+			It("should expose the conversion", func() {
+				conversion, err := WalletProxy.AutoConvert(nil, altAddress)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(conversion.DexAdapter).To(Equal(dexAddress))
+				Expect(conversion.TargetToken).To(Equal(stableAddress))
+			})
+
+			When("a random account calls convertDeposit", func() {
+				It("should fail", func() {
+					tx, err := WalletProxy.ConvertDeposit(RandomAccount.TransactOpts(ethertest.WithGasLimit(300000)), altAddress, big.NewInt(1))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeFalse())
+					returnData, _ := ethCall(tx)
+					Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("only self"))
+				})
+			})
+
+			When("the exchange holds enough of the stablecoin", func() {
+				BeforeEach(func() {
+					tx, err := stable.Credit(BankAccount.TransactOpts(), dexAddress, big.NewInt(1000))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+
+					deposit(100)
+				})
+
+				It("should convert the deposit", func() {
+					Expect(balanceOf(alt, WalletProxyAddress)).To(Equal("0"))
+					Expect(balanceOf(stable, WalletProxyAddress)).To(Equal("100"))
+					Expect(balanceOf(alt, dexAddress)).To(Equal("100"))
+				})
+
+				It("should emit an AutoConverted event", func() {
+					it, err := WalletProxy.FilterAutoConverted(nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(it.Next()).To(BeTrue())
+					evt := it.Event
+					Expect(it.Next()).To(BeFalse())
+					Expect(evt.Token).To(Equal(altAddress))
+					Expect(evt.TargetToken).To(Equal(stableAddress))
+					Expect(evt.Amount.String()).To(Equal("100"))
+					Expect(evt.Received.String()).To(Equal("100"))
+				})
+
+				It("should not leave an allowance to the exchange", func() {
+					allowance, err := alt.Allowance(nil, WalletProxyAddress, dexAddress)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(allowance.String()).To(Equal("0"))
+				})
+
+				When("the owner disables the conversion", func() {
+					BeforeEach(func() {
+						tx, err := WalletProxy.SetAutoConvert(Owner.TransactOpts(), altAddress, common.Address{}, common.Address{})
+						Expect(err).ToNot(HaveOccurred())
+						Backend.Commit()
+						Expect(isSuccessful(tx)).To(BeTrue())
+
+						deposit(50)
+					})
+
+					It("should leave later deposits untouched", func() {
+						Expect(balanceOf(alt, WalletProxyAddress)).To(Equal("50"))
+						Expect(balanceOf(stable, WalletProxyAddress)).To(Equal("100"))
+					})
+				})
+			})
+
+			When("deposits of the stablecoin are held", func() {
+				BeforeEach(func() {
+					tx, err := TokenWhitelist.AddTokens(
+						ControllerAdmin.TransactOpts(),
+						[]common.Address{stableAddress},
+						StringsToByte32("USD"),
+						[]*big.Int{DecimalsToMagnitude(big.NewInt(0))},
+						[]bool{true},
+						[]bool{true},
+						big.NewInt(20180913153211),
+					)
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+
+					tx, err = TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), stableAddress, EthToWei(1), big.NewInt(20180913153211))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+
+					tx, err = WalletProxy.SetDepositHold(Controller.TransactOpts(), EthToWei(5), big.NewInt(3600))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+
+					tx, err = stable.Credit(BankAccount.TransactOpts(), dexAddress, big.NewInt(1000))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+
+					deposit(50)
+				})
+
+				It("should not hold the proceeds of the conversion", func() {
+					held, err := WalletProxy.HeldBalance(nil, stableAddress)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(held.String()).To(Equal("0"))
+				})
+
+				It("should sweep the proceeds of the conversion", func() {
+					tx, err := WalletProxy.SweepDust(Owner.TransactOpts(ethertest.WithGasLimit(500000)), []common.Address{stableAddress}, RandomAccount.Address(), big.NewInt(50))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+					Expect(balanceOf(stable, WalletProxyAddress)).To(Equal("0"))
+					Expect(balanceOf(stable, RandomAccount.Address())).To(Equal("50"))
+				})
+
+				It("should hold a later deposit of the stablecoin", func() {
+					tx, err := stable.Credit(BankAccount.TransactOpts(), WalletProxyAddress, big.NewInt(10))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+
+					held, err := WalletProxy.HeldBalance(nil, stableAddress)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(held.String()).To(Equal("10"))
+				})
+			})
+
+			When("the exchange runs out of the stablecoin", func() {
+				BeforeEach(func() {
+					deposit(100)
+				})
+
+				It("should leave the deposit untouched", func() {
+					Expect(balanceOf(alt, WalletProxyAddress)).To(Equal("100"))
+					Expect(balanceOf(stable, WalletProxyAddress)).To(Equal("0"))
+
+					allowance, err := alt.Allowance(nil, WalletProxyAddress, dexAddress)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(allowance.String()).To(Equal("0"))
+				})
+
+				It("should emit an AutoConvertFailed event", func() {
+					it, err := WalletProxy.FilterAutoConvertFailed(nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(it.Next()).To(BeTrue())
+					evt := it.Event
+					Expect(it.Next()).To(BeFalse())
+					Expect(evt.Token).To(Equal(altAddress))
+					Expect(evt.Amount.String()).To(Equal("100"))
+				})
+			})
+
+			When("recordDeposit is called with too little gas to convert", func() {
+				It("should fail rather than skip the conversion", func() {
+					tx, err := alt.Credit(BankAccount.TransactOpts(), WalletProxyAddress, big.NewInt(100))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+
+					tx, err = WalletProxy.RecordDeposit(RandomAccount.TransactOpts(ethertest.WithGasLimit(200000)), altAddress)
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeFalse())
+				})
+			})
+		})
+	})
+})
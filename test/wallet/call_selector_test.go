@@ -0,0 +1,113 @@
+package wallet_test
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("allowCallSelector", func() {
+
+	var erc20 abi.ABI
+	var approveSelector, transferSelector [4]byte
+
+	execute := func(method string, args ...interface{}) *types.Transaction {
+		data, err := erc20.Pack(method, args...)
+		Expect(err).ToNot(HaveOccurred())
+		tx, err := WalletProxy.ExecuteTransaction(Owner.TransactOpts(ethertest.WithGasLimit(200000)), RandomTokenAddress, big.NewInt(0), data)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		return tx
+	}
+
+	BeforeEach(func() {
+		var err error
+		erc20, err = abi.JSON(strings.NewReader(ERC20ABI))
+		Expect(err).ToNot(HaveOccurred())
+		copy(approveSelector[:], erc20.Methods["approve"].ID())
+		copy(transferSelector[:], erc20.Methods["transfer"].ID())
+
+		tx, err := RandomToken.Credit(BankAccount.TransactOpts(), WalletProxyAddress, big.NewInt(1000))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	When("no selector is allowed on the target", func() {
+		It("should allow any selector", func() {
+			allowed, err := WalletProxy.IsCallSelectorAllowed(nil, RandomTokenAddress, transferSelector)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(BeTrue())
+			Expect(isSuccessful(execute("transfer", RandomAccount.Address(), big.NewInt(300)))).To(BeTrue())
+		})
+	})
+
+	When("a non-controller account tries to allow a selector", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.AllowCallSelector(Owner.TransactOpts(ethertest.WithGasLimit(100000)), RandomTokenAddress, approveSelector)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the controller allows the 'approve' selector on the target", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.AllowCallSelector(Controller.TransactOpts(), RandomTokenAddress, approveSelector)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit an AllowedCallSelector event", func() {
+			it, err := WalletProxy.FilterAllowedCallSelector(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Controller.Address()))
+			Expect(evt.Target).To(Equal(RandomTokenAddress))
+			Expect(evt.Selector).To(Equal(approveSelector))
+		})
+
+		It("should permit calling 'approve'", func() {
+			Expect(isSuccessful(execute("approve", RandomAccount.Address(), big.NewInt(300)))).To(BeTrue())
+			allowance, err := RandomToken.Allowance(nil, WalletProxyAddress, RandomAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowance.String()).To(Equal("300"))
+		})
+
+		It("should reject calling 'transfer'", func() {
+			tx := execute("transfer", RandomAccount.Address(), big.NewInt(300))
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("selector not allowed"))
+		})
+
+		It("should fail to allow the same selector again", func() {
+			tx, err := WalletProxy.AllowCallSelector(Controller.TransactOpts(ethertest.WithGasLimit(100000)), RandomTokenAddress, approveSelector)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+
+		When("the owner disallows the selector again", func() {
+			BeforeEach(func() {
+				tx, err := WalletProxy.DisallowCallSelector(Owner.TransactOpts(), RandomTokenAddress, approveSelector)
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should allow any selector again", func() {
+				Expect(isSuccessful(execute("transfer", RandomAccount.Address(), big.NewInt(300)))).To(BeTrue())
+			})
+		})
+	})
+})
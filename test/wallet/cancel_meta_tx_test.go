@@ -0,0 +1,106 @@
+package wallet_test
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("cancelMetaTx", func() {
+
+	var data []byte
+
+	relay := func(nonce *big.Int) bool {
+		signature, err := SignData(big.NewInt(1337), WalletProxyAddress, nonce, data, Owner.PrivKey())
+		Expect(err).ToNot(HaveOccurred())
+		tx, err := WalletProxy.ExecuteRelayedTransaction(Controller.TransactOpts(ethertest.WithGasLimit(500000)), nonce, data, signature)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		return isSuccessful(tx)
+	}
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(2))
+
+		a, err := abi.JSON(strings.NewReader(WALLET_ABI))
+		Expect(err).ToNot(HaveOccurred())
+		data, err = a.Pack("transfer", RandomAccount.Address(), [20]byte{}, EthToWei(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	When("a non-owner account tries to cancel a nonce", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.CancelMetaTx(Controller.TransactOpts(ethertest.WithGasLimit(60000)), big.NewInt(0))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the owner cancels the current nonce", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.CancelMetaTx(Owner.TransactOpts(), big.NewInt(0))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit an IncreasedRelayNonce event", func() {
+			it, err := WalletProxy.FilterIncreasedRelayNonce(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Owner.Address()))
+			Expect(evt.CurrentNonce.String()).To(Equal("1"))
+		})
+
+		It("should reject the transaction signed with the cancelled nonce", func() {
+			Expect(relay(big.NewInt(0))).To(BeFalse())
+		})
+
+		It("should accept a transaction signed with the next nonce", func() {
+			Expect(relay(big.NewInt(1))).To(BeTrue())
+		})
+	})
+
+	When("the owner cancels a later nonce", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.CancelMetaTx(Owner.TransactOpts(), big.NewInt(2))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should move the relay nonce past it", func() {
+			nonce, err := WalletProxy.RelayNonce(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nonce.String()).To(Equal("3"))
+		})
+
+		It("should reject the transactions signed with a nonce up to it", func() {
+			Expect(relay(big.NewInt(0))).To(BeFalse())
+			Expect(relay(big.NewInt(2))).To(BeFalse())
+		})
+	})
+
+	When("the owner cancels a nonce that was already used", func() {
+		BeforeEach(func() {
+			Expect(relay(big.NewInt(0))).To(BeTrue())
+		})
+
+		It("should fail", func() {
+			tx, err := WalletProxy.CancelMetaTx(Owner.TransactOpts(ethertest.WithGasLimit(60000)), big.NewInt(0))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("nonce already used"))
+		})
+	})
+})
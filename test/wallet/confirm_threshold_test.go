@@ -0,0 +1,307 @@
+package wallet_test
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("confirmThreshold", func() {
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(10))
+	})
+
+	It("should be disabled by default", func() {
+		th, err := WalletProxy.ConfirmThreshold(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(th.String()).To(Equal("0"))
+	})
+
+	When("a random person tries to set the threshold", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SetConfirmThreshold(RandomAccount.TransactOpts(ethertest.WithGasLimit(100000)), EthToWei(1))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the owner sets the threshold to 1 ETH", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetConfirmThreshold(Owner.TransactOpts(), EthToWei(1))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SetConfirmThreshold event", func() {
+			it, err := WalletProxy.FilterSetConfirmThreshold(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Owner.Address()))
+			Expect(evt.Amount.String()).To(Equal(EthToWei(1).String()))
+		})
+
+		It("should transfer 1 ETH immediately", func() {
+			tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(100000)), RandomAccount.Address(), common.Address{}, EthToWei(1))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should reject a direct transfer of 2 ETH", func() {
+			tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(100000)), RandomAccount.Address(), common.Address{}, EthToWei(2))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("confirmation required"))
+		})
+
+		It("should reject sending 2 ETH with executeTransaction", func() {
+			tx, err := WalletProxy.ExecuteTransaction(Owner.TransactOpts(ethertest.WithGasLimit(200000)), RandomAccount.Address(), EthToWei(2), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("confirmation required"))
+		})
+
+		When("the wallet has tokens worth more than the threshold", func() {
+			BeforeEach(func() {
+				// 10^13 base units of TKN are worth 1.633 ETH.
+				tx, err := TKNBurner.Mint(BankAccount.TransactOpts(), WalletProxyAddress, big.NewInt(10000000000000))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should reject transferring them with executeTransaction", func() {
+				erc20, err := abi.JSON(strings.NewReader(ERC20ABI))
+				Expect(err).ToNot(HaveOccurred())
+				data, err := erc20.Pack("transfer", RandomAccount.Address(), big.NewInt(10000000000000))
+				Expect(err).ToNot(HaveOccurred())
+				tx, err := WalletProxy.ExecuteTransaction(Owner.TransactOpts(ethertest.WithGasLimit(500000)), TKNBurnerAddress, big.NewInt(0), data)
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeFalse())
+				returnData, _ := ethCall(tx)
+				Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("confirmation required"))
+			})
+		})
+
+		When("the owner lowers the threshold to 500 Finney", func() {
+			BeforeEach(func() {
+				tx, err := WalletProxy.SetConfirmThreshold(Owner.TransactOpts(), FinneyToWei(500))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should apply it immediately", func() {
+				th, err := WalletProxy.ConfirmThreshold(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(th.String()).To(Equal(FinneyToWei(500).String()))
+			})
+		})
+
+		When("the owner disables the threshold", func() {
+			BeforeEach(func() {
+				tx, err := WalletProxy.SetConfirmThreshold(Owner.TransactOpts(), big.NewInt(0))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should emit a SubmittedConfirmThresholdUpdate event", func() {
+				it, err := WalletProxy.FilterSubmittedConfirmThresholdUpdate(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(it.Next()).To(BeTrue())
+				evt := it.Event
+				Expect(it.Next()).To(BeFalse())
+				Expect(evt.Amount.String()).To(Equal("0"))
+			})
+
+			It("should keep the threshold until the controller confirms", func() {
+				th, err := WalletProxy.ConfirmThreshold(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(th.String()).To(Equal(EthToWei(1).String()))
+				submitted, err := WalletProxy.ConfirmThresholdUpdateSubmitted(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(submitted).To(BeTrue())
+			})
+
+			It("should still reject a direct transfer of 2 ETH", func() {
+				tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(100000)), RandomAccount.Address(), common.Address{}, EthToWei(2))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeFalse())
+			})
+
+			When("the owner tries to confirm it", func() {
+				It("should fail", func() {
+					tx, err := WalletProxy.ConfirmConfirmThresholdUpdate(Owner.TransactOpts(ethertest.WithGasLimit(100000)), big.NewInt(0))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeFalse())
+				})
+			})
+
+			When("the controller confirms a different threshold", func() {
+				It("should fail", func() {
+					tx, err := WalletProxy.ConfirmConfirmThresholdUpdate(Controller.TransactOpts(ethertest.WithGasLimit(100000)), EthToWei(5))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeFalse())
+					returnData, _ := ethCall(tx)
+					Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("confirmed/submitted threshold mismatch"))
+				})
+			})
+
+			When("the controller confirms it", func() {
+				BeforeEach(func() {
+					tx, err := WalletProxy.ConfirmConfirmThresholdUpdate(Controller.TransactOpts(), big.NewInt(0))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+				})
+
+				It("should disable the threshold", func() {
+					th, err := WalletProxy.ConfirmThreshold(nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(th.String()).To(Equal("0"))
+					submitted, err := WalletProxy.ConfirmThresholdUpdateSubmitted(nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(submitted).To(BeFalse())
+				})
+
+				It("should transfer 2 ETH immediately", func() {
+					tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(100000)), RandomAccount.Address(), common.Address{}, EthToWei(2))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+				})
+			})
+		})
+
+		When("the owner raises the threshold to 5 ETH", func() {
+			BeforeEach(func() {
+				tx, err := WalletProxy.SetConfirmThreshold(Owner.TransactOpts(), EthToWei(5))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should only apply it once the controller confirms", func() {
+				th, err := WalletProxy.ConfirmThreshold(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(th.String()).To(Equal(EthToWei(1).String()))
+
+				tx, err := WalletProxy.ConfirmConfirmThresholdUpdate(Controller.TransactOpts(), EthToWei(5))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+
+				th, err = WalletProxy.ConfirmThreshold(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(th.String()).To(Equal(EthToWei(5).String()))
+			})
+		})
+
+		When("the owner initiates a transfer of 2 ETH", func() {
+			var id [32]byte
+
+			BeforeEach(func() {
+				tx, err := WalletProxy.InitiateTransfer(Owner.TransactOpts(), RandomAccount.Address(), common.Address{}, EthToWei(2))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+
+				it, err := WalletProxy.FilterInitiatedTransfer(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(it.Next()).To(BeTrue())
+				Expect(it.Event.To).To(Equal(RandomAccount.Address()))
+				Expect(it.Event.Amount.String()).To(Equal(EthToWei(2).String()))
+				id = it.Event.Id
+			})
+
+			It("should not move any funds yet", func() {
+				b, err := Backend.BalanceAt(context.Background(), WalletProxyAddress, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(b.String()).To(Equal(EthToWei(10).String()))
+			})
+
+			When("the owner confirms it within the window", func() {
+				var tx *types.Transaction
+
+				BeforeEach(func() {
+					var err error
+					tx, err = WalletProxy.ConfirmLargeTransfer(Owner.TransactOpts(), id)
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+				})
+
+				It("should succeed", func() {
+					Expect(isSuccessful(tx)).To(BeTrue())
+				})
+
+				It("should transfer 2 ETH", func() {
+					b, err := Backend.BalanceAt(context.Background(), WalletProxyAddress, nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(b.String()).To(Equal(EthToWei(8).String()))
+				})
+
+				It("should emit a ConfirmedLargeTransfer event", func() {
+					it, err := WalletProxy.FilterConfirmedLargeTransfer(nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(it.Next()).To(BeTrue())
+					Expect(it.Event.Id).To(Equal(id))
+				})
+
+				It("should not be confirmable twice", func() {
+					tx, err := WalletProxy.ConfirmLargeTransfer(Owner.TransactOpts(ethertest.WithGasLimit(100000)), id)
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeFalse())
+				})
+			})
+
+			When("a random person tries to confirm it", func() {
+				It("should fail", func() {
+					tx, err := WalletProxy.ConfirmLargeTransfer(RandomAccount.TransactOpts(ethertest.WithGasLimit(100000)), id)
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeFalse())
+				})
+			})
+
+			When("the confirmation window has passed", func() {
+				BeforeEach(func() {
+					Backend.AdjustTime(time.Hour + time.Second)
+					Backend.Commit()
+				})
+
+				It("should fail to confirm", func() {
+					tx, err := WalletProxy.ConfirmLargeTransfer(Owner.TransactOpts(ethertest.WithGasLimit(100000)), id)
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeFalse())
+					returnData, _ := ethCall(tx)
+					Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("confirmation expired"))
+				})
+			})
+		})
+	})
+})
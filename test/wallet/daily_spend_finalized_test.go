@@ -0,0 +1,123 @@
+package wallet_test
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("DailySpendFinalized", func() {
+
+	transfer := func(amount *big.Int) *types.Transaction {
+		tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(500000)), RandomAccount.Address(), common.Address{}, amount)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+		return tx
+	}
+
+	finalized := func() []*big.Int {
+		it, err := WalletProxy.FilterDailySpendFinalized(nil)
+		Expect(err).ToNot(HaveOccurred())
+		var totals []*big.Int
+		for it.Next() {
+			totals = append(totals, it.Event.TotalSpent)
+		}
+		return totals
+	}
+
+	var periodStart uint64
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(10))
+
+		// Start a new spend limit period with the first transfer.
+		Backend.AdjustTime(24*time.Hour + time.Second)
+		Backend.Commit()
+		tx := transfer(EthToWei(1))
+		r, err := Backend.TransactionReceipt(context.Background(), tx.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		periodStart = Backend.Blockchain().GetHeaderByNumber(r.BlockNumber.Uint64()).Time
+
+		transfer(EthToWei(2))
+	})
+
+	It("should not finalize the period while it lasts", func() {
+		Expect(finalized()).To(BeEmpty())
+	})
+
+	When("a transfer is made after the limit has reset", func() {
+		BeforeEach(func() {
+			Backend.AdjustTime(24*time.Hour + time.Second)
+			Backend.Commit()
+			transfer(EthToWei(4))
+		})
+
+		It("should emit the total spent in the previous period", func() {
+			it, err := WalletProxy.FilterDailySpendFinalized(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Day.Uint64()).To(Equal(periodStart))
+			Expect(evt.TotalSpent.String()).To(Equal(EthToWei(3).String()))
+		})
+
+		It("should not emit it again for later transfers of the same period", func() {
+			transfer(EthToWei(1))
+			Expect(finalized()).To(HaveLen(1))
+		})
+
+		When("the next period ends as well", func() {
+			BeforeEach(func() {
+				Backend.AdjustTime(24*time.Hour + time.Second)
+				Backend.Commit()
+				transfer(EthToWei(1))
+			})
+
+			It("should only count the spends of that period", func() {
+				totals := finalized()
+				Expect(totals).To(HaveLen(2))
+				Expect(totals[1].String()).To(Equal(EthToWei(4).String()))
+			})
+		})
+	})
+
+	When("the spend limit is set after the limit has reset", func() {
+		var block uint64
+
+		BeforeEach(func() {
+			Backend.AdjustTime(24*time.Hour + time.Second)
+			Backend.Commit()
+
+			tx, err := WalletProxy.SetSpendLimit(Owner.TransactOpts(), EthToWei(50))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+			r, err := Backend.TransactionReceipt(context.Background(), tx.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			block = r.BlockNumber.Uint64()
+		})
+
+		It("should emit the total spent in the previous period with the reset", func() {
+			it, err := WalletProxy.FilterDailySpendFinalized(&bind.FilterOpts{Start: block, End: &block})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			Expect(it.Event.Day.Uint64()).To(Equal(periodStart))
+			Expect(it.Event.TotalSpent.String()).To(Equal(EthToWei(3).String()))
+		})
+
+		It("should not emit it again on the next transfer", func() {
+			transfer(EthToWei(1))
+			Expect(finalized()).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,165 @@
+package wallet_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("deposit hold", func() {
+
+	transfer := func(amount *big.Int) bool {
+		tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(200000)), RandomAccount.Address(), common.HexToAddress("0x0"), amount)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		if !isSuccessful(tx) {
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("balance on hold"))
+			return false
+		}
+		return true
+	}
+
+	heldBalance := func() string {
+		held, err := WalletProxy.HeldBalance(nil, common.HexToAddress("0x0"))
+		Expect(err).ToNot(HaveOccurred())
+		return held.String()
+	}
+
+	It("should be disabled by default", func() {
+		threshold, err := WalletProxy.DepositHoldThreshold(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(threshold.String()).To(Equal("0"))
+
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(10))
+		Expect(heldBalance()).To(Equal("0"))
+		Expect(transfer(EthToWei(10))).To(BeTrue())
+	})
+
+	When("the owner tries to set the deposit hold", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SetDepositHold(Owner.TransactOpts(ethertest.WithGasLimit(100000)), EthToWei(5), big.NewInt(3600))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the controller holds deposits of 5 ETH or more for an hour", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetDepositHold(Controller.TransactOpts(), EthToWei(5), big.NewInt(3600))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SetDepositHold event", func() {
+			it, err := WalletProxy.FilterSetDepositHold(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Controller.Address()))
+			Expect(evt.Threshold.String()).To(Equal(EthToWei(5).String()))
+			Expect(evt.HoldPeriod.String()).To(Equal("3600"))
+		})
+
+		When("1 ETH is deposited", func() {
+			BeforeEach(func() {
+				BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(1))
+			})
+
+			It("should be spendable immediately", func() {
+				Expect(heldBalance()).To(Equal("0"))
+				Expect(transfer(EthToWei(1))).To(BeTrue())
+			})
+		})
+
+		When("10 ETH are deposited", func() {
+			BeforeEach(func() {
+				BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(10))
+			})
+
+			It("should hold the deposit", func() {
+				Expect(heldBalance()).To(Equal(EthToWei(10).String()))
+			})
+
+			It("should not be spendable immediately", func() {
+				Expect(transfer(EthToWei(1))).To(BeFalse())
+			})
+
+			It("should not be loadable to the card", func() {
+				tx, err := TokenWhitelist.AddTokens(
+					ControllerAdmin.TransactOpts(),
+					[]common.Address{common.HexToAddress("0x0")},
+					StringsToByte32("ETH"),
+					[]*big.Int{DecimalsToMagnitude(big.NewInt(18))},
+					[]bool{true},
+					[]bool{true},
+					big.NewInt(20180913153211),
+				)
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+
+				tx, err = WalletProxy.LoadTokenCard(Owner.TransactOpts(ethertest.WithGasLimit(1000000)), common.HexToAddress("0x0"), big.NewInt(1000))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeFalse())
+				returnData, _ := ethCall(tx)
+				Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("balance on hold"))
+			})
+
+			It("should not be usable to top up the owner's gas", func() {
+				BankAccount.MustTransfer(Backend, Controller.Address(), EthToWei(1))
+				tx, err := WalletProxy.TopUpGas(Controller.TransactOpts(ethertest.WithGasLimit(200000)), FinneyToWei(1))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeFalse())
+				returnData, _ := ethCall(tx)
+				Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("balance on hold"))
+			})
+
+			When("the deposit is recorded", func() {
+				BeforeEach(func() {
+					tx, err := WalletProxy.RecordDeposit(RandomAccount.TransactOpts(), common.HexToAddress("0x0"))
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+				})
+
+				It("should emit a HeldDeposit event", func() {
+					it, err := WalletProxy.FilterHeldDeposit(nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(it.Next()).To(BeTrue())
+					evt := it.Event
+					Expect(it.Next()).To(BeFalse())
+					Expect(evt.Asset).To(Equal(common.HexToAddress("0x0")))
+					Expect(evt.Amount.String()).To(Equal(EthToWei(10).String()))
+				})
+
+				It("should still hold the deposit", func() {
+					Expect(heldBalance()).To(Equal(EthToWei(10).String()))
+					Expect(transfer(EthToWei(1))).To(BeFalse())
+				})
+
+				When("the hold period is over", func() {
+					BeforeEach(func() {
+						Backend.AdjustTime(time.Hour + time.Second)
+						Backend.Commit()
+					})
+
+					It("should release the deposit", func() {
+						Expect(heldBalance()).To(Equal("0"))
+						Expect(transfer(EthToWei(10))).To(BeTrue())
+					})
+				})
+			})
+		})
+	})
+})
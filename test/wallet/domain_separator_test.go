@@ -0,0 +1,46 @@
+package wallet_test
+
+import (
+	"math/big"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/metatx"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("DOMAIN_SEPARATOR", func() {
+
+	var version string
+
+	BeforeEach(func() {
+		var err error
+		version, err = WalletProxy.WALLETVERSION(nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should match the separator computed off chain", func() {
+		separator, err := WalletProxy.DOMAINSEPARATOR(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(separator).To(Equal([32]byte(metatx.DomainSeparator(metatx.WalletName, version, big.NewInt(1337), WalletProxyAddress))))
+	})
+
+	It("should be verified", func() {
+		err := metatx.VerifyDomainSeparator(nil, Backend, metatx.WalletName, version, big.NewInt(1337), WalletProxyAddress)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	When("the separator is computed for another chain", func() {
+		It("should not be verified", func() {
+			err := metatx.VerifyDomainSeparator(nil, Backend, metatx.WalletName, version, big.NewInt(1), WalletProxyAddress)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("the separator is computed for another wallet", func() {
+		It("should not be verified", func() {
+			err := metatx.VerifyDomainSeparator(nil, Backend, metatx.WalletName, version, big.NewInt(1337), RandomAccount.Address())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
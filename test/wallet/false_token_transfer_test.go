@@ -0,0 +1,59 @@
+package wallet_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings/mocks"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("transfer of a token returning false", func() {
+
+	var falseToken *mocks.FalseToken
+	var falseTokenAddress common.Address
+
+	BeforeEach(func() {
+		var tx *types.Transaction
+		var err error
+		falseTokenAddress, tx, falseToken, err = mocks.DeployFalseToken(BankAccount.TransactOpts(), Backend)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = falseToken.Credit(BankAccount.TransactOpts(), WalletProxyAddress, big.NewInt(1000))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	When("the owner transfers the token", func() {
+
+		BeforeEach(func() {
+			tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(500000)), RandomAccount.Address(), falseTokenAddress, big.NewInt(300))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+
+		It("should keep the wallet's balance", func() {
+			b, err := falseToken.BalanceOf(nil, WalletProxyAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b.String()).To(Equal("1000"))
+
+			b, err = falseToken.BalanceOf(nil, RandomAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b.String()).To(Equal("0"))
+		})
+
+		It("should not emit a Transferred event", func() {
+			it, err := WalletProxy.FilterTransferred(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeFalse())
+		})
+	})
+})
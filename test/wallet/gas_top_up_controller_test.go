@@ -0,0 +1,89 @@
+package wallet_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("gasTopUpController", func() {
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(10))
+		BankAccount.MustTransfer(Backend, Controller.Address(), EthToWei(1))
+	})
+
+	It("should be disabled by default", func() {
+		enabled, err := WalletProxy.GasTopUpController(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(enabled).To(BeFalse())
+	})
+
+	It("should let the owner top up gas by default", func() {
+		tx, err := WalletProxy.TopUpGas(Owner.TransactOpts(ethertest.WithGasLimit(100000)), FinneyToWei(1))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	When("the owner tries to enable it", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SetGasTopUpController(Owner.TransactOpts(ethertest.WithGasLimit(100000)), true)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the controller enables it", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetGasTopUpController(Controller.TransactOpts(), true)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SetGasTopUpController event", func() {
+			it, err := WalletProxy.FilterSetGasTopUpController(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Controller.Address()))
+			Expect(evt.Enabled).To(BeTrue())
+		})
+
+		It("should reject a top up by the owner", func() {
+			tx, err := WalletProxy.TopUpGas(Owner.TransactOpts(ethertest.WithGasLimit(100000)), FinneyToWei(1))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("sender is not a controller"))
+		})
+
+		It("should let the controller top up gas", func() {
+			tx, err := WalletProxy.TopUpGas(Controller.TransactOpts(ethertest.WithGasLimit(100000)), FinneyToWei(1))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		When("the controller disables it again", func() {
+			BeforeEach(func() {
+				tx, err := WalletProxy.SetGasTopUpController(Controller.TransactOpts(), false)
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should let the owner top up gas", func() {
+				tx, err := WalletProxy.TopUpGas(Owner.TransactOpts(ethertest.WithGasLimit(100000)), FinneyToWei(1))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+		})
+	})
+})
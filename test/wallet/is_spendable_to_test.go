@@ -0,0 +1,166 @@
+package wallet_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("isSpendableTo", func() {
+
+	expectSpendable := func(to, asset common.Address, amount *big.Int, ok bool, reason string) {
+		res, err := WalletProxy.IsSpendableTo(nil, to, asset, amount)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Ok).To(Equal(ok))
+		Expect(res.Reason).To(Equal(reason))
+	}
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(200))
+	})
+
+	It("should reject the zero address", func() {
+		expectSpendable(common.Address{}, common.Address{}, EthToWei(1), false, "destination=0")
+	})
+
+	It("should permit an amount within the available spend limit", func() {
+		expectSpendable(RandomAccount.Address(), common.Address{}, EthToWei(100), true, "")
+	})
+
+	It("should reject an amount beyond the available spend limit", func() {
+		expectSpendable(RandomAccount.Address(), common.Address{}, EthToWei(101), false, "available<amount")
+	})
+
+	When("the spend limit is used up", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.Transfer(Owner.TransactOpts(), RandomAccount.Address(), common.Address{}, EthToWei(100))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should reject an address that isn't whitelisted", func() {
+			expectSpendable(RandomAccount.Address(), common.Address{}, EthToWei(1), false, "available<amount")
+		})
+
+		When("the address is whitelisted", func() {
+			BeforeEach(func() {
+				tx, err := WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{RandomAccount.Address()})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should permit it", func() {
+				expectSpendable(RandomAccount.Address(), common.Address{}, EthToWei(1), true, "")
+			})
+		})
+
+		When("the address is whitelisted with an expiry", func() {
+			BeforeEach(func() {
+				tx, err := WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{BankAccount.Address()})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+
+				expiresAt := new(big.Int).SetUint64(Backend.Blockchain().CurrentHeader().Time + uint64(time.Hour/time.Second))
+				tx, err = WalletProxy.SubmitWhitelistAdditionWithExpiry(Owner.TransactOpts(), []common.Address{RandomAccount.Address()}, expiresAt)
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+
+				hash, err := WalletProxy.CalculateHash(nil, []common.Address{RandomAccount.Address()})
+				Expect(err).ToNot(HaveOccurred())
+				tx, err = WalletProxy.ConfirmWhitelistAddition(Controller.TransactOpts(), hash)
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should permit it before the expiry", func() {
+				expectSpendable(RandomAccount.Address(), common.Address{}, EthToWei(1), true, "")
+			})
+
+			It("should reject it once expired", func() {
+				Backend.AdjustTime(time.Hour + time.Second)
+				Backend.Commit()
+				expectSpendable(RandomAccount.Address(), common.Address{}, EthToWei(1), false, "available<amount")
+			})
+		})
+	})
+
+	When("the destination is restricted to ether", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SubmitWhitelistForToken(Owner.TransactOpts(), RandomAccount.Address(), common.Address{})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+
+			tx, err = WalletProxy.ConfirmWhitelistForToken(Controller.TransactOpts(), RandomAccount.Address(), common.Address{})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should permit ether beyond the spend limit", func() {
+			expectSpendable(RandomAccount.Address(), common.Address{}, EthToWei(150), true, "")
+		})
+
+		It("should reject other tokens", func() {
+			expectSpendable(RandomAccount.Address(), RandomTokenAddress, big.NewInt(300), false, "token not whitelisted for destination")
+		})
+	})
+
+	When("transfers above 1 ETH need a confirmation", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetConfirmThreshold(Owner.TransactOpts(), EthToWei(1))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should permit 1 ETH", func() {
+			expectSpendable(RandomAccount.Address(), common.Address{}, EthToWei(1), true, "")
+		})
+
+		It("should reject 2 ETH", func() {
+			expectSpendable(RandomAccount.Address(), common.Address{}, EthToWei(2), false, "confirmation required")
+		})
+	})
+
+	When("the lifetime spend cap is 5 ETH", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetLifetimeSpendCap(Controller.TransactOpts(), EthToWei(5))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should permit 5 ETH", func() {
+			expectSpendable(RandomAccount.Address(), common.Address{}, EthToWei(5), true, "")
+		})
+
+		It("should reject 6 ETH", func() {
+			expectSpendable(RandomAccount.Address(), common.Address{}, EthToWei(6), false, "lifetime cap reached")
+		})
+	})
+
+	When("a deposit is on hold", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetDepositHold(Controller.TransactOpts(), EthToWei(5), big.NewInt(3600))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+
+			BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(10))
+		})
+
+		It("should reject spending it", func() {
+			expectSpendable(RandomAccount.Address(), common.Address{}, EthToWei(1), false, "balance on hold")
+		})
+	})
+})
@@ -0,0 +1,153 @@
+package wallet_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("lifetimeSpendCap", func() {
+
+	transfer := func(to common.Address, amount *big.Int) bool {
+		tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(500000)), to, common.Address{}, amount)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		if !isSuccessful(tx) {
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("lifetime cap reached"))
+			return false
+		}
+		return true
+	}
+
+	lifetimeSpent := func() string {
+		spent, err := WalletProxy.LifetimeSpent(nil)
+		Expect(err).ToNot(HaveOccurred())
+		return spent.String()
+	}
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(20))
+	})
+
+	It("should be disabled by default", func() {
+		limitCap, err := WalletProxy.LifetimeSpendCap(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(limitCap.String()).To(Equal("0"))
+
+		Expect(transfer(RandomAccount.Address(), EthToWei(5))).To(BeTrue())
+		Expect(lifetimeSpent()).To(Equal("0"))
+	})
+
+	When("the owner tries to set the cap", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SetLifetimeSpendCap(Owner.TransactOpts(ethertest.WithGasLimit(100000)), EthToWei(5))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the controller caps the lifetime spend to 5 ETH", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetLifetimeSpendCap(Controller.TransactOpts(), EthToWei(5))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SetLifetimeSpendCap event", func() {
+			it, err := WalletProxy.FilterSetLifetimeSpendCap(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Controller.Address()))
+			Expect(evt.Cap.String()).To(Equal(EthToWei(5).String()))
+		})
+
+		It("should accumulate spends up to the cap across days", func() {
+			Expect(transfer(RandomAccount.Address(), EthToWei(2))).To(BeTrue())
+			Expect(lifetimeSpent()).To(Equal(EthToWei(2).String()))
+
+			Backend.AdjustTime(24*time.Hour + time.Second)
+			Backend.Commit()
+
+			Expect(transfer(RandomAccount.Address(), EthToWei(3))).To(BeTrue())
+			Expect(lifetimeSpent()).To(Equal(EthToWei(5).String()))
+
+			Expect(transfer(RandomAccount.Address(), big.NewInt(1))).To(BeFalse())
+			Expect(lifetimeSpent()).To(Equal(EthToWei(5).String()))
+		})
+
+		It("should fail a spend exceeding the cap", func() {
+			Expect(transfer(RandomAccount.Address(), EthToWei(6))).To(BeFalse())
+			Expect(lifetimeSpent()).To(Equal("0"))
+		})
+
+		It("should count card loads", func() {
+			tx, err := TokenWhitelist.AddTokens(
+				ControllerAdmin.TransactOpts(),
+				[]common.Address{common.HexToAddress("0x0")},
+				StringsToByte32("ETH"),
+				[]*big.Int{DecimalsToMagnitude(big.NewInt(18))},
+				[]bool{true},
+				[]bool{true},
+				big.NewInt(20180913153211),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+
+			tx, err = WalletProxy.LoadTokenCard(Owner.TransactOpts(ethertest.WithGasLimit(1000000)), common.Address{}, big.NewInt(1000))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+			Expect(lifetimeSpent()).To(Equal("1000"))
+		})
+
+		It("should count gas top ups", func() {
+			BankAccount.MustTransfer(Backend, Controller.Address(), EthToWei(1))
+			tx, err := WalletProxy.TopUpGas(Controller.TransactOpts(ethertest.WithGasLimit(200000)), FinneyToWei(1))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+			Expect(lifetimeSpent()).To(Equal(FinneyToWei(1).String()))
+		})
+
+		When("the recipient is whitelisted", func() {
+			BeforeEach(func() {
+				tx, err := WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{RandomAccount.Address()})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should count the spends as well", func() {
+				Expect(transfer(RandomAccount.Address(), EthToWei(5))).To(BeTrue())
+				Expect(transfer(RandomAccount.Address(), big.NewInt(1))).To(BeFalse())
+			})
+		})
+
+		When("the controller removes the cap", func() {
+			BeforeEach(func() {
+				Expect(transfer(RandomAccount.Address(), EthToWei(5))).To(BeTrue())
+
+				tx, err := WalletProxy.SetLifetimeSpendCap(Controller.TransactOpts(), big.NewInt(0))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should allow further spends", func() {
+				Expect(transfer(RandomAccount.Address(), EthToWei(1))).To(BeTrue())
+				Expect(lifetimeSpent()).To(Equal(EthToWei(5).String()))
+			})
+		})
+	})
+})
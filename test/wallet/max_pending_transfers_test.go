@@ -0,0 +1,169 @@
+package wallet_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("maxPendingTransfers", func() {
+
+	initiate := func() [32]byte {
+		tx, err := WalletProxy.InitiateTransfer(Owner.TransactOpts(ethertest.WithGasLimit(300000)), RandomAccount.Address(), common.Address{}, EthToWei(2))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		it, err := WalletProxy.FilterInitiatedTransfer(nil)
+		Expect(err).ToNot(HaveOccurred())
+		var id [32]byte
+		for it.Next() {
+			id = it.Event.Id
+		}
+		return id
+	}
+
+	pendingTransferCount := func() string {
+		n, err := WalletProxy.PendingTransferCount(nil)
+		Expect(err).ToNot(HaveOccurred())
+		return n.String()
+	}
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(10))
+
+		tx, err := WalletProxy.SetConfirmThreshold(Owner.TransactOpts(), EthToWei(1))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	It("should be unset by default", func() {
+		m, err := WalletProxy.MaxPendingTransfers(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(m.String()).To(Equal("0"))
+		Expect(pendingTransferCount()).To(Equal("0"))
+	})
+
+	When("no cap is set", func() {
+		It("should still reject more than 20 pending transfers", func() {
+			for i := 0; i < 20; i++ {
+				initiate()
+			}
+			Expect(pendingTransferCount()).To(Equal("20"))
+
+			tx, err := WalletProxy.InitiateTransfer(Owner.TransactOpts(ethertest.WithGasLimit(300000)), RandomAccount.Address(), common.Address{}, EthToWei(2))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("too many pending transfers"))
+		})
+	})
+
+	When("the controller tries to set a cap above 20", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SetMaxPendingTransfers(Controller.TransactOpts(ethertest.WithGasLimit(100000)), big.NewInt(21))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("cap too large"))
+		})
+	})
+
+	When("the owner tries to set the cap", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SetMaxPendingTransfers(Owner.TransactOpts(ethertest.WithGasLimit(100000)), big.NewInt(2))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the controller sets the cap to 2", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetMaxPendingTransfers(Controller.TransactOpts(), big.NewInt(2))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SetMaxPendingTransfers event", func() {
+			it, err := WalletProxy.FilterSetMaxPendingTransfers(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Controller.Address()))
+			Expect(evt.MaxPendingTransfers.String()).To(Equal("2"))
+		})
+
+		When("the owner initiates two transfers", func() {
+			var first [32]byte
+
+			BeforeEach(func() {
+				first = initiate()
+				initiate()
+			})
+
+			It("should count them as pending", func() {
+				Expect(pendingTransferCount()).To(Equal("2"))
+			})
+
+			It("should reject a third one", func() {
+				tx, err := WalletProxy.InitiateTransfer(Owner.TransactOpts(ethertest.WithGasLimit(300000)), RandomAccount.Address(), common.Address{}, EthToWei(2))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeFalse())
+				returnData, _ := ethCall(tx)
+				Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("too many pending transfers"))
+			})
+
+			When("one of them is confirmed", func() {
+				BeforeEach(func() {
+					tx, err := WalletProxy.ConfirmLargeTransfer(Owner.TransactOpts(ethertest.WithGasLimit(300000)), first)
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+				})
+
+				It("should accept another one", func() {
+					Expect(pendingTransferCount()).To(Equal("1"))
+					initiate()
+					Expect(pendingTransferCount()).To(Equal("2"))
+				})
+			})
+
+			When("they have expired", func() {
+				BeforeEach(func() {
+					Backend.AdjustTime(time.Hour + time.Second)
+					Backend.Commit()
+				})
+
+				It("should accept new ones", func() {
+					Expect(pendingTransferCount()).To(Equal("0"))
+					initiate()
+					initiate()
+					Expect(pendingTransferCount()).To(Equal("2"))
+				})
+
+				It("should delete them once pruned", func() {
+					initiate()
+
+					tx, err := WalletProxy.ConfirmLargeTransfer(Owner.TransactOpts(ethertest.WithGasLimit(300000)), first)
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeFalse())
+					returnData, _ := ethCall(tx)
+					Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("unknown transfer"))
+				})
+			})
+		})
+	})
+})
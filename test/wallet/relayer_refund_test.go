@@ -0,0 +1,164 @@
+package wallet_test
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("relayer refund", func() {
+
+	relayTransfer := func() {
+		a, err := abi.JSON(strings.NewReader(WALLET_ABI))
+		Expect(err).ToNot(HaveOccurred())
+		data, err := a.Pack("transfer", RandomAccount.Address(), common.HexToAddress("0x0"), EthToWei(1))
+		Expect(err).ToNot(HaveOccurred())
+
+		nonce := big.NewInt(0)
+		chainId := big.NewInt(1337)
+		signature, err := SignData(chainId, WalletProxyAddress, nonce, data, Owner.PrivKey())
+		Expect(err).ToNot(HaveOccurred())
+
+		tx, err := WalletProxy.ExecuteRelayedTransaction(Controller.TransactOpts(ethertest.WithGasLimit(500000), ethertest.WithGasPrice(big.NewInt(1000000000))), nonce, data, signature)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	}
+
+	refundedAmount := func() *big.Int {
+		it, err := WalletProxy.FilterRefundedRelayer(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(it.Next()).To(BeTrue())
+		evt := it.Event
+		Expect(it.Next()).To(BeFalse())
+		Expect(evt.Relayer).To(Equal(Controller.Address()))
+		return evt.Amount
+	}
+
+	setCap := func(refundCap *big.Int) {
+		tx, err := WalletProxy.SetRelayerRefundCap(Owner.TransactOpts(), refundCap)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	}
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(2))
+	})
+
+	It("should have refunds disabled by default", func() {
+		refundCap, err := WalletProxy.RelayerRefundCap(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(refundCap.String()).To(Equal("0"))
+
+		relayTransfer()
+
+		it, err := WalletProxy.FilterRefundedRelayer(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(it.Next()).To(BeFalse())
+		b, err := WalletProxy.GetBalance(nil, common.HexToAddress("0x0"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(b.String()).To(Equal(EthToWei(1).String()))
+	})
+
+	When("a random account tries to set the refund cap", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SetRelayerRefundCap(RandomAccount.TransactOpts(ethertest.WithGasLimit(100000)), EthToWei(1))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("a random account tries to set the refund token", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SetRelayerRefundToken(RandomAccount.TransactOpts(ethertest.WithGasLimit(100000)), TKNBurnerAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the cap is well above the cost of the relayed transaction", func() {
+		BeforeEach(func() {
+			setCap(EthToWei(1))
+			relayTransfer()
+		})
+
+		It("should emit a SetRelayerRefundCap event", func() {
+			it, err := WalletProxy.FilterSetRelayerRefundCap(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			Expect(it.Event.Sender).To(Equal(Owner.Address()))
+			Expect(it.Event.Cap.String()).To(Equal(EthToWei(1).String()))
+		})
+
+		It("should refund the gas cost in ETH", func() {
+			refund := refundedAmount()
+			Expect(refund.Sign()).To(Equal(1))
+			Expect(refund.Cmp(EthToWei(1))).To(Equal(-1))
+
+			b, err := WalletProxy.GetBalance(nil, common.HexToAddress("0x0"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b.String()).To(Equal(new(big.Int).Sub(EthToWei(1), refund).String()))
+		})
+	})
+
+	When("the cost of the relayed transaction exceeds the cap", func() {
+		BeforeEach(func() {
+			setCap(big.NewInt(1000))
+			relayTransfer()
+		})
+
+		It("should refund exactly the cap", func() {
+			Expect(refundedAmount().String()).To(Equal("1000"))
+		})
+	})
+
+	When("the relayer is refunded in TKN", func() {
+		BeforeEach(func() {
+			tx, err := TKNBurner.Mint(BankAccount.TransactOpts(), WalletProxyAddress, big.NewInt(100000000000))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+
+			tx, err = WalletProxy.SetRelayerRefundToken(Owner.TransactOpts(), TKNBurnerAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		When("the cap is 1 TKN", func() {
+			BeforeEach(func() {
+				setCap(big.NewInt(100000000))
+				relayTransfer()
+			})
+
+			It("should refund exactly 1 TKN", func() {
+				Expect(refundedAmount().String()).To(Equal("100000000"))
+				b, err := TKNBurner.BalanceOf(nil, Controller.Address())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(b.String()).To(Equal("100000000"))
+			})
+		})
+
+		When("the cap is 1000 TKN", func() {
+			BeforeEach(func() {
+				setCap(big.NewInt(100000000000))
+				relayTransfer()
+			})
+
+			It("should refund less than the cap", func() {
+				refund := refundedAmount()
+				Expect(refund.Sign()).To(Equal(1))
+				Expect(refund.Cmp(big.NewInt(100000000000))).To(Equal(-1))
+			})
+		})
+	})
+})
@@ -0,0 +1,151 @@
+package wallet_test
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("removalGracePeriod", func() {
+
+	payee := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	// transferAboveLimit transfers more than the daily spend limit, which only succeeds to whitelisted addresses.
+	transferAboveLimit := func() bool {
+		tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(500000)), payee, common.Address{}, EthToWei(101))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		return isSuccessful(tx)
+	}
+
+	removePayee := func() uint64 {
+		tx, err := WalletProxy.SubmitWhitelistRemoval(Owner.TransactOpts(), []common.Address{payee})
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		pwl, err := WalletProxy.PendingWhitelistRemoval(nil)
+		Expect(err).ToNot(HaveOccurred())
+		hash, err := WalletProxy.CalculateHash(nil, pwl)
+		Expect(err).ToNot(HaveOccurred())
+		tx, err = WalletProxy.ConfirmWhitelistRemoval(Controller.TransactOpts(), hash)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		r, err := Backend.TransactionReceipt(context.Background(), tx.Hash())
+		Expect(err).ToNot(HaveOccurred())
+		return Backend.Blockchain().GetHeaderByNumber(r.BlockNumber.Uint64()).Time
+	}
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(500))
+
+		tx, err := WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{payee})
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	It("should be disabled by default", func() {
+		p, err := WalletProxy.RemovalGracePeriod(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.String()).To(Equal("0"))
+
+		at, err := WalletProxy.RemovalEffectiveAt(nil, payee)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(at.String()).To(Equal("0"))
+	})
+
+	When("the owner tries to set the grace period", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SetRemovalGracePeriod(Owner.TransactOpts(ethertest.WithGasLimit(100000)), big.NewInt(3600))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("an address is removed without a grace period", func() {
+		BeforeEach(func() {
+			removePayee()
+		})
+
+		It("should block transfers above the limit right away", func() {
+			Expect(transferAboveLimit()).To(BeFalse())
+		})
+	})
+
+	When("the controller sets a grace period of an hour", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetRemovalGracePeriod(Controller.TransactOpts(), big.NewInt(3600))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SetRemovalGracePeriod event", func() {
+			it, err := WalletProxy.FilterSetRemovalGracePeriod(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Controller.Address()))
+			Expect(evt.GracePeriod.String()).To(Equal("3600"))
+		})
+
+		When("an address is removed", func() {
+			var removedAt uint64
+
+			BeforeEach(func() {
+				removedAt = removePayee()
+			})
+
+			It("should report when the removal takes effect", func() {
+				at, err := WalletProxy.RemovalEffectiveAt(nil, payee)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(at.Uint64()).To(Equal(removedAt + 3600))
+			})
+
+			It("should no longer list it", func() {
+				listed, err := WalletProxy.WhitelistMap(nil, payee)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(listed).To(BeFalse())
+			})
+
+			It("should still be spendable above the limit during the grace period", func() {
+				whitelisted, err := WalletProxy.IsWhitelisted(nil, payee)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(whitelisted).To(BeTrue())
+				Expect(transferAboveLimit()).To(BeTrue())
+			})
+
+			It("should still be spendable above the limit with executeTransaction during the grace period", func() {
+				tx, err := WalletProxy.ExecuteTransaction(Owner.TransactOpts(ethertest.WithGasLimit(500000)), payee, EthToWei(101), nil)
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			When("the grace period has passed", func() {
+				BeforeEach(func() {
+					Backend.AdjustTime(time.Hour)
+					Backend.Commit()
+				})
+
+				It("should block transfers above the limit", func() {
+					whitelisted, err := WalletProxy.IsWhitelisted(nil, payee)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(whitelisted).To(BeFalse())
+					Expect(transferAboveLimit()).To(BeFalse())
+				})
+			})
+		})
+	})
+})
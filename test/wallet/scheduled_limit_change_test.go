@@ -0,0 +1,228 @@
+package wallet_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("scheduleLimitChange", func() {
+
+	var effectiveAt *big.Int
+
+	submit := func(limit *big.Int) {
+		tx, err := WalletProxy.ScheduleLimitChange(Owner.TransactOpts(), limit, effectiveAt)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	}
+
+	schedule := func(limit *big.Int) {
+		submit(limit)
+		tx, err := WalletProxy.ConfirmLimitChange(Controller.TransactOpts(), limit, effectiveAt)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	}
+
+	transfer := func(amount *big.Int) bool {
+		tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(200000)), RandomAccount.Address(), common.HexToAddress("0x0"), amount)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		return isSuccessful(tx)
+	}
+
+	spendLimitValue := func() string {
+		v, err := WalletProxy.SpendLimitValue(nil)
+		Expect(err).ToNot(HaveOccurred())
+		return v.String()
+	}
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(100))
+		effectiveAt = big.NewInt(int64(Backend.Blockchain().CurrentBlock().Time()) + 3600)
+	})
+
+	It("should have no scheduled change by default", func() {
+		limit, at, err := WalletProxy.ScheduledLimitChange(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(limit.String()).To(Equal("0"))
+		Expect(at.String()).To(Equal("0"))
+	})
+
+	When("the controller tries to submit a change", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.ScheduleLimitChange(Controller.TransactOpts(ethertest.WithGasLimit(100000)), EthToWei(200), effectiveAt)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the effective time is in the past", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.ScheduleLimitChange(Owner.TransactOpts(ethertest.WithGasLimit(100000)), EthToWei(200), big.NewInt(1))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("effective time in the past"))
+		})
+	})
+
+	When("the controller confirms a change that wasn't submitted", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.ConfirmLimitChange(Controller.TransactOpts(ethertest.WithGasLimit(100000)), EthToWei(200), effectiveAt)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("no pending submission"))
+		})
+	})
+
+	When("the owner submits an increase to 200 ETH", func() {
+		BeforeEach(func() {
+			submit(EthToWei(200))
+		})
+
+		It("should emit a SubmittedSpendLimitChange event", func() {
+			it, err := WalletProxy.FilterSubmittedSpendLimitChange(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Amount.String()).To(Equal(EthToWei(200).String()))
+			Expect(evt.EffectiveAt.String()).To(Equal(effectiveAt.String()))
+		})
+
+		It("should be pending", func() {
+			limit, at, err := WalletProxy.PendingLimitChange(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(limit.String()).To(Equal(EthToWei(200).String()))
+			Expect(at.String()).To(Equal(effectiveAt.String()))
+		})
+
+		It("should not be scheduled before the controller confirms it", func() {
+			Backend.AdjustTime(time.Hour)
+			Backend.Commit()
+
+			_, at, err := WalletProxy.ScheduledLimitChange(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(at.String()).To(Equal("0"))
+			Expect(spendLimitValue()).To(Equal(EthToWei(100).String()))
+		})
+
+		It("should fail to be confirmed by the owner", func() {
+			tx, err := WalletProxy.ConfirmLimitChange(Owner.TransactOpts(ethertest.WithGasLimit(100000)), EthToWei(200), effectiveAt)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+
+		It("should fail to be confirmed with a different limit", func() {
+			tx, err := WalletProxy.ConfirmLimitChange(Controller.TransactOpts(ethertest.WithGasLimit(100000)), EthToWei(300), effectiveAt)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("confirmed/submitted limit change mismatch"))
+		})
+	})
+
+	When("the controller confirms an increase to 200 ETH", func() {
+		BeforeEach(func() {
+			schedule(EthToWei(200))
+		})
+
+		It("should emit a ScheduledSpendLimitChange event", func() {
+			it, err := WalletProxy.FilterScheduledSpendLimitChange(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Controller.Address()))
+			Expect(evt.Amount.String()).To(Equal(EthToWei(200).String()))
+			Expect(evt.EffectiveAt.String()).To(Equal(effectiveAt.String()))
+		})
+
+		It("should clear the submission", func() {
+			_, at, err := WalletProxy.PendingLimitChange(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(at.String()).To(Equal("0"))
+		})
+
+		It("should be readable", func() {
+			limit, at, err := WalletProxy.ScheduledLimitChange(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(limit.String()).To(Equal(EthToWei(200).String()))
+			Expect(at.String()).To(Equal(effectiveAt.String()))
+		})
+
+		It("should not change the limit before the effective time", func() {
+			Expect(spendLimitValue()).To(Equal(EthToWei(100).String()))
+		})
+
+		When("the effective time has passed", func() {
+			BeforeEach(func() {
+				Backend.AdjustTime(time.Hour)
+				Backend.Commit()
+			})
+
+			It("should report the new limit", func() {
+				Expect(spendLimitValue()).To(Equal(EthToWei(200).String()))
+			})
+
+			It("should store the new limit on the next transfer", func() {
+				Expect(transfer(EthToWei(1))).To(BeTrue())
+
+				limit, at, err := WalletProxy.ScheduledLimitChange(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(limit.String()).To(Equal("0"))
+				Expect(at.String()).To(Equal("0"))
+				Expect(spendLimitValue()).To(Equal(EthToWei(200).String()))
+
+				it, err := WalletProxy.FilterSetSpendLimit(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(it.Next()).To(BeTrue())
+				Expect(it.Event.Amount.String()).To(Equal(EthToWei(200).String()))
+			})
+		})
+	})
+
+	When("the controller confirms a decrease to 10 ETH", func() {
+		BeforeEach(func() {
+			schedule(EthToWei(10))
+		})
+
+		It("should allow transferring 20 ETH before the effective time", func() {
+			Expect(transfer(EthToWei(20))).To(BeTrue())
+		})
+
+		When("the effective time has passed", func() {
+			BeforeEach(func() {
+				Backend.AdjustTime(time.Hour)
+				Backend.Commit()
+			})
+
+			It("should cap the available limit", func() {
+				available, err := WalletProxy.SpendLimitAvailable(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(available.String()).To(Equal(EthToWei(10).String()))
+			})
+
+			It("should not allow transferring 20 ETH", func() {
+				Expect(transfer(EthToWei(20))).To(BeFalse())
+			})
+
+			It("should allow transferring 10 ETH", func() {
+				Expect(transfer(EthToWei(10))).To(BeTrue())
+			})
+		})
+	})
+})
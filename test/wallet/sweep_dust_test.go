@@ -0,0 +1,138 @@
+package wallet_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("sweepDust", func() {
+
+	var tokens []common.Address
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(1))
+
+		tx, err := ERC20Contract1.Credit(BankAccount.TransactOpts(), WalletProxyAddress, big.NewInt(1000))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tx, err = ERC20Contract2.Credit(BankAccount.TransactOpts(), WalletProxyAddress, big.NewInt(500))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+
+		tokens = []common.Address{common.Address{}, ERC20Contract1Address, ERC20Contract2Address}
+	})
+
+	When("called by the owner", func() {
+
+		var tx *types.Transaction
+
+		BeforeEach(func() {
+			var err error
+			tx, err = WalletProxy.SweepDust(Owner.TransactOpts(ethertest.WithGasLimit(500000)), tokens, RandomAccount.Address(), big.NewInt(600))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should transfer the balance of the tokens holding dust", func() {
+			b, err := ERC20Contract2.BalanceOf(nil, RandomAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b.String()).To(Equal("500"))
+
+			b, err = ERC20Contract2.BalanceOf(nil, WalletProxyAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b.String()).To(Equal("0"))
+		})
+
+		It("should skip the larger balances", func() {
+			b, err := ERC20Contract1.BalanceOf(nil, WalletProxyAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b.String()).To(Equal("1000"))
+
+			b, err = Backend.BalanceAt(context.Background(), WalletProxyAddress, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b.String()).To(Equal(EthToWei(1).String()))
+		})
+
+		It("should emit a SweptDust event", func() {
+			it, err := WalletProxy.FilterSweptDust(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Tokens).To(Equal(tokens))
+			Expect(evt.To).To(Equal(RandomAccount.Address()))
+			Expect(evt.MaxPerToken.String()).To(Equal("600"))
+		})
+
+		It("should emit a single Transferred event", func() {
+			it, err := WalletProxy.FilterTransferred(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Asset).To(Equal(ERC20Contract2Address))
+			Expect(evt.Amount.String()).To(Equal("500"))
+		})
+	})
+
+	When("the dust of all tokens exceeds the spend limit", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetSpendLimit(Owner.TransactOpts(), EthToWei(1))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should fail", func() {
+			tx, err := WalletProxy.SweepDust(Owner.TransactOpts(ethertest.WithGasLimit(500000)), tokens, RandomAccount.Address(), EthToWei(2))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+
+		When("the recipient is whitelisted", func() {
+			BeforeEach(func() {
+				tx, err := WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{RandomAccount.Address()})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should succeed", func() {
+				tx, err := WalletProxy.SweepDust(Owner.TransactOpts(ethertest.WithGasLimit(500000)), tokens, RandomAccount.Address(), EthToWei(2))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+		})
+	})
+
+	When("the token array is empty", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SweepDust(Owner.TransactOpts(ethertest.WithGasLimit(100000)), []common.Address{}, RandomAccount.Address(), big.NewInt(600))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("called by a random account", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SweepDust(RandomAccount.TransactOpts(ethertest.WithGasLimit(100000)), tokens, RandomAccount.Address(), big.NewInt(600))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+})
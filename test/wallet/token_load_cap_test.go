@@ -0,0 +1,107 @@
+package wallet_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("tokenLoadCap", func() {
+
+	load := func(amount int64) bool {
+		tx, err := WalletProxy.LoadTokenCard(Owner.TransactOpts(ethertest.WithGasLimit(1000000), ethertest.WithValue(big.NewInt(amount))), common.HexToAddress("0x0"), big.NewInt(amount))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		if !isSuccessful(tx) {
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("load cap exceeded"))
+			return false
+		}
+		return true
+	}
+
+	BeforeEach(func() {
+		tx, err := TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			[]common.Address{common.HexToAddress("0x0")},
+			StringsToByte32("ETH"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{true},
+			[]bool{true},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	It("should be disabled by default", func() {
+		c, err := WalletProxy.TokenLoadCap(nil, common.HexToAddress("0x0"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.String()).To(Equal("0"))
+		Expect(load(5000)).To(BeTrue())
+	})
+
+	When("the owner tries to set the cap", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SetTokenLoadCap(Owner.TransactOpts(ethertest.WithGasLimit(100000)), common.HexToAddress("0x0"), big.NewInt(1000))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the controller caps ETH loads at 1000 wei", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SetTokenLoadCap(Controller.TransactOpts(), common.HexToAddress("0x0"), big.NewInt(1000))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SetTokenLoadCap event", func() {
+			it, err := WalletProxy.FilterSetTokenLoadCap(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Controller.Address()))
+			Expect(evt.Token).To(Equal(common.HexToAddress("0x0")))
+			Expect(evt.Cap.String()).To(Equal("1000"))
+		})
+
+		It("should reject loading 1001 wei at once", func() {
+			Expect(load(1001)).To(BeFalse())
+		})
+
+		It("should allow loading up to exactly the cap", func() {
+			Expect(load(600)).To(BeTrue())
+			Expect(load(400)).To(BeTrue())
+			loaded, err := WalletProxy.TokenLoaded(nil, common.HexToAddress("0x0"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(loaded.String()).To(Equal("1000"))
+		})
+
+		It("should reject loading 1 wei past the cap", func() {
+			Expect(load(1000)).To(BeTrue())
+			Expect(load(1)).To(BeFalse())
+		})
+
+		When("the cap is removed again", func() {
+			BeforeEach(func() {
+				tx, err := WalletProxy.SetTokenLoadCap(Controller.TransactOpts(), common.HexToAddress("0x0"), big.NewInt(0))
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should allow loading past it", func() {
+				Expect(load(1001)).To(BeTrue())
+			})
+		})
+	})
+})
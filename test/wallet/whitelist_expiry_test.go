@@ -0,0 +1,191 @@
+package wallet_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("submitWhitelistAdditionWithExpiry", func() {
+
+	var expiresAt *big.Int
+
+	transfer := func(amount *big.Int) *types.Transaction {
+		tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(500000)), RandomAccount.Address(), common.Address{}, amount)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		return tx
+	}
+
+	confirmAddition := func(addresses []common.Address) {
+		hash, err := WalletProxy.CalculateHash(nil, addresses)
+		Expect(err).ToNot(HaveOccurred())
+		tx, err := WalletProxy.ConfirmWhitelistAddition(Controller.TransactOpts(ethertest.WithGasLimit(500000)), hash)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	}
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(200))
+		now := Backend.Blockchain().CurrentHeader().Time
+		expiresAt = new(big.Int).SetUint64(now + uint64(time.Hour/time.Second))
+
+		tx, err := WalletProxy.SetWhitelist(Owner.TransactOpts(), []common.Address{BankAccount.Address()})
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	When("a non-owner account tries to submit an address", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SubmitWhitelistAdditionWithExpiry(Controller.TransactOpts(ethertest.WithGasLimit(200000)), []common.Address{RandomAccount.Address()}, expiresAt)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the owner submits an address with an expiry in the past", func() {
+		It("should fail", func() {
+			past := new(big.Int).SetUint64(Backend.Blockchain().CurrentHeader().Time)
+			tx, err := WalletProxy.SubmitWhitelistAdditionWithExpiry(Owner.TransactOpts(ethertest.WithGasLimit(200000)), []common.Address{RandomAccount.Address()}, past)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("expiry in the past"))
+		})
+	})
+
+	When("the owner submits an address expiring an hour from now", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SubmitWhitelistAdditionWithExpiry(Owner.TransactOpts(), []common.Address{RandomAccount.Address()}, expiresAt)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SubmittedWhitelistAdditionExpiry event", func() {
+			it, err := WalletProxy.FilterSubmittedWhitelistAdditionExpiry(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.ExpiresAt.String()).To(Equal(expiresAt.String()))
+		})
+
+		It("should expose the pending expiry", func() {
+			expiry, err := WalletProxy.PendingWhitelistAdditionExpiry(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(expiry.String()).To(Equal(expiresAt.String()))
+		})
+
+		It("should not whitelist the address before the controller confirms it", func() {
+			whitelisted, err := WalletProxy.IsWhitelisted(nil, RandomAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(whitelisted).To(BeFalse())
+			Expect(isSuccessful(transfer(EthToWei(150)))).To(BeFalse())
+		})
+
+		When("the controller cancels the addition", func() {
+			BeforeEach(func() {
+				hash, err := WalletProxy.CalculateHash(nil, []common.Address{RandomAccount.Address()})
+				Expect(err).ToNot(HaveOccurred())
+				tx, err := WalletProxy.CancelWhitelistAddition(Controller.TransactOpts(), hash)
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should clear the pending expiry", func() {
+				expiry, err := WalletProxy.PendingWhitelistAdditionExpiry(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expiry.String()).To(Equal("0"))
+			})
+
+			It("should whitelist a later addition without an expiry permanently", func() {
+				tx, err := WalletProxy.SubmitWhitelistAddition(Owner.TransactOpts(), []common.Address{RandomAccount.Address()})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+				confirmAddition([]common.Address{RandomAccount.Address()})
+
+				expiry, err := WalletProxy.WhitelistExpiry(nil, RandomAccount.Address())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expiry.String()).To(Equal("0"))
+			})
+		})
+
+		When("the controller confirms the addition", func() {
+			BeforeEach(func() {
+				confirmAddition([]common.Address{RandomAccount.Address()})
+			})
+
+			It("should expose the expiry", func() {
+				expiry, err := WalletProxy.WhitelistExpiry(nil, RandomAccount.Address())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expiry.String()).To(Equal(expiresAt.String()))
+			})
+
+			It("should clear the pending expiry", func() {
+				expiry, err := WalletProxy.PendingWhitelistAdditionExpiry(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expiry.String()).To(Equal("0"))
+			})
+
+			It("should transfer beyond the spend limit", func() {
+				Expect(isSuccessful(transfer(EthToWei(150)))).To(BeTrue())
+			})
+
+			When("the expiry has passed", func() {
+				BeforeEach(func() {
+					Backend.AdjustTime(time.Hour + time.Second)
+					Backend.Commit()
+				})
+
+				It("should no longer be whitelisted", func() {
+					whitelisted, err := WalletProxy.IsWhitelisted(nil, RandomAccount.Address())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(whitelisted).To(BeFalse())
+				})
+
+				It("should enforce the spend limit", func() {
+					Expect(isSuccessful(transfer(EthToWei(150)))).To(BeFalse())
+				})
+
+				It("should transfer within the spend limit", func() {
+					Expect(isSuccessful(transfer(EthToWei(50)))).To(BeTrue())
+				})
+			})
+
+			When("the address is added again without an expiry", func() {
+				BeforeEach(func() {
+					tx, err := WalletProxy.SubmitWhitelistAddition(Owner.TransactOpts(), []common.Address{RandomAccount.Address()})
+					Expect(err).ToNot(HaveOccurred())
+					Backend.Commit()
+					Expect(isSuccessful(tx)).To(BeTrue())
+					confirmAddition([]common.Address{RandomAccount.Address()})
+				})
+
+				It("should clear the expiry", func() {
+					expiry, err := WalletProxy.WhitelistExpiry(nil, RandomAccount.Address())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(expiry.String()).To(Equal("0"))
+				})
+
+				It("should transfer beyond the spend limit after the former expiry", func() {
+					Backend.AdjustTime(time.Hour + time.Second)
+					Backend.Commit()
+					Expect(isSuccessful(transfer(EthToWei(150)))).To(BeTrue())
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,255 @@
+package wallet_test
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+var _ = Describe("submitWhitelistForToken", func() {
+
+	transfer := func(asset common.Address, amount *big.Int) *types.Transaction {
+		tx, err := WalletProxy.Transfer(Owner.TransactOpts(ethertest.WithGasLimit(500000)), RandomAccount.Address(), asset, amount)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		return tx
+	}
+
+	expectRejected := func(tx *types.Transaction) {
+		Expect(isSuccessful(tx)).To(BeFalse())
+		returnData, _ := ethCall(tx)
+		Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("token not whitelisted for destination"))
+	}
+
+	BeforeEach(func() {
+		BankAccount.MustTransfer(Backend, WalletProxyAddress, EthToWei(200))
+
+		tx, err := RandomToken.Credit(BankAccount.TransactOpts(), WalletProxyAddress, big.NewInt(1000))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(isSuccessful(tx)).To(BeTrue())
+	})
+
+	It("should not restrict destinations by default", func() {
+		restricted, err := WalletProxy.IsTokenRestricted(nil, RandomAccount.Address())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restricted).To(BeFalse())
+		Expect(isSuccessful(transfer(RandomTokenAddress, big.NewInt(300)))).To(BeTrue())
+	})
+
+	When("a non-owner account tries to submit a destination for a token", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SubmitWhitelistForToken(Controller.TransactOpts(ethertest.WithGasLimit(100000)), RandomAccount.Address(), common.Address{})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the owner submits the zero address", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.SubmitWhitelistForToken(Owner.TransactOpts(ethertest.WithGasLimit(100000)), common.Address{}, RandomTokenAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+	})
+
+	When("the controller confirms a token whitelisting that wasn't submitted", func() {
+		It("should fail", func() {
+			tx, err := WalletProxy.ConfirmWhitelistForToken(Controller.TransactOpts(ethertest.WithGasLimit(100000)), RandomAccount.Address(), common.Address{})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("no pending submission"))
+		})
+	})
+
+	When("the owner submits the destination for ether only", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SubmitWhitelistForToken(Owner.TransactOpts(), RandomAccount.Address(), common.Address{})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should emit a SubmittedWhitelistForToken event", func() {
+			it, err := WalletProxy.FilterSubmittedWhitelistForToken(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.To).To(Equal(RandomAccount.Address()))
+			Expect(evt.Token).To(Equal(common.Address{}))
+		})
+
+		It("should expose the pending submission", func() {
+			submitted, err := WalletProxy.SubmittedWhitelistForToken(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(submitted).To(BeTrue())
+			to, token, err := WalletProxy.PendingWhitelistForToken(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(to).To(Equal(RandomAccount.Address()))
+			Expect(token).To(Equal(common.Address{}))
+		})
+
+		It("should not restrict the destination yet", func() {
+			restricted, err := WalletProxy.IsTokenRestricted(nil, RandomAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restricted).To(BeFalse())
+			Expect(isSuccessful(transfer(common.Address{}, EthToWei(150)))).To(BeFalse())
+		})
+
+		It("should fail to submit another one", func() {
+			tx, err := WalletProxy.SubmitWhitelistForToken(Owner.TransactOpts(ethertest.WithGasLimit(100000)), RandomAccount.Address(), RandomTokenAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("token whitelist submission pending"))
+		})
+
+		It("should fail to be confirmed by the owner", func() {
+			tx, err := WalletProxy.ConfirmWhitelistForToken(Owner.TransactOpts(ethertest.WithGasLimit(100000)), RandomAccount.Address(), common.Address{})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+		})
+
+		It("should fail to be confirmed for another token", func() {
+			tx, err := WalletProxy.ConfirmWhitelistForToken(Controller.TransactOpts(ethertest.WithGasLimit(100000)), RandomAccount.Address(), RandomTokenAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeFalse())
+			returnData, _ := ethCall(tx)
+			Expect(string(returnData[len(returnData)-64:])).To(ContainSubstring("non-matching pending token whitelist"))
+		})
+
+		When("the owner cancels the submission", func() {
+			BeforeEach(func() {
+				tx, err := WalletProxy.CancelWhitelistForToken(Owner.TransactOpts(), RandomAccount.Address(), common.Address{})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should emit a CancelledWhitelistForToken event", func() {
+				it, err := WalletProxy.FilterCancelledWhitelistForToken(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(it.Next()).To(BeTrue())
+				evt := it.Event
+				Expect(it.Next()).To(BeFalse())
+				Expect(evt.Sender).To(Equal(Owner.Address()))
+				Expect(evt.To).To(Equal(RandomAccount.Address()))
+			})
+
+			It("should not be confirmable anymore", func() {
+				tx, err := WalletProxy.ConfirmWhitelistForToken(Controller.TransactOpts(ethertest.WithGasLimit(100000)), RandomAccount.Address(), common.Address{})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeFalse())
+			})
+		})
+	})
+
+	When("the controller confirms the destination for ether only", func() {
+		BeforeEach(func() {
+			tx, err := WalletProxy.SubmitWhitelistForToken(Owner.TransactOpts(), RandomAccount.Address(), common.Address{})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+
+			tx, err = WalletProxy.ConfirmWhitelistForToken(Controller.TransactOpts(), RandomAccount.Address(), common.Address{})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(isSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should clear the submission", func() {
+			submitted, err := WalletProxy.SubmittedWhitelistForToken(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(submitted).To(BeFalse())
+		})
+
+		It("should emit an AddedWhitelistForToken event", func() {
+			it, err := WalletProxy.FilterAddedWhitelistForToken(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(it.Next()).To(BeTrue())
+			evt := it.Event
+			Expect(it.Next()).To(BeFalse())
+			Expect(evt.Sender).To(Equal(Controller.Address()))
+			Expect(evt.To).To(Equal(RandomAccount.Address()))
+			Expect(evt.Token).To(Equal(common.Address{}))
+		})
+
+		It("should restrict the destination to ether", func() {
+			restricted, err := WalletProxy.IsTokenRestricted(nil, RandomAccount.Address())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restricted).To(BeTrue())
+
+			whitelisted, err := WalletProxy.IsWhitelistedForToken(nil, RandomAccount.Address(), common.Address{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(whitelisted).To(BeTrue())
+
+			whitelisted, err = WalletProxy.IsWhitelistedForToken(nil, RandomAccount.Address(), RandomTokenAddress)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(whitelisted).To(BeFalse())
+		})
+
+		It("should transfer ether beyond the spend limit", func() {
+			before := RandomAccount.Balance(Backend)
+			Expect(isSuccessful(transfer(common.Address{}, EthToWei(150)))).To(BeTrue())
+			Expect(RandomAccount.Balance(Backend).String()).To(Equal(new(big.Int).Add(before, EthToWei(150)).String()))
+		})
+
+		It("should reject transfers of other tokens", func() {
+			expectRejected(transfer(RandomTokenAddress, big.NewInt(300)))
+		})
+
+		It("should reject token transfers made with executeTransaction", func() {
+			erc20, err := abi.JSON(strings.NewReader(ERC20ABI))
+			Expect(err).ToNot(HaveOccurred())
+			data, err := erc20.Pack("transfer", RandomAccount.Address(), big.NewInt(300))
+			Expect(err).ToNot(HaveOccurred())
+			tx, err := WalletProxy.ExecuteTransaction(Owner.TransactOpts(ethertest.WithGasLimit(500000)), RandomTokenAddress, big.NewInt(0), data)
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			expectRejected(tx)
+		})
+
+		When("the owner removes the token from the destination", func() {
+			BeforeEach(func() {
+				tx, err := WalletProxy.RemoveWhitelistForToken(Owner.TransactOpts(), RandomAccount.Address(), common.Address{})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(isSuccessful(tx)).To(BeTrue())
+			})
+
+			It("should emit a RemovedWhitelistForToken event", func() {
+				it, err := WalletProxy.FilterRemovedWhitelistForToken(nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(it.Next()).To(BeTrue())
+				evt := it.Event
+				Expect(it.Next()).To(BeFalse())
+				Expect(evt.Sender).To(Equal(Owner.Address()))
+				Expect(evt.To).To(Equal(RandomAccount.Address()))
+			})
+
+			It("should lift the restriction", func() {
+				Expect(isSuccessful(transfer(RandomTokenAddress, big.NewInt(300)))).To(BeTrue())
+			})
+
+			It("should enforce the spend limit again", func() {
+				Expect(isSuccessful(transfer(common.Address{}, EthToWei(150)))).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package walletops_test
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/walletops"
+	. "github.com/tokencard/contracts/v3/test/shared"
+	"github.com/tokencard/ethertest"
+)
+
+// headBackend reports the head of the test chain, the simulated backend has no HeaderByNumber.
+type headBackend struct {
+	ethertest.TestBackend
+}
+
+func (h headBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return h.Blockchain().CurrentHeader(), nil
+}
+
+var _ = Describe("EligibleAt", func() {
+
+	When("no limit change is scheduled", func() {
+		It("should fail", func() {
+			_, err := walletops.EligibleAt(context.Background(), WalletProxy, headBackend{Backend}, walletops.OpScheduledLimitChange)
+			Expect(err).To(Equal(walletops.ErrNothingPending))
+		})
+	})
+
+	When("the operation is unknown", func() {
+		It("should fail", func() {
+			_, err := walletops.EligibleAt(context.Background(), WalletProxy, headBackend{Backend}, 42)
+			Expect(err).To(MatchError("unknown operation 42"))
+		})
+	})
+
+	When("a limit change is scheduled in an hour", func() {
+
+		BeforeEach(func() {
+			// Move the chain away from the local clock, the result must not depend on it.
+			Backend.AdjustTime(48 * time.Hour)
+			Backend.Commit()
+
+			at := Backend.Blockchain().CurrentBlock().Time() + 3600
+			tx, err := WalletProxy.ScheduleLimitChange(Owner.TransactOpts(), EthToWei(10), new(big.Int).SetUint64(at))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+
+			tx, err = WalletProxy.ConfirmLimitChange(Controller.TransactOpts(), EthToWei(10), new(big.Int).SetUint64(at))
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should be eligible in an hour from now", func() {
+			submitted := Backend.Blockchain().CurrentBlock().Time()
+			_, effectiveAt, err := WalletProxy.ScheduledLimitChange(nil)
+			Expect(err).ToNot(HaveOccurred())
+			window := time.Duration(effectiveAt.Uint64()-submitted) * time.Second
+
+			eligible, err := walletops.EligibleAt(context.Background(), WalletProxy, headBackend{Backend}, walletops.OpScheduledLimitChange)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(eligible).To(BeTemporally("~", time.Now().Add(window), time.Second))
+		})
+
+		When("the hour has passed", func() {
+			BeforeEach(func() {
+				Backend.AdjustTime(2 * time.Hour)
+				Backend.Commit()
+			})
+
+			It("should be eligible in the past", func() {
+				eligible, err := walletops.EligibleAt(context.Background(), WalletProxy, headBackend{Backend}, walletops.OpScheduledLimitChange)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(eligible).To(BeTemporally("<", time.Now()))
+			})
+		})
+	})
+})
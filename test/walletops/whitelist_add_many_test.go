@@ -0,0 +1,122 @@
+package walletops_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/bindings"
+	"github.com/tokencard/contracts/v3/pkg/walletops"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("WhitelistAddMany", func() {
+
+	var counterparty = common.HexToAddress("0x1234")
+	var other = common.HexToAddress("0x5678")
+	var wallets []common.Address
+
+	wallet := func(addr common.Address) *bindings.Wallet {
+		w, err := bindings.NewWallet(addr, Backend)
+		Expect(err).ToNot(HaveOccurred())
+		return w
+	}
+
+	BeforeEach(func() {
+		wallets = []common.Address{WalletProxyAddress}
+		for i := 0; i < 3; i++ {
+			_, addr, err := NewFundedWallet(Owner.TransactOpts(), big.NewInt(0))
+			Expect(err).ToNot(HaveOccurred())
+			wallets = append(wallets, addr)
+		}
+
+		// The first two wallets already have a whitelist.
+		for _, addr := range wallets[:2] {
+			tx, err := wallet(addr).SetWhitelist(Owner.TransactOpts(), []common.Address{other})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		}
+	})
+
+	It("should add the address to every wallet", func() {
+		results := walletops.WhitelistAddMany(context.Background(), wallets, counterparty, Backend, Owner.TransactOpts())
+		Backend.Commit()
+
+		Expect(results).To(HaveLen(len(wallets)))
+		for i, r := range results {
+			Expect(r.Err).ToNot(HaveOccurred())
+			Expect(r.Wallet).To(Equal(wallets[i]))
+			receipt, err := Backend.TransactionReceipt(context.Background(), r.TxHash)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt.Status).To(Equal(types.ReceiptStatusSuccessful))
+		}
+
+		for _, r := range results[:2] {
+			Expect(r.Pending).To(BeTrue())
+			pending, err := wallet(r.Wallet).PendingWhitelistAddition(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pending).To(Equal([]common.Address{counterparty}))
+		}
+		for _, r := range results[2:] {
+			Expect(r.Pending).To(BeFalse())
+			whitelisted, err := wallet(r.Wallet).WhitelistMap(nil, counterparty)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(whitelisted).To(BeTrue())
+		}
+	})
+
+	When("some of the additions fail", func() {
+
+		var foreignWallet common.Address
+
+		BeforeEach(func() {
+			// A wallet owned by someone else.
+			var err error
+			_, foreignWallet, err = NewFundedWallet(RandomAccount.TransactOpts(), big.NewInt(0))
+			Expect(err).ToNot(HaveOccurred())
+
+			// A wallet with a submission pending already.
+			tx, err := WalletProxy.SubmitWhitelistAddition(Owner.TransactOpts(), []common.Address{common.HexToAddress("0x9abc")})
+			Expect(err).ToNot(HaveOccurred())
+			Backend.Commit()
+			Expect(IsSuccessful(tx)).To(BeTrue())
+		})
+
+		It("should add the address to the other wallets", func() {
+			results := walletops.WhitelistAddMany(context.Background(), append(wallets, foreignWallet), counterparty, Backend, Owner.TransactOpts())
+			Backend.Commit()
+
+			Expect(results).To(HaveLen(len(wallets) + 1))
+			Expect(results[0].Err).To(HaveOccurred())
+			Expect(results[0].TxHash).To(Equal(common.Hash{}))
+			Expect(results[len(wallets)].Err).To(HaveOccurred())
+			Expect(results[len(wallets)].Wallet).To(Equal(foreignWallet))
+			for _, r := range results[1:len(wallets)] {
+				Expect(r.Err).ToNot(HaveOccurred())
+				receipt, err := Backend.TransactionReceipt(context.Background(), r.TxHash)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(receipt.Status).To(Equal(types.ReceiptStatusSuccessful))
+			}
+
+			pending, err := WalletProxy.PendingWhitelistAddition(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pending).To(Equal([]common.Address{common.HexToAddress("0x9abc")}))
+		})
+	})
+
+	When("the context is canceled", func() {
+		It("should not send anything", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			results := walletops.WhitelistAddMany(ctx, wallets, counterparty, Backend, Owner.TransactOpts())
+			Expect(results).To(HaveLen(len(wallets)))
+			for _, r := range results {
+				Expect(r.Err).To(Equal(context.Canceled))
+			}
+		})
+	})
+})
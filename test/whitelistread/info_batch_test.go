@@ -0,0 +1,90 @@
+package whitelistread_test
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/whitelistread"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("InfoBatch", func() {
+
+	var caller *whitelistread.Caller
+	var tokens = []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")}
+
+	BeforeEach(func() {
+		tx, err := TokenWhitelist.AddTokens(
+			ControllerAdmin.TransactOpts(),
+			tokens,
+			StringsToByte32("BNT", "TKN", "ZRX"),
+			[]*big.Int{DecimalsToMagnitude(big.NewInt(18)), DecimalsToMagnitude(big.NewInt(8)), DecimalsToMagnitude(big.NewInt(18))},
+			[]bool{false, true, true},
+			[]bool{false, true, false},
+			big.NewInt(20180913153211),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		tx, err = TokenWhitelist.UpdateTokenRate(ControllerAdmin.TransactOpts(), tokens[1], big.NewInt(1000), big.NewInt(20180913153212))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+
+		caller, err = whitelistread.NewCaller(RPC, TokenWhitelistAddress)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should read all the tokens in one round-trip", func() {
+		infos, err := whitelistread.InfoBatch(context.Background(), caller, tokens)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(RPC.batches).To(Equal(1))
+		Expect(infos).To(HaveLen(3))
+
+		for i, info := range infos {
+			Expect(info.Address).To(Equal(tokens[i]))
+			Expect(info.Available).To(BeTrue())
+		}
+		Expect(infos[0].Symbol).To(Equal("BNT"))
+		Expect(infos[0].Magnitude.String()).To(Equal(DecimalsToMagnitude(big.NewInt(18)).String()))
+		Expect(infos[0].Loadable).To(BeFalse())
+		Expect(infos[0].Redeemable).To(BeFalse())
+
+		Expect(infos[1].Symbol).To(Equal("TKN"))
+		Expect(infos[1].Magnitude.String()).To(Equal(DecimalsToMagnitude(big.NewInt(8)).String()))
+		Expect(infos[1].Rate.String()).To(Equal("1000"))
+		Expect(infos[1].Loadable).To(BeTrue())
+		Expect(infos[1].Redeemable).To(BeTrue())
+		Expect(infos[1].LastUpdate.String()).To(Equal("20180913153212"))
+
+		Expect(infos[2].Symbol).To(Equal("ZRX"))
+		Expect(infos[2].Loadable).To(BeTrue())
+		Expect(infos[2].Redeemable).To(BeFalse())
+		Expect(infos[2].LastUpdate.String()).To(Equal("20180913153211"))
+	})
+
+	When("a token isn't whitelisted", func() {
+		It("should return it as unavailable", func() {
+			infos, err := whitelistread.InfoBatch(context.Background(), caller, []common.Address{tokens[0], common.HexToAddress("0x4")})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(infos).To(HaveLen(2))
+			Expect(infos[0].Available).To(BeTrue())
+			Expect(infos[1].Address).To(Equal(common.HexToAddress("0x4")))
+			Expect(infos[1].Available).To(BeFalse())
+			Expect(infos[1].Symbol).To(Equal(""))
+		})
+	})
+
+	When("no tokens are given", func() {
+		It("should not make any request", func() {
+			infos, err := whitelistread.InfoBatch(context.Background(), caller, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(infos).To(BeEmpty())
+			Expect(RPC.batches).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,114 @@
+package whitelistread_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tokencard/contracts/v3/pkg/whitelistread"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+var _ = Describe("Stream", func() {
+
+	var caller *whitelistread.Caller
+	var listed []common.Address
+
+	addTokens := func(tokens ...common.Address) {
+		symbols := make([]string, len(tokens))
+		magnitudes := make([]*big.Int, len(tokens))
+		flags := make([]bool, len(tokens))
+		for i := range tokens {
+			symbols[i] = fmt.Sprintf("T%d", i)
+			magnitudes[i] = DecimalsToMagnitude(big.NewInt(18))
+		}
+		tx, err := TokenWhitelist.AddTokens(ControllerAdmin.TransactOpts(), tokens, StringsToByte32(symbols...), magnitudes, flags, flags, big.NewInt(20180913153211))
+		Expect(err).ToNot(HaveOccurred())
+		Backend.Commit()
+		Expect(IsSuccessful(tx)).To(BeTrue())
+	}
+
+	collect := func(infos <-chan whitelistread.TokenInfo, errc <-chan error, each func(whitelistread.TokenInfo)) []common.Address {
+		var got []common.Address
+		for info := range infos {
+			Expect(info.Available).To(BeTrue())
+			got = append(got, info.Address)
+			if each != nil {
+				each(info)
+			}
+		}
+		Expect(<-errc).ToNot(HaveOccurred())
+		return got
+	}
+
+	BeforeEach(func() {
+		var tokens []common.Address
+		for i := 1; i <= 10; i++ {
+			tokens = append(tokens, common.BigToAddress(big.NewInt(int64(0x1000+i))))
+		}
+		addTokens(tokens...)
+
+		var err error
+		listed, err = TokenWhitelist.TokenAddressArray(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(len(listed)).To(BeNumerically(">", 10))
+
+		caller, err = whitelistread.NewCaller(RPC, TokenWhitelistAddress)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should stream every token in pages", func() {
+		infos, errc := whitelistread.Stream(context.Background(), caller, 4)
+		Expect(collect(infos, errc, nil)).To(Equal(listed))
+
+		pages := (len(listed) + 3) / 4
+		// The token list is read once up front and once more to find additions.
+		Expect(RPC.batches).To(Equal(pages + 2))
+	})
+
+	When("the whitelist changes while it is streamed", func() {
+		It("should skip removed tokens and stream added ones", func() {
+			removed := listed[len(listed)-1]
+			added := common.HexToAddress("0x2000")
+
+			infos, errc := whitelistread.Stream(context.Background(), caller, 4)
+			first := true
+			got := collect(infos, errc, func(whitelistread.TokenInfo) {
+				if !first {
+					return
+				}
+				first = false
+				tx, err := TokenWhitelist.RemoveTokens(ControllerAdmin.TransactOpts(), []common.Address{removed})
+				Expect(err).ToNot(HaveOccurred())
+				Backend.Commit()
+				Expect(IsSuccessful(tx)).To(BeTrue())
+				addTokens(added)
+			})
+
+			Expect(got).ToNot(ContainElement(removed))
+			Expect(got).To(ContainElement(added))
+			Expect(got).To(HaveLen(len(listed)))
+		})
+	})
+
+	When("the page size isn't positive", func() {
+		It("should fail", func() {
+			infos, errc := whitelistread.Stream(context.Background(), caller, 0)
+			Expect(<-errc).To(MatchError("invalid page size 0"))
+			Eventually(infos).Should(BeClosed())
+		})
+	})
+
+	When("the context is cancelled", func() {
+		It("should stop streaming", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			infos, errc := whitelistread.Stream(ctx, caller, 4)
+			Eventually(infos).Should(Receive())
+			cancel()
+			Eventually(errc).Should(Receive(MatchError(ContainSubstring(context.Canceled.Error()))))
+		})
+	})
+})
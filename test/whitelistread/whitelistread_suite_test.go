@@ -0,0 +1,61 @@
+package whitelistread_test
+
+import (
+	"context"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tokencard/contracts/v3/test/shared"
+)
+
+func TestWhitelistReadSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Whitelist Read Suite")
+}
+
+// RPC is an in-process JSON-RPC client serving eth_call from the simulated backend.
+var RPC *countingClient
+
+var _ = BeforeEach(func() {
+	err := InitializeBackend()
+	Expect(err).ToNot(HaveOccurred())
+
+	server := rpc.NewServer()
+	err = server.RegisterName("eth", &ethService{})
+	Expect(err).ToNot(HaveOccurred())
+	RPC = &countingClient{Client: rpc.DialInProc(server)}
+})
+
+var _ = AfterEach(func() {
+	RPC.Close()
+	err := Backend.Close()
+	Expect(err).ToNot(HaveOccurred())
+})
+
+type callArgs struct {
+	To   *common.Address `json:"to"`
+	Data hexutil.Bytes   `json:"data"`
+}
+
+// ethService implements eth_call on top of the simulated backend.
+type ethService struct{}
+
+func (s *ethService) Call(ctx context.Context, args callArgs, block string) (hexutil.Bytes, error) {
+	return Backend.CallContract(ctx, ethereum.CallMsg{To: args.To, Data: args.Data}, nil)
+}
+
+// countingClient counts the round-trips made to the server.
+type countingClient struct {
+	*rpc.Client
+	batches int
+}
+
+func (c *countingClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	c.batches++
+	return c.Client.BatchCallContext(ctx, b)
+}